@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/statepath"
+)
+
+// backup is a timestamped copy of files codo replaced or removed in a
+// repository, outside the repository.
+type backup struct {
+	Name string // timestamp, e.g. 20250102-150405
+	Dir  string
+	// Manifest is the install the files belonged to; nil for backups that
+	// did not record it.
+	Manifest *manifest.Manifest
+	// RolledBackTo names the backup a rollback returned to, for the backup
+	// that rollback took of the install it left.
+	RolledBackTo string
+}
+
+// rollbackFile records, in the backup a rollback takes, the backup it
+// rolled back to.
+const rollbackFile = ".codo-rollback"
+
+// newBackupDir returns a fresh backup directory for the repo at root. It is
+// created when the first file is written to it.
+func newBackupDir(root string) (string, error) {
+	ts := time.Now().UTC().Format("20060102-150405")
+	dir, err := statepath.BackupDir(root, ts)
+	if err != nil {
+		return "", err
+	}
+	for i := 2; ; i++ {
+		if _, err := os.Stat(dir); errors.Is(err, fs.ErrNotExist) {
+			return dir, nil
+		}
+		if dir, err = statepath.BackupDir(root, ts+"-"+strconv.Itoa(i)); err != nil {
+			return "", err
+		}
+	}
+}
+
+// backups lists the backups of the repo at root, newest first.
+func backups(root string) ([]backup, error) {
+	dir, err := statepath.BackupsDir(root)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var out []backup
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		b := backup{Name: e.Name(), Dir: filepath.Join(dir, e.Name())}
+		if m, err := manifest.OpenSnapshot(b.Dir); err == nil {
+			b.Manifest = &m
+		}
+		if to, err := os.ReadFile(filepath.Join(b.Dir, rollbackFile)); err == nil {
+			b.RolledBackTo = strings.TrimSpace(string(to))
+		}
+		out = append(out, b)
+	}
+	slices.SortFunc(out, func(a, b backup) int {
+		ta, na := backupTime(a.Name)
+		tb, nb := backupTime(b.Name)
+		if c := strings.Compare(tb, ta); c != 0 {
+			return c
+		}
+		return nb - na
+	})
+	return out, nil
+}
+
+// backupTime splits a backup name into its timestamp and the sequence number
+// that orders backups taken within the same second.
+func backupTime(name string) (string, int) {
+	const stamp = len("20060102-150405")
+	if len(name) > stamp+1 && name[stamp] == '-' {
+		n, _ := strconv.Atoi(name[stamp+1:])
+		return name[:stamp], n
+	}
+	return name, 1
+}
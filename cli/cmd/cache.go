@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/statepath"
+)
+
+var cacheKeep int
+var cacheDry bool
+var cacheImportTag string
+var cacheImportFrom string
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage downloaded packs (list, prune, import, export)",
+}
+
+var cacheListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Show cached packs, newest first",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries, err := pack.CacheEntries()
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			fmt.Println("No cached packs")
+			return nil
+		}
+		used := packsInUse()
+		for _, e := range entries {
+			note := ""
+			if used[e.Key()] {
+				note = "  (in use)"
+			}
+			if err := e.Verify(); err != nil {
+				note += "  (unverified: " + err.Error() + ")"
+			}
+			tag := e.Tag
+			if tag == "" {
+				tag = "-"
+			}
+			fmt.Printf("%-16s %-10s %s %7s  %s%s\n", cacheKey(e), tag, e.Fetched.Local().Format("2006-01-02 15:04"), size(e.Size), e.Origin, note)
+		}
+		return nil
+	},
+}
+
+var cachePruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Delete cached packs no installed repository uses",
+	Long: `Delete cached packs that no repository on this machine has installed, keeping
+the newest --keep of them for offline use. Leftover downloads and the cache
+location of older versions (~/.codo/packs) are removed too.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries, err := pack.CacheEntries()
+		if err != nil {
+			return err
+		}
+		used := packsInUse()
+		kept, removed := 0, 0
+		var freed int64
+		for _, e := range entries {
+			if used[e.Key()] {
+				continue
+			}
+			if kept < cacheKeep && e.Verify() == nil {
+				kept++
+				continue
+			}
+			fmt.Printf("- %s %s\n", cacheKey(e), e.Tag)
+			if !cacheDry {
+				if err := e.Remove(); err != nil {
+					return err
+				}
+			}
+			removed++
+			freed += e.Size
+		}
+		if !cacheDry {
+			removeStale()
+			if legacy := pack.LegacyPacksDir(); legacy != "" {
+				if _, err := os.Stat(legacy); err == nil {
+					fmt.Printf("- %s (old cache location)\n", legacy)
+					if err := os.RemoveAll(legacy); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		fmt.Printf("\nRemoved %d cached packs (%s)\n", removed, size(freed))
+		return nil
+	},
+}
+
+var cacheExportCmd = &cobra.Command{
+	Use:   "export <digest|tag> <file>",
+	Short: "Write a cached pack and its checksum to a file, e.g. for an offline machine",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		e, err := pack.FindCached(args[0])
+		if err != nil {
+			return err
+		}
+		if err := e.Export(args[1]); err != nil {
+			return err
+		}
+		fmt.Printf("Exported %s (%s) to %s and %s.sha256\n", cacheKey(e), e.Tag, args[1], args[1])
+		return nil
+	},
+}
+
+var cacheImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Add a pack archive to the cache so installs can use it offline",
+	Long: `Add a pack archive to the cache. A checksum file next to it (<file>.sha256, as
+written by export and published with releases) is verified. The pack is filed
+as a release of the upstream pack unless --from names another github: source,
+and is used by init and update when those releases cannot be reached.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		rs := releaseSource()
+		if cacheImportFrom != "" {
+			src, err := pack.ParseSource(cacheImportFrom)
+			if err != nil {
+				return err
+			}
+			gh, ok := src.(*pack.GitHubSource)
+			if !ok {
+				return fmt.Errorf("--from must be a github: source, got %s", cacheImportFrom)
+			}
+			rs = gh.Releases
+		}
+		e, err := rs.Import(args[0], cacheImportTag)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Imported %s as %s (sha256 %s)\n", args[0], e.Tag, short(e.Digest))
+		return nil
+	},
+}
+
+func init() {
+	cachePruneCmd.Flags().IntVar(&cacheKeep, "keep", 2, "Number of unused packs to keep for offline use")
+	cachePruneCmd.Flags().BoolVar(&cacheDry, "dry-run", false, "Preview only")
+	cacheImportCmd.Flags().StringVar(&cacheImportTag, "tag", "", "Release tag to file the pack under (default: v<version> from its pack.json)")
+	cacheImportCmd.Flags().StringVar(&cacheImportFrom, "from", "", "github: source the pack was released from (default: upstream)")
+	cacheCmd.AddCommand(cacheListCmd, cachePruneCmd, cacheExportCmd, cacheImportCmd)
+	rootCmd.AddCommand(cacheCmd)
+}
+
+// packsInUse returns the cache keys of packs installed in any repository,
+// including their layers.
+func packsInUse() map[string]bool {
+	used := map[string]bool{}
+	all, _ := manifest.All()
+	for _, m := range all {
+		pins := append([]manifest.Layer{{Source: manifestSource(m), Tag: m.Tag, Digest: m.Digest}}, m.Layers...)
+		for _, p := range pins {
+			switch {
+			case p.Digest != "":
+				used[p.Digest] = true
+			case strings.HasPrefix(p.Source, "git+") && p.Tag != "":
+				used["git:"+p.Tag] = true
+			}
+		}
+	}
+	return used
+}
+
+// removeStale deletes downloads and checkouts interrupted more than a day ago.
+func removeStale() {
+	root, err := statepath.PacksDir()
+	if err != nil {
+		return
+	}
+	for _, pattern := range []string{"download-*.zip", "git-*", "sha256/.unpack-*"} {
+		matches, _ := filepath.Glob(filepath.Join(root, pattern))
+		for _, p := range matches {
+			if info, err := os.Stat(p); err == nil && time.Since(info.ModTime()) > 24*time.Hour {
+				os.RemoveAll(p)
+			}
+		}
+	}
+}
+
+// cacheKey abbreviates an entry's key for display.
+func cacheKey(e pack.CacheEntry) string {
+	if e.Commit != "" {
+		return "git:" + short(e.Commit)
+	}
+	return short(e.Digest)
+}
+
+// size formats a byte count.
+func size(n int64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1fM", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1fK", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%dB", n)
+}
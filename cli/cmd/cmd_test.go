@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+)
+
+// newRepo creates a git repository with a config directory and home of its
+// own, and makes it the working directory.
+func newRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("CODO_PACK_DIR", "")
+	t.Setenv("CODO_RELEASES_URL", "http://127.0.0.1:1")
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	git(t, dir, "init", "-q")
+	chdir(t, dir)
+	return dir
+}
+
+// git runs git in dir.
+func git(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	c := exec.Command("git", args...)
+	c.Dir = dir
+	c.Env = append(os.Environ(), "GIT_AUTHOR_NAME=a", "GIT_AUTHOR_EMAIL=a@b", "GIT_COMMITTER_NAME=a", "GIT_COMMITTER_EMAIL=a@b")
+	if out, err := c.CombinedOutput(); err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+}
+
+// chdir changes the working directory for the rest of the test.
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+}
+
+// writePack writes a pack of the given version whose files are
+// dotclaude-relative paths and their contents, and returns its dir: spec.
+// A "../pack.json" in files replaces the generated one and sets the version
+// itself.
+func writePack(t *testing.T, version string, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	if _, ok := files["../pack.json"]; !ok {
+		files["../pack.json"] = `{"version":"` + version + `"}`
+	}
+	for rel, data := range files {
+		path := filepath.Join(dir, "dotclaude", filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return "dir:" + dir
+}
+
+// embedPack makes the pack written by writePack the embedded one.
+func embedPack(t *testing.T, spec string) {
+	t.Helper()
+	pack.SetEmbeddedFS(os.DirFS(strings.TrimPrefix(spec, "dir:")))
+	t.Cleanup(func() { pack.SetEmbeddedFS(nil) })
+}
+
+// codo runs a codo command the way Execute does, with every flag back at its
+// default first.
+func codo(t *testing.T, args ...string) error {
+	t.Helper()
+	resetCommands(rootCmd)
+	rootCmd.SetArgs(args)
+	return endJournal(rootCmd.Execute())
+}
+
+// mustCodo runs a codo command that must succeed.
+func mustCodo(t *testing.T, args ...string) {
+	t.Helper()
+	if err := codo(t, args...); err != nil {
+		t.Fatalf("codo %v: %v", args, err)
+	}
+}
+
+// resetCommands puts every flag back at its default and undoes what the last
+// run set on the commands.
+func resetCommands(c *cobra.Command) {
+	reset := func(f *pflag.Flag) {
+		if s, ok := f.Value.(pflag.SliceValue); ok {
+			_ = s.Replace(nil)
+		} else {
+			_ = f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	}
+	c.Flags().VisitAll(reset)
+	c.PersistentFlags().VisitAll(reset)
+	c.SilenceUsage = false
+	for _, sub := range c.Commands() {
+		resetCommands(sub)
+	}
+}
+
+// installed returns the manifest of the repository.
+func installed(t *testing.T) manifest.Manifest {
+	t.Helper()
+	m, err := manifest.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// readFile returns the contents of a repository file.
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
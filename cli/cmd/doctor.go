@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/hergert/codo-agentic-toolkit/cli/internal/doctor"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
 )
 
 var doctorCmd = &cobra.Command{
@@ -17,7 +18,11 @@ var doctorCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		summary := doctor.Collect(root)
+		var catalog []pack.Stack
+		if rootFS, err := pack.GetEmbeddedBaseFS(); err == nil {
+			catalog, _ = pack.Catalog(rootFS)
+		}
+		summary := doctor.Collect(root, catalog)
 		fmt.Println("Dev tools checklist:")
 		for _, item := range summary.Items {
 			fmt.Printf(" - %s: %s\n", item.Label, item.Detail)
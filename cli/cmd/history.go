@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/history"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+)
+
+var historyJSON bool
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show what codo changed in this repository over time",
+	Long: `List every init, update, rollback, sync, stack add/remove, remove and restore
+run in this repository, oldest first: when, by which codo, the pack version
+before and after, and the files added, updated, left conflicted and removed.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		root, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		events, err := history.Read(root)
+		if err != nil {
+			return err
+		}
+		if historyJSON {
+			if events == nil {
+				events = []history.Event{}
+			}
+			buf, _ := json.MarshalIndent(events, "", "  ")
+			fmt.Println(string(buf))
+			return nil
+		}
+		if len(events) == 0 {
+			fmt.Println("No history for this repository")
+			return nil
+		}
+		for _, e := range events {
+			at := e.Time
+			if t, err := time.Parse(time.RFC3339, e.Time); err == nil {
+				at = t.Local().Format("2006-01-02 15:04")
+			}
+			fmt.Printf("%-16s  %-13s %-17s %s  (codo %s)\n", at, e.Command, versions(e), counts(e), e.CLIVersion)
+			for _, p := range e.Conflicted {
+				fmt.Println("    ! " + p)
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	historyCmd.Flags().BoolVar(&historyJSON, "json", false, "Print the events as JSON")
+	rootCmd.AddCommand(historyCmd)
+}
+
+// versions describes the pack versions an event went between.
+func versions(e history.Event) string {
+	switch {
+	case e.From == e.To:
+		return e.To
+	case e.From == "":
+		return "→ " + e.To
+	case e.To == "":
+		return e.From + " →"
+	}
+	return e.From + " → " + e.To
+}
+
+// counts summarizes the files an event changed.
+func counts(e history.Event) string {
+	var out []string
+	for _, c := range []struct {
+		n    int
+		verb string
+	}{{len(e.Added), "added"}, {len(e.Updated), "updated"}, {len(e.Conflicted), "conflicted"}, {len(e.Removed), "removed"}} {
+		if c.n > 0 {
+			out = append(out, fmt.Sprintf("%d %s", c.n, c.verb))
+		}
+	}
+	if len(out) == 0 {
+		return "no file changes"
+	}
+	return strings.Join(out, ", ")
+}
+
+// recordHistory appends the change a command made, from the install before
+// to the one after, to the repository's history. Files are compared by their
+// recorded hashes; conflicts lists the files left for the user to resolve.
+func recordHistory(command string, before, after manifest.Manifest, conflicts []string) error {
+	root, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	e := history.Event{
+		Time:       time.Now().UTC().Format(time.RFC3339),
+		Command:    command,
+		CLIVersion: version,
+		From:       before.Version,
+		To:         after.Version,
+		Conflicted: slices.Clone(conflicts),
+	}
+	old := map[string]string{}
+	for _, ent := range before.Files {
+		old[ent.Path] = ent.SHA256
+	}
+	for _, ent := range after.Files {
+		sum, ok := old[ent.Path]
+		delete(old, ent.Path)
+		switch {
+		case slices.Contains(conflicts, ent.Path):
+		case !ok:
+			e.Added = append(e.Added, ent.Path)
+		case sum != ent.SHA256:
+			e.Updated = append(e.Updated, ent.Path)
+		}
+	}
+	for path := range old {
+		e.Removed = append(e.Removed, path)
+	}
+	slices.Sort(e.Conflicted)
+	slices.Sort(e.Added)
+	slices.Sort(e.Updated)
+	slices.Sort(e.Removed)
+	return history.Append(root, e)
+}
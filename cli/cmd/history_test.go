@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/history"
+)
+
+func TestHistoryRecordsEachChange(t *testing.T) {
+	root := newRepo(t)
+	v1 := writePack(t, "1.0.0", map[string]string{"commands/a.md": "a 1\n", "commands/b.md": "b\n"})
+	v2 := writePack(t, "2.0.0", map[string]string{"commands/a.md": "a 2\n", "commands/c.md": "c\n"})
+	mustCodo(t, "init", "--no-tui", "--stacks", "", "--from", v1)
+	mustCodo(t, "update", "--from", v2)
+	mustCodo(t, "update", "--from", v2, "--dry-run")
+	mustCodo(t, "rollback")
+	mustCodo(t, "remove")
+	mustCodo(t, "history")
+	mustCodo(t, "history", "--json")
+
+	events, err := history.Read(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var commands []string
+	for _, e := range events {
+		commands = append(commands, e.Command)
+	}
+	if want := []string{"init", "update", "rollback", "remove"}; !slices.Equal(commands, want) {
+		t.Fatalf("history = %v, want %v", commands, want)
+	}
+	up := events[1]
+	if up.From != "1.0.0" || up.To != "2.0.0" || up.CLIVersion != version {
+		t.Fatalf("update event = %+v", up)
+	}
+	if !slices.Contains(up.Added, ".claude/commands/c.md") || !slices.Contains(up.Updated, ".claude/commands/a.md") || !slices.Contains(up.Removed, ".claude/commands/b.md") {
+		t.Fatalf("update event files: added %v, updated %v, removed %v", up.Added, up.Updated, up.Removed)
+	}
+	if back := events[2]; back.From != "2.0.0" || back.To != "1.0.0" {
+		t.Fatalf("rollback event = %+v", back)
+	}
+	if rm := events[3]; rm.To != "" || !slices.Contains(rm.Removed, ".claude/commands/a.md") {
+		t.Fatalf("remove event = %+v", rm)
+	}
+}
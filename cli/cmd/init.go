@@ -3,8 +3,8 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"io/fs"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -20,6 +20,11 @@ var initDryRun bool
 var initStacks string // comma-separated
 var initNoTUI bool    // headless mode
 var initOffline bool  // force embedded base pack only
+var initHooksTarget string
+var initSnippets string // comma-separated, or "suggested"
+var initFrom string
+var initLayers []string
+var initWriteLock bool
 
 var initCmd = &cobra.Command{
 	Use:   "init",
@@ -28,20 +33,66 @@ var initCmd = &cobra.Command{
 		root, _ := os.Getwd()
 		ctx := context.Background()
 
+		locked, err := initLock()
+		if err != nil {
+			return err
+		}
+		var src resolvedPack
+		if locked != nil {
+			fmt.Printf("Installing pack %s as recorded in %s\n", locked.Version, manifest.LockPath)
+			if src, err = lockedPack(*locked); err != nil {
+				return err
+			}
+		} else {
+			layers, err := parseLayers(initLayers)
+			if err != nil {
+				return err
+			}
+			if src, err = resolvePack(initFrom, initVersion, initOffline); err != nil {
+				return err
+			}
+			if err := openLayers(&src, layers); err != nil {
+				return err
+			}
+		}
+		packs := src.Layers()
+		catalog, err := packs.Catalog()
+		if err != nil {
+			return err
+		}
+
+		detected := pack.Detect(catalog, os.DirFS(root))
+		for _, d := range detected {
+			fmt.Printf("Detected stack %s\n", d)
+		}
+
 		var choices tui.InitResult
-		if initNoTUI || initStacks != "" {
-			// Headless: parse stacks from flag
-			keys := []string{}
-			for _, s := range strings.Split(initStacks, ",") {
-				ss := strings.TrimSpace(s)
-				if ss != "" {
-					keys = append(keys, ss)
+		if locked != nil {
+			choices = tui.InitResult{Stacks: locked.Stacks, Confirmed: true}
+		} else if initNoTUI || initStacks != "" {
+			// Headless: parse stacks from flag, "auto" standing for the detected ones
+			stacks := initStacks
+			if stacks == "" {
+				stacks = "auto"
+			}
+			var keys []string
+			for _, s := range splitList(stacks) {
+				if s == "auto" {
+					keys = append(keys, pack.DetectedKeys(detected)...)
+				} else {
+					keys = append(keys, s)
 				}
 			}
+			if len(keys) == 0 && len(detected) == 0 && slices.Contains(splitList(stacks), "auto") {
+				fmt.Println("No stacks detected; installing the base pack only")
+			}
 			choices = tui.InitResult{Stacks: keys, Confirmed: true}
 		} else {
-			var err error
-			choices, err = tui.RunInitWizard(ctx)
+			opts := make([]tui.Option, len(catalog))
+			for i, s := range catalog {
+				opts[i] = tui.Option{Key: s.Key, Label: s.Label, Description: s.Description, Selected: pack.Has(detected, s.Key)}
+			}
+			choices, err = tui.RunInitWizard(ctx, opts)
 			if err != nil {
 				return err
 			}
@@ -50,59 +101,72 @@ var initCmd = &cobra.Command{
 				return nil
 			}
 		}
+		requested := choices.Stacks
+		choices.Stacks, err = pack.ResolveStacks(catalog, requested)
+		if err != nil {
+			return err
+		}
+		for _, s := range choices.Stacks {
+			if !slices.Contains(requested, s) {
+				fmt.Printf("Adding stack %s (required by your selection)\n", s)
+			}
+		}
 
-		// Pack resolution order:
-		// 1. Local ./pack directory (for development)
-		// 2. Downloaded pack (unless --offline)
-		// 3. Embedded base pack (fallback)
-		var rootFS fs.FS
-		var packSource string
-
-		if _, err := os.Stat("pack"); err == nil {
-			// Use local pack for development
-			rootFS = os.DirFS("pack")
-			packSource = "local"
-			fmt.Println("Using local pack directory")
-		} else if !initOffline {
-			// Try to download pack from GitHub
-			versionToFetch := initVersion
-			if versionToFetch == "" {
-				versionToFetch = "latest"
-			}
-			fmt.Printf("Downloading pack version: %s...\n", versionToFetch)
+		for _, s := range packs.MissingStacks(choices.Stacks) {
+			fmt.Fprintf(os.Stderr, "warning: stack %q has no content in the %s pack; nothing stack-specific will be installed\n", s, src.Source)
+		}
 
-			packPath, err := pack.Resolve(versionToFetch)
-			if err == nil {
-				rootFS = os.DirFS(packPath)
-				packSource = versionToFetch
-				fmt.Printf("Downloaded pack from GitHub releases\n")
+		snips, err := packs.Snippets()
+		if err != nil {
+			return err
+		}
+		suggested := pack.SuggestSnippets(snips, choices.Stacks)
+		var enabled []string
+		for _, name := range splitList(initSnippets) {
+			if name == "suggested" {
+				enabled = append(enabled, suggested...)
 			} else {
-				// Fall back to embedded base
-				fmt.Printf("Download failed (%v), using embedded base pack\n", err)
-				rootFS, err = pack.GetEmbeddedBaseFS()
-				if err != nil {
-					return fmt.Errorf("failed to load embedded pack: %w", err)
-				}
-				packSource = "embedded-base"
+				enabled = append(enabled, name)
 			}
-		} else {
-			// --offline flag: use embedded base only
-			fmt.Println("Using embedded base pack (offline mode)")
-			rootFS, err = pack.GetEmbeddedBaseFS()
-			if err != nil {
-				return fmt.Errorf("failed to load embedded pack: %w", err)
-			}
-			packSource = "embedded-base"
 		}
+		slices.Sort(enabled)
+		enabled = slices.Compact(enabled)
 
-		files, err := pack.FilesFromDotclaudeFS(rootFS, choices.Stacks)
+		sel := pack.Selection{Stacks: choices.Stacks, HooksTarget: initHooksTarget, Snippets: enabled}
+		if locked != nil {
+			sel.Snippets = locked.Snippets
+			if locked.HooksTarget != "" {
+				sel.HooksTarget = locked.HooksTarget
+			}
+		}
+		files, err := packs.Files(sel)
 		if err != nil {
 			return err
 		}
+		if locked != nil {
+			if err := checkLockedFiles(files, *locked); err != nil {
+				return err
+			}
+		}
 
 		unmanaged := map[string]bool{}
+		owned := map[string][]byte{}
 		// Copy safely (or simulate with --dry-run). fsops prints +/=!/conflict lines.
 		for _, f := range files {
+			switch f.Policy {
+			case pack.PolicyMergeJSON:
+				contrib, _, err := fsops.MergeJSON(f, root, nil, initDryRun)
+				if err != nil {
+					return err
+				}
+				owned[f.RelPath] = contrib
+				continue
+			case pack.PolicySeedOnce, pack.PolicyNeverOverwrite:
+				if _, err := fsops.Seed(f, root, initDryRun); err != nil {
+					return err
+				}
+				continue
+			}
 			managed, err := fsops.CopySafe(f, root, initDryRun)
 			if err != nil {
 				return err
@@ -111,24 +175,80 @@ var initCmd = &cobra.Command{
 				unmanaged[f.RelPath] = true
 			}
 		}
-		installedVersion := packSource
+		installedVersion := src.Version
 		if !initDryRun {
 			if err := fsops.ChmodHooks(); err != nil {
 				return err
 			}
-			if err := manifest.WriteWithStacks(files, installedVersion, choices.Stacks, unmanaged); err != nil {
+			m := manifest.Manifest{Stacks: sel.Stacks, HooksTarget: sel.HooksTarget, Snippets: sel.Snippets}
+			src.stamp(&m)
+			if err := manifest.WriteWithStacks(files, m, unmanaged, owned); err != nil {
+				return err
+			}
+			if initWriteLock && !manifest.LockExists() {
+				if err := writeLock(); err != nil {
+					return err
+				}
+			}
+			after, err := manifest.Open()
+			if err != nil {
+				return err
+			}
+			var conflicts []string
+			for path := range unmanaged {
+				conflicts = append(conflicts, path)
+			}
+			if err := recordHistory("init", manifest.Manifest{}, after, conflicts); err != nil {
 				return err
 			}
 		}
 		fmt.Printf("\nCodo %s initialized. Resolve any *.codo.new conflicts noted above.\n", installedVersion)
+		for _, name := range suggested {
+			if !slices.Contains(enabled, name) {
+				fmt.Printf("Suggested hook snippet for your stacks: %s (enable with `codo snippets enable %s`)\n", name, name)
+			}
+		}
 		return nil
 	},
 }
 
+// initLock returns the repository's lock when init should reproduce it:
+// when no flag asks for a different pack or selection.
+func initLock() (*manifest.Lock, error) {
+	if !manifest.LockExists() {
+		return nil, nil
+	}
+	if initFrom != "" || initVersion != "" || len(initLayers) > 0 || initStacks != "" || initSnippets != "" || initOffline {
+		fmt.Printf("Not following %s: installing what the flags select; the lock is rewritten\n", manifest.LockPath)
+		return nil, nil
+	}
+	l, err := manifest.OpenLock()
+	if err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// splitList parses a comma-separated flag value, dropping empty items.
+func splitList(s string) []string {
+	out := []string{}
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
 func init() {
 	initCmd.Flags().StringVar(&initVersion, "version", "", "Pack version to download (e.g. v1.2.0)")
 	initCmd.Flags().BoolVar(&initDryRun, "dry-run", false, "Preview only; do not write files")
-	initCmd.Flags().StringVar(&initStacks, "stacks", "", "Comma-separated stacks (skip TUI)")
+	initCmd.Flags().StringVar(&initStacks, "stacks", "", `Comma-separated stacks (skip TUI); "auto" selects the detected ones`)
 	initCmd.Flags().BoolVar(&initNoTUI, "no-tui", false, "Don't show the TUI wizard")
+	initCmd.Flags().StringVar(&initFrom, "from", "", "Pack source: dir:<path>, zip:<path>, https://…/pack.zip, git+https://…@ref, github:<org>/<repo>[@tag] or embedded")
+	initCmd.Flags().StringArrayVar(&initLayers, "layer", nil, "Layer another pack on top: <name>=<source>, repeatable, applied in order (e.g. org=git+https://…@main)")
 	initCmd.Flags().BoolVar(&initOffline, "offline", false, "Use embedded base pack only (no download)")
+	initCmd.Flags().StringVar(&initSnippets, "snippets", "", `Comma-separated hook snippets to enable ("suggested" for the stacks' defaults)`)
+	initCmd.Flags().BoolVar(&initWriteLock, "lock", false, "Also write "+manifest.LockPath+" to commit, so teammates and CI install the same pack")
+	initCmd.Flags().StringVar(&initHooksTarget, "hooks-target", pack.HooksTargetSettings, "Register hooks in .claude/settings.json (settings) or settings.local.json (local)")
 }
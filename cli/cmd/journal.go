@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/journal"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/statepath"
+)
+
+// transactional marks commands whose changes to the repository are journaled:
+// they are committed when the command succeeds and rolled back when it fails
+// or is interrupted.
+const transactional = "transactional"
+
+func init() {
+	for _, c := range []*cobra.Command{initCmd, updateCmd, removeCmd, stackAddCmd, stackRemoveCmd, snippetsEnableCmd, snippetsDisableCmd, rollbackCmd, restoreCmd, lockCmd, syncCmd} {
+		if c.Annotations == nil {
+			c.Annotations = map[string]string{}
+		}
+		c.Annotations[transactional] = "true"
+	}
+}
+
+// changesRepo reports whether cmd, as invoked, changes the repository: it is
+// transactional and not run as a --dry-run or --check preview.
+func changesRepo(cmd *cobra.Command) bool {
+	if cmd.Annotations[transactional] == "" {
+		return false
+	}
+	for _, preview := range []string{"dry-run", "check"} {
+		if f := cmd.Flags().Lookup(preview); f != nil && f.Value.String() == "true" {
+			return false
+		}
+	}
+	return true
+}
+
+// journalDir returns where the current repository's transactions are journaled.
+func journalDir() (string, error) {
+	root, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	return statepath.JournalDir(root)
+}
+
+// beginJournal starts the transaction of a command that modifies the
+// repository. Other commands and previews only warn about a journal left
+// behind.
+func beginJournal(cmd *cobra.Command) error {
+	dir, err := journalDir()
+	if err != nil {
+		return err
+	}
+	if !changesRepo(cmd) {
+		if cmd != recoverCmd {
+			if j, err := journal.Pending(dir); err == nil && j != nil {
+				fmt.Fprintln(os.Stderr, "warning: "+(&journal.PendingError{Journal: j}).Error())
+			}
+		}
+		return nil
+	}
+	_, err = journal.Begin(dir, strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" "))
+	return err
+}
+
+// endJournal commits the running transaction if the command succeeded and
+// rolls it back if it failed.
+func endJournal(err error) error {
+	j := journal.Active()
+	if j == nil {
+		return err
+	}
+	if err == nil {
+		return j.Commit()
+	}
+	n := len(j.Changed())
+	if rerr := j.Rollback(); rerr != nil {
+		return fmt.Errorf("%w\nrolling back failed: %v\nrun `codo recover --rollback` to retry", err, rerr)
+	}
+	if n > 0 {
+		fmt.Fprintf(os.Stderr, "Rolled back %d changed files.\n", n)
+	}
+	return err
+}
+
+var recoverRollback bool
+var recoverKeep bool
+
+var recoverCmd = &cobra.Command{
+	Use:   "recover",
+	Short: "Roll back or keep the changes of an interrupted command",
+	Long: `When codo is killed or crashes while changing a repository, the changes it
+made so far are left journaled. recover lists them; --rollback restores the
+repository and manifest as they were before the command, --keep accepts the
+partial changes (run ` + "`codo update`" + ` afterwards to complete them).`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if recoverRollback && recoverKeep {
+			return errors.New("--rollback and --keep are mutually exclusive")
+		}
+		dir, err := journalDir()
+		if err != nil {
+			return err
+		}
+		j, err := journal.Pending(dir)
+		if err != nil {
+			return err
+		}
+		if j == nil {
+			fmt.Println("Nothing to recover")
+			return nil
+		}
+		if j.Running() {
+			return &journal.PendingError{Journal: j}
+		}
+		switch {
+		case recoverRollback:
+			if err := j.Rollback(); err != nil {
+				return err
+			}
+			fmt.Printf("Rolled back the interrupted `codo %s` (%d files restored)\n", j.Command, len(j.Changed()))
+		case recoverKeep:
+			if err := j.Commit(); err != nil {
+				return err
+			}
+			fmt.Printf("Kept the changes of the interrupted `codo %s`\n", j.Command)
+		default:
+			fmt.Printf("`codo %s` was interrupted (started %s) after changing:\n", j.Command, j.Started.Local().Format("2006-01-02 15:04:05"))
+			for _, p := range j.Changed() {
+				fmt.Println("  " + p)
+			}
+			fmt.Println("\nRun `codo recover --rollback` to undo these changes, or `codo recover --keep` to keep them.")
+		}
+		return nil
+	},
+}
+
+func init() {
+	recoverCmd.Flags().BoolVar(&recoverRollback, "rollback", false, "Restore the files and manifest as they were before the interrupted command")
+	recoverCmd.Flags().BoolVar(&recoverKeep, "keep", false, "Keep the partial changes and discard the journal")
+	rootCmd.AddCommand(recoverCmd)
+}
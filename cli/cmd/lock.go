@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+)
+
+var lockCmd = &cobra.Command{
+	Use:   "lock",
+	Short: "Record this install in " + manifest.LockPath + " for teammates and CI",
+	Long: `Write ` + manifest.LockPath + `: the pack source and version (with its digest),
+layers, stacks, snippets and the hash of every installed file. Commit it: on
+another machine ` + "`codo init`" + ` installs exactly that pack and selection, and
+codo keeps the lock up to date whenever it changes the install.
+
+Local changes to files codo installed are recorded too, as the team's: commit
+them with the lock, and ` + "`codo sync`" + ` expects them instead of reporting them,
+until an update changes the pack's copy of the file.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		abortIf(!manifest.Exists(), "No manifest found. Run `codo init --lock` to install and lock.")
+		m, err := manifest.Open()
+		if err != nil {
+			return err
+		}
+		if err := manifest.SaveLockAccepting(m); err != nil {
+			return err
+		}
+		l, err := manifest.OpenLock()
+		if err != nil {
+			return err
+		}
+		for _, lf := range l.Files {
+			if lf.Local != "" {
+				fmt.Println("~ " + lf.Path + " (local changes recorded)")
+			}
+		}
+		fmt.Printf("Wrote %s; commit it with .claude/\n", manifest.LockPath)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(lockCmd)
+}
+
+// writeLock records the installed manifest in the repository's lock.
+func writeLock() error {
+	m, err := manifest.Open()
+	if err != nil {
+		return err
+	}
+	return manifest.SaveLock(m)
+}
+
+// lockMatches reports whether l records the install m. Local changes the
+// lock accepted are drift, not a different install.
+func lockMatches(l manifest.Lock, m manifest.Manifest) bool {
+	l.Files = slices.Clone(l.Files)
+	for i := range l.Files {
+		l.Files[i].Local = ""
+	}
+	a, _ := json.Marshal(l)
+	b, _ := json.Marshal(manifest.NewLock(m))
+	return bytes.Equal(a, b)
+}
+
+// lockedPack resolves the pack and layers a lock pins and fails if they are
+// not the ones it recorded, e.g. because a release was re-published.
+func lockedPack(l manifest.Lock) (resolvedPack, error) {
+	p, err := installedPack(l.Manifest())
+	if err != nil {
+		return resolvedPack{}, err
+	}
+	pins := append([]manifest.Layer{{Version: l.Version, Digest: l.Digest}}, l.Layers...)
+	got := append([]resolvedPack{p}, p.Overlays...)
+	for i, pin := range pins {
+		what := "the pack"
+		if pin.Name != "" {
+			what = "layer " + pin.Name
+		}
+		switch {
+		case pin.Digest != "" && got[i].Release.Digest != pin.Digest:
+			return resolvedPack{}, fmt.Errorf("%s resolved to sha256 %s, but %s records %s", what, short(got[i].Release.Digest), manifest.LockPath, short(pin.Digest))
+		case pin.Digest == "" && pin.Version != "" && got[i].Version != pin.Version:
+			return resolvedPack{}, fmt.Errorf("%s resolved to version %s, but %s records %s", what, got[i].Version, manifest.LockPath, pin.Version)
+		}
+	}
+	return p, nil
+}
+
+// checkLockedFiles compares the managed files composed from a locked pack with
+// the hashes the lock records, catching sources that have no digest to pin.
+func checkLockedFiles(files []pack.File, l manifest.Lock) error {
+	want := map[string]string{}
+	for _, lf := range l.Files {
+		if (lf.Policy == "" || lf.Policy == pack.PolicyManaged) && !lf.Unmanaged {
+			want[lf.Path] = lf.SHA256
+		}
+	}
+	var diff []string
+	for _, f := range files {
+		if f.Policy != pack.PolicyManaged {
+			continue
+		}
+		sum, ok := want[f.RelPath]
+		if !ok {
+			continue // unmanaged on the machine that wrote the lock
+		}
+		delete(want, f.RelPath)
+		b, err := f.Read()
+		if err != nil {
+			return err
+		}
+		if fmt.Sprintf("%x", sha256.Sum256(b)) != sum {
+			diff = append(diff, "~ "+f.RelPath)
+		}
+	}
+	for path := range want {
+		diff = append(diff, "- "+path)
+	}
+	if len(diff) == 0 {
+		return nil
+	}
+	slices.Sort(diff)
+	return fmt.Errorf("the pack does not match %s:\n  %s", manifest.LockPath, strings.Join(diff, "\n  "))
+}
@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/spf13/cobra"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+)
+
+var outdatedStrict bool
+
+var outdatedCmd = &cobra.Command{
+	Use:   "outdated",
+	Short: "Check whether a newer pack release is available",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		abortIf(!manifest.Exists(), "No manifest found. Run `codo init` first.")
+		m, err := manifest.Open()
+		if err != nil {
+			return err
+		}
+		rs, channel, ok := releaseChannel(m)
+		if !ok {
+			fmt.Printf("Pack %s is installed from %s, which publishes no releases to check\n", m.Version, manifestSource(m))
+			return nil
+		}
+		avail, err := rs.Pin(channel)
+		if err != nil {
+			return fmt.Errorf("check %s release: %w", channel, err)
+		}
+		if !packOutdated(m, avail) {
+			fmt.Printf("Pack %s is up to date (%s is %s)\n", m.Version, channel, avail.Tag)
+			return nil
+		}
+
+		cur, err := installedPack(m)
+		if err != nil {
+			return err
+		}
+		next, err := rs.Resolve(avail.Tag)
+		if err != nil {
+			return err
+		}
+		nextVersion := avail.Tag
+		if meta, err := pack.ReadMeta(os.DirFS(next.Dir)); err == nil {
+			if meta.Version != "" {
+				nextVersion = meta.Version
+			}
+			if err := meta.Check(version); err != nil {
+				fmt.Fprintln(os.Stderr, "warning:", err)
+			}
+		}
+
+		// Compare with the same layers on top
+		oldFiles, err := cur.Layers().Files(m.Selection())
+		if err != nil {
+			return err
+		}
+		nextLayers := cur.Layers()
+		nextLayers[0].FS = os.DirFS(next.Dir)
+		newFiles, err := nextLayers.Files(m.Selection())
+		if err != nil {
+			return err
+		}
+		changes, err := pack.Compare(oldFiles, newFiles)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("\nInstalled: %s\nAvailable: %s (%s)\n\n", m.Version, nextVersion, avail.Tag)
+		for _, p := range changes.Added {
+			fmt.Println("  + " + p)
+		}
+		for _, p := range changes.Changed {
+			fmt.Println("  ~ " + p)
+		}
+		for _, p := range changes.Removed {
+			fmt.Println("  - " + p)
+		}
+		fmt.Printf("\n%d added, %d changed, %d removed. Run `codo update` to apply.\n",
+			len(changes.Added), len(changes.Changed), len(changes.Removed))
+		if outdatedStrict {
+			return fmt.Errorf("pack is outdated")
+		}
+		return nil
+	},
+}
+
+func init() {
+	outdatedCmd.Flags().BoolVar(&outdatedStrict, "strict", false, "Exit non-zero if a newer pack is available")
+	rootCmd.AddCommand(outdatedCmd)
+}
+
+// releaseChannel returns the releases an install follows and the moving tag
+// to compare with: edge installs track edge, everything else the latest
+// release. Installs from the embedded pack follow upstream; sources without
+// releases (dir:, zip:, URLs, git) report false.
+func releaseChannel(m manifest.Manifest) (pack.ReleaseSource, string, bool) {
+	spec := manifestSource(m)
+	if spec == "embedded" {
+		return releaseSource(), "latest", true
+	}
+	src, err := pack.ParseSource(spec)
+	if err != nil {
+		return pack.ReleaseSource{}, "", false
+	}
+	gh, ok := src.(*pack.GitHubSource)
+	if !ok {
+		return pack.ReleaseSource{}, "", false
+	}
+	if gh.Tag == "edge" {
+		return gh.Releases, "edge", true
+	}
+	return gh.Releases, "latest", true
+}
+
+// packOutdated reports whether rel is newer than the installed pack. Semantic
+// versions are compared when both sides have one; otherwise any change of
+// content counts.
+func packOutdated(m manifest.Manifest, rel pack.Release) bool {
+	if m.Digest != "" && m.Digest == rel.Digest {
+		return false
+	}
+	installed, err1 := semver.Parse(m.Version)
+	avail, err2 := semver.Parse(strings.TrimPrefix(rel.Tag, "v"))
+	if err1 == nil && err2 == nil {
+		return avail.GT(installed)
+	}
+	return true
+}
+
+// updateCheckTimeout bounds the whole check `codo status` makes, however
+// many requests pinning the release takes.
+const updateCheckTimeout = 3 * time.Second
+
+// checkOutdated is the quick check `codo status` runs; it gives up after
+// updateCheckTimeout and stays quiet on errors.
+func checkOutdated(m manifest.Manifest) (pack.Release, bool) {
+	rs, channel, ok := releaseChannel(m)
+	if !ok {
+		return pack.Release{}, false
+	}
+	rs.Client = &http.Client{Timeout: updateCheckTimeout}
+	type pinned struct {
+		rel pack.Release
+		err error
+	}
+	done := make(chan pinned, 1)
+	go func() {
+		rel, err := rs.Pin(channel)
+		done <- pinned{rel, err}
+	}()
+	select {
+	case p := <-done:
+		if p.err != nil {
+			return pack.Release{}, false
+		}
+		return p.rel, packOutdated(m, p.rel)
+	case <-time.After(updateCheckTimeout):
+		return pack.Release{}, false
+	}
+}
@@ -0,0 +1,313 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/signature"
+)
+
+// resolvedPack is a pack ready to install from.
+type resolvedPack struct {
+	FS      fs.FS
+	Source  string // spec of the source it came from, recorded in the manifest
+	Version string // concrete version from pack.json, recorded in the manifest
+	Release pack.Release
+	// Overlays are the organization and team packs layered on top, in
+	// order; Name is an overlay's layer name.
+	Overlays []resolvedPack
+	Name     string
+}
+
+// Layers returns the packs to compose, upstream first.
+func (p resolvedPack) Layers() pack.Layers {
+	if len(p.Overlays) == 0 {
+		return pack.Layers{{FS: p.FS}}
+	}
+	ls := pack.Layers{{Name: "upstream", FS: p.FS}}
+	for _, o := range p.Overlays {
+		ls = append(ls, pack.Layer{Name: o.Name, FS: o.FS})
+	}
+	return ls
+}
+
+// stamp records where the pack and its overlays came from in m.
+func (p resolvedPack) stamp(m *manifest.Manifest) {
+	m.Version = p.Version
+	m.Source = p.Source
+	m.Tag = p.Release.Tag
+	m.Digest = p.Release.Digest
+	m.URL = p.Release.URL
+	m.Layers = nil
+	for _, o := range p.Overlays {
+		m.Layers = append(m.Layers, o.pin())
+	}
+}
+
+// pin describes p as a manifest layer.
+func (p resolvedPack) pin() manifest.Layer {
+	return manifest.Layer{Name: p.Name, Source: p.Source, Version: p.Version, Tag: p.Release.Tag, Digest: p.Release.Digest, URL: p.Release.URL}
+}
+
+// CODO_RELEASES_URL points the upstream release source at a mirror or a
+// local stand-in server.
+func init() {
+	if u := os.Getenv("CODO_RELEASES_URL"); u != "" {
+		pack.DefaultReleases.BaseURL = strings.TrimSuffix(u, "/")
+	}
+}
+
+// releaseSource is where upstream packs are downloaded from.
+func releaseSource() pack.ReleaseSource {
+	return pack.DefaultReleases
+}
+
+// resolvePack opens the pack to install from and checks this CLI can install
+// it. An explicit --from spec is used as given, with version selecting the
+// release of a github: source. Without one, resolution order is:
+// 1. The pack directory named by CODO_PACK_DIR (for pack development)
+// 2. Upstream release (unless offline)
+// 3. The newest verified upstream pack in the cache (when unreachable)
+// 4. Embedded base pack (fallback)
+func resolvePack(from, version string, offline bool) (resolvedPack, error) {
+	if from != "" {
+		src, err := pack.ParseSource(from)
+		if err != nil {
+			return resolvedPack{}, err
+		}
+		gh, ok := src.(*pack.GitHubSource)
+		if ok && version != "" {
+			gh.Tag = version
+		}
+		fmt.Printf("Using pack from %s\n", src.Spec())
+		p, err := openPack(src)
+		if err != nil && ok {
+			warnNoKeys(err)
+			if c, cerr := cachedRelease(gh, err); cerr == nil {
+				return c, nil
+			}
+		}
+		return p, err
+	}
+	if dir := os.Getenv("CODO_PACK_DIR"); dir != "" {
+		fmt.Printf("Using pack directory %s (CODO_PACK_DIR)\n", dir)
+		return openPack(pack.DirSource{Path: dir})
+	}
+	if offline {
+		fmt.Println("Using embedded base pack (offline mode)")
+		return openPack(pack.EmbeddedSource{})
+	}
+
+	// Try to download pack from GitHub
+	src := pack.NewGitHubSource(pack.DefaultRepo, version)
+	fmt.Printf("Downloading pack version: %s...\n", src.Tag)
+	p, err := openPack(src)
+	if err != nil {
+		warnNoKeys(err)
+		if c, cerr := cachedRelease(src, err); cerr == nil {
+			return c, nil
+		}
+		// Fall back to embedded base
+		fmt.Printf("Download failed (%v), using embedded base pack\n", err)
+		return openPack(pack.EmbeddedSource{})
+	}
+	return p, nil
+}
+
+// warnNoKeys says so when err is a download refused because this codo trusts
+// no signing key, which no retry fixes, before resolvePack falls back.
+func warnNoKeys(err error) {
+	if errors.Is(err, signature.ErrNoKeys) {
+		fmt.Fprintln(os.Stderr, "warning: this codo has no release signing key built in, so no release can be verified; add the key to the trusted keys file")
+	}
+}
+
+// cachedRelease falls back to the newest verified pack cached from src's
+// releases when they cannot be reached.
+func cachedRelease(src *pack.GitHubSource, cause error) (resolvedPack, error) {
+	rel, err := src.Releases.NewestCached(src.Tag)
+	if err != nil {
+		return resolvedPack{}, err
+	}
+	fmt.Printf("Download failed (%v), using cached pack %s (sha256 %s)\n", cause, rel.Tag, short(rel.Digest))
+	return checkPack(os.DirFS(rel.Dir), src.Spec(), rel)
+}
+
+// openPack fetches src and checks its metadata.
+func openPack(src pack.Source) (resolvedPack, error) {
+	rootFS, rel, err := src.Open()
+	if err != nil {
+		return resolvedPack{}, fmt.Errorf("open pack %s: %w", src.Spec(), err)
+	}
+	switch {
+	case rel.Tag != "" && rel.Digest != "":
+		fmt.Printf("Using pack %s (sha256 %s)\n", rel.Tag, short(rel.Digest))
+	case rel.Tag != "":
+		fmt.Printf("Using pack at %s\n", rel.Tag)
+	case rel.Digest != "":
+		fmt.Printf("Using pack sha256 %s\n", short(rel.Digest))
+	}
+	return checkPack(rootFS, src.Spec(), rel)
+}
+
+// manifestSource returns the source spec recorded in m. Older manifests
+// stored a release tag, "local" or "embedded-base" instead of a spec.
+func manifestSource(m manifest.Manifest) string {
+	switch s := m.PackSource(); {
+	case s == "embedded" || s == "embedded-base":
+		return "embedded"
+	case s == "local":
+		return "dir:pack"
+	case strings.Contains(s, ":"):
+		return s
+	default:
+		return "github:" + pack.DefaultRepo + "@" + s
+	}
+}
+
+// updateSource is the spec update goes back to: an explicitly chosen source,
+// or "" for installs from upstream (or its embedded fallback), which follow
+// the default resolution again.
+func updateSource(m manifest.Manifest) string {
+	if m.PackSource() == "local" {
+		return "" // picked up implicitly by older versions
+	}
+	spec := manifestSource(m)
+	if spec == "embedded" || strings.HasPrefix(spec, "github:"+pack.DefaultRepo+"@") {
+		return ""
+	}
+	return spec
+}
+
+// installedPack resolves the pack a manifest was installed from, with its
+// layers, so commands that adjust an install (snippets, stack) do not pull in
+// a different version.
+func installedPack(m manifest.Manifest) (resolvedPack, error) {
+	p, err := pinnedPack(manifest.Layer{Source: manifestSource(m), Version: m.Version, Tag: m.Tag, Digest: m.Digest, URL: m.URL})
+	if err != nil {
+		return resolvedPack{}, err
+	}
+	for _, l := range m.Layers {
+		o, err := pinnedPack(l)
+		if err != nil {
+			return resolvedPack{}, fmt.Errorf("layer %s: %w", l.Name, err)
+		}
+		p.Overlays = append(p.Overlays, o)
+	}
+	return p, nil
+}
+
+// pinnedPack opens the exact pack pin describes: from the cache by digest,
+// else the pinned release or commit rather than what the moving ref points
+// at now.
+func pinnedPack(pin manifest.Layer) (resolvedPack, error) {
+	if pin.Digest != "" {
+		if dir, err := pack.Cached(pin.Digest); err == nil {
+			p, err := checkPack(os.DirFS(dir), pin.Source, pack.Release{Tag: pin.Tag, Digest: pin.Digest, URL: pin.URL, Dir: dir})
+			p.Name = pin.Name
+			return p, err
+		}
+	}
+	src, err := pack.ParseSource(pin.Source)
+	if err != nil {
+		return resolvedPack{}, err
+	}
+	switch s := src.(type) {
+	case *pack.GitHubSource:
+		if pin.Tag != "" {
+			s.Tag = pin.Tag
+		} else if _, err := semver.Parse(pin.Version); err == nil && s.Tag == "latest" {
+			s.Tag = "v" + pin.Version
+		}
+	case pack.GitSource:
+		if pin.Tag != "" {
+			s.Ref = pin.Tag
+			src = s
+		}
+	}
+	p, err := openPack(src)
+	p.Source = pin.Source
+	p.Name = pin.Name
+	return p, err
+}
+
+// parseLayers parses --layer values of the form <name>=<spec>.
+func parseLayers(values []string) ([]manifest.Layer, error) {
+	var out []manifest.Layer
+	for _, v := range values {
+		name, spec, ok := strings.Cut(v, "=")
+		if !ok || name == "" || spec == "" {
+			return nil, fmt.Errorf("invalid layer %q (want <name>=<source>, e.g. org=git+https://host/pack@main)", v)
+		}
+		if name == "upstream" || strings.ContainsAny(name, "+/ ") {
+			return nil, fmt.Errorf("invalid layer name %q", name)
+		}
+		if slices.ContainsFunc(out, func(l manifest.Layer) bool { return l.Name == name }) {
+			return nil, fmt.Errorf("layer %q given twice", name)
+		}
+		out = append(out, manifest.Layer{Name: name, Source: spec})
+	}
+	return out, nil
+}
+
+// openLayers fetches the overlays in order and adds them to p. Moving refs
+// are followed, as for the upstream pack.
+func openLayers(p *resolvedPack, layers []manifest.Layer) error {
+	for _, l := range layers {
+		src, err := pack.ParseSource(l.Source)
+		if err != nil {
+			return fmt.Errorf("layer %s: %w", l.Name, err)
+		}
+		fmt.Printf("Using %s layer from %s\n", l.Name, src.Spec())
+		o, err := openPack(src)
+		if err != nil {
+			return fmt.Errorf("layer %s: %w", l.Name, err)
+		}
+		o.Name = l.Name
+		p.Overlays = append(p.Overlays, o)
+	}
+	return nil
+}
+
+// short abbreviates a digest for display.
+func short(digest string) string {
+	if len(digest) > 12 {
+		return digest[:12]
+	}
+	return digest
+}
+
+// checkPack reads the pack's metadata, refuses packs this CLI is too old for
+// or that do not say their version, and works out the version to record. The
+// embedded pack is built from the same tag as a released CLI, so it takes the
+// CLI's version. Packs published before pack.json existed take their tag.
+func checkPack(rootFS fs.FS, spec string, rel pack.Release) (resolvedPack, error) {
+	meta, err := pack.ReadMeta(rootFS)
+	if err != nil {
+		return resolvedPack{}, err
+	}
+	if err := meta.Check(version); err != nil {
+		return resolvedPack{}, err
+	}
+	v := meta.Version
+	switch {
+	case spec == "embedded" && version != "dev":
+		v = strings.TrimPrefix(version, "v")
+	case v != "":
+	case pack.HasMeta(rootFS):
+		return resolvedPack{}, fmt.Errorf("pack.json of %s has no \"version\"; add one (e.g. \"1.0.0\")", spec)
+	case rel.Tag != "" && rel.Digest != "":
+		v = strings.TrimPrefix(rel.Tag, "v")
+	default:
+		v = "unversioned"
+	}
+	return resolvedPack{FS: rootFS, Source: spec, Version: v, Release: rel}, nil
+}
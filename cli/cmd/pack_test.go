@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestInitWithoutSigningKeyFallsBackToEmbedded(t *testing.T) {
+	newRepo(t)
+	embedPack(t, writePack(t, "1.0.0", map[string]string{"commands/ship.md": "ship\n"}))
+	zip := []byte("a release this codo has no key to verify")
+	assets := map[string][]byte{
+		"/download/v2.0.0/dotclaude-pack.zip":         zip,
+		"/download/v2.0.0/dotclaude-pack.zip.minisig": []byte("signature"),
+		"/download/v2.0.0/dotclaude-pack.sha256":      []byte(fmt.Sprintf("%x  dotclaude-pack.zip\n", sha256.Sum256(zip))),
+	}
+	releasesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/latest" {
+			http.Redirect(w, r, "/tag/v2.0.0", http.StatusFound)
+			return
+		}
+		b, ok := assets[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(b)
+	})
+
+	mustCodo(t, "init", "--no-tui", "--stacks", "")
+	if m := installed(t); m.Version != "1.0.0" {
+		t.Fatalf("installed %s, want the embedded 1.0.0", m.Version)
+	}
+	if got := readFile(t, ".claude/commands/ship.md"); got != "ship\n" {
+		t.Fatalf("ship.md = %q", got)
+	}
+}
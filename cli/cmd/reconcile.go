@@ -0,0 +1,402 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/fsops"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/journal"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/merge"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+)
+
+// reconciler brings an install in line with a new file set: clean files are
+// overwritten or removed, local edits are three-way merged, files new to the
+// install are copied safely and settings are deep-merged. update uses it to
+// move to a new pack and `stack add|remove` to change the selection.
+type reconciler struct {
+	labels  merge.Labels
+	oldSel  pack.Selection // selection the manifest was installed with
+	dry     bool
+	markers bool // write conflict markers in place instead of <file>.codo.new
+	// backup, when set, receives a copy of every file the run replaces or
+	// removes, so the previous install can be restored by rollback.
+	backup string
+
+	merged, saved int
+	conflicts     []string // paths left with a conflict to resolve
+}
+
+// run reconciles the files recorded in m with files and returns the new
+// manifest entries, sorted by path.
+func (r *reconciler) run(m manifest.Manifest, files []pack.File) ([]manifest.Entry, error) {
+	// Build map of new contents
+	newMap := map[string][]byte{}
+	byPath := map[string]pack.File{}
+	for _, f := range files {
+		byPath[f.RelPath] = f
+		b, err := f.Read()
+		if err == nil {
+			newMap[f.RelPath] = b
+		}
+	}
+
+	// Track which files exist in the old manifest
+	oldSet := map[string]manifest.Entry{}
+	for _, ent := range m.Files {
+		oldSet[ent.Path] = ent
+	}
+
+	var entries []manifest.Entry
+
+	// Process each file from the old manifest
+	for _, ent := range m.Files {
+		dst := ent.Path
+		nb, ok := newMap[dst]
+		switch f := byPath[dst]; f.Policy {
+		case pack.PolicyMergeJSON:
+			ent, err := r.mergeJSON(f, ent)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, ent)
+			continue
+		case pack.PolicySeedOnce, pack.PolicyNeverOverwrite:
+			// Only recreate a missing never-overwrite file; seeded files
+			// belong to the project.
+			if f.Policy == pack.PolicyNeverOverwrite && fileHash(dst) == "" {
+				if _, err := fsops.Seed(f, ".", r.dry); err != nil {
+					return nil, err
+				}
+			}
+			entries = append(entries, manifest.Entry{Path: dst, SHA256: fileHash(dst), Policy: f.Policy})
+			continue
+		}
+		if !ok && ent.Policy == pack.PolicySeedOnce {
+			fmt.Println("= " + dst + " (seeded, kept)")
+			continue
+		}
+		if !ok {
+			// File no longer provided - handle safely
+			if ent.Owned != nil {
+				// Settings dropped: take back only codo's keys and rules
+				if err := r.save(dst); err != nil {
+					return nil, err
+				}
+				kept, err := fsops.StripJSON(dst, ent.Owned, r.dry)
+				if err != nil {
+					if os.IsNotExist(err) {
+						continue
+					}
+					return nil, err
+				}
+				fmt.Println("- " + dst + " (codo settings)")
+				if !kept && !r.dry {
+					if err := journal.Remove(dst); err != nil {
+						return nil, err
+					}
+				}
+				continue
+			}
+			if ent.Unmanaged {
+				fmt.Println("~ skip unmanaged " + dst)
+				if !r.dry {
+					_ = journal.Remove(dst + ".codo.new")
+				}
+				continue
+			}
+			cur, err := os.ReadFile(dst)
+			if err != nil {
+				// File already gone, nothing to do
+				continue
+			}
+			curHash := fmt.Sprintf("%x", sha256.Sum256(cur))
+			if curHash == ent.SHA256 {
+				// File is clean (unmodified) - safe to remove
+				fmt.Println("- " + dst)
+				if err := r.save(dst); err != nil {
+					return nil, err
+				}
+				if !r.dry {
+					if err := journal.Remove(dst); err != nil {
+						return nil, err
+					}
+				}
+			} else {
+				// File has local modifications - keep it and notify user
+				note := dst + ".codo.removed.suggested"
+				fmt.Println("! modified & removed upstream → " + note)
+				if !r.dry {
+					msg := []byte("Upstream removed this file, but you have local changes.\nConsider removing it manually if no longer needed.\n")
+					if err := journal.WriteFile(note, msg, 0o644); err != nil {
+						return nil, err
+					}
+				}
+			}
+			continue
+		}
+
+		// File still provided - check if it needs updating
+		newHash := fmt.Sprintf("%x", sha256.Sum256(nb))
+		upstream := manifest.Entry{Path: dst, SHA256: newHash}
+		cur, err := os.ReadFile(dst)
+		if err != nil {
+			// Missing → treat as clean overwrite
+			fmt.Println("+ " + dst)
+			if err := r.write(dst, nb); err != nil {
+				return nil, err
+			}
+			entries = append(entries, upstream)
+			continue
+		}
+		curHash := fmt.Sprintf("%x", sha256.Sum256(cur))
+		if ent.Unmanaged {
+			if curHash == newHash {
+				fmt.Println("= " + dst)
+				entries = append(entries, upstream)
+			} else {
+				// No common ancestor: the file predates codo.
+				out := dst + ".codo.new"
+				fmt.Println("! conflict → " + out)
+				if !r.dry {
+					if err := journal.WriteFile(out, nb, 0o644); err != nil {
+						return nil, err
+					}
+				}
+				r.conflicts = append(r.conflicts, dst)
+				entries = append(entries, manifest.Entry{Path: dst, SHA256: curHash, Unmanaged: true})
+			}
+			continue
+		}
+		if curHash == ent.SHA256 {
+			// clean → overwrite
+			if curHash == newHash {
+				fmt.Println("= " + dst)
+			} else {
+				fmt.Println("~ " + dst)
+			}
+			if err := r.write(dst, nb); err != nil {
+				return nil, err
+			}
+			entries = append(entries, upstream)
+			continue
+		}
+		if merge.HasConflictMarkers(cur) {
+			// A conflict written in place by --markers: merging again would
+			// nest markers, so wait until the user has resolved it.
+			fmt.Println("! unresolved conflict markers in " + dst + " (resolve them, then update again)")
+			r.conflicts = append(r.conflicts, dst)
+			entries = append(entries, ent)
+			continue
+		}
+		if newHash == ent.SHA256 {
+			// upstream unchanged → keep local edits as they are
+			fmt.Println("= " + dst + " (local changes kept)")
+			entries = append(entries, ent)
+			continue
+		}
+
+		// diverged → three-way merge against the installed base
+		base, err := installedBase(ent, r.oldSel)
+		if err != nil {
+			out := dst + ".codo.new"
+			fmt.Println("! conflict (no base) → " + out)
+			if !r.dry {
+				if err := journal.WriteFile(out, nb, 0o644); err != nil {
+					return nil, err
+				}
+			}
+			r.conflicts = append(r.conflicts, dst)
+			entries = append(entries, ent)
+			continue
+		}
+		res := merge.ThreeWay(base, cur, nb, r.labels)
+		if res.Conflicts == 0 {
+			fmt.Println("~ merged " + dst)
+			if err := r.write(dst, res.Data); err != nil {
+				return nil, err
+			}
+			r.merged++
+			// Record the upstream hash so local edits keep showing as drift
+			// and are merged again on the next update.
+			entries = append(entries, upstream)
+			continue
+		}
+		out := dst + ".codo.new"
+		if r.markers {
+			out = dst
+		}
+		fmt.Printf("! conflict (%d hunks) → %s\n", res.Conflicts, out)
+		if r.markers {
+			if err := r.save(dst); err != nil {
+				return nil, err
+			}
+		}
+		if !r.dry {
+			if err := journal.WriteFile(out, res.Data, 0o644); err != nil {
+				return nil, err
+			}
+		}
+		r.conflicts = append(r.conflicts, dst)
+		if r.markers {
+			// The file now carries the upstream side: once resolved it is
+			// merged against the new version, not the one it replaced.
+			entries = append(entries, upstream)
+			continue
+		}
+		// Keep the old base until the conflict is resolved.
+		entries = append(entries, ent)
+	}
+
+	// Add any new files that weren't in the old manifest. A file the user
+	// already has is left alone and the pack version goes to .codo.new.
+	for _, f := range files {
+		if _, exists := oldSet[f.RelPath]; exists {
+			continue
+		}
+		switch f.Policy {
+		case pack.PolicyMergeJSON:
+			ent, err := r.mergeJSON(f, manifest.Entry{Path: f.RelPath})
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, ent)
+			continue
+		case pack.PolicySeedOnce, pack.PolicyNeverOverwrite:
+			if _, err := fsops.Seed(f, ".", r.dry); err != nil {
+				return nil, err
+			}
+			entries = append(entries, manifest.Entry{Path: f.RelPath, SHA256: fileHash(f.RelPath), Policy: f.Policy})
+			continue
+		}
+		content, ok := newMap[f.RelPath]
+		if !ok {
+			continue
+		}
+		managed, err := fsops.CopySafe(f, ".", r.dry)
+		if err != nil {
+			return nil, err
+		}
+		if !managed {
+			r.conflicts = append(r.conflicts, f.RelPath)
+			entries = append(entries, manifest.Entry{Path: f.RelPath, SHA256: fileHash(f.RelPath), Unmanaged: true})
+			continue
+		}
+		entries = append(entries, manifest.Entry{Path: f.RelPath, SHA256: fmt.Sprintf("%x", sha256.Sum256(content))})
+	}
+	if !r.dry {
+		if err := fsops.ChmodHooks(); err != nil {
+			return nil, err
+		}
+		for _, ent := range entries {
+			if nb, ok := newMap[ent.Path]; ok && !ent.Unmanaged && ent.Owned == nil && fmt.Sprintf("%x", sha256.Sum256(nb)) == ent.SHA256 {
+				if err := manifest.SaveBase(nb); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	for i := range entries {
+		entries[i].Layer = byPath[entries[i].Path].Layer
+		entries[i].Stack = byPath[entries[i].Path].Stack
+	}
+	slices.SortFunc(entries, func(a, b manifest.Entry) int { return strings.Compare(a.Path, b.Path) })
+	return entries, nil
+}
+
+// write writes b to dst unless running dry, saving the copy it replaces.
+func (r *reconciler) write(dst string, b []byte) error {
+	if r.dry {
+		return nil
+	}
+	if cur, err := os.ReadFile(dst); err == nil && !bytes.Equal(cur, b) {
+		if err := r.saveBytes(dst, cur); err != nil {
+			return err
+		}
+	}
+	return journal.WriteFile(dst, b, 0o644)
+}
+
+// save copies dst into the backup before it is changed or removed.
+func (r *reconciler) save(dst string) error {
+	b, err := os.ReadFile(dst)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return r.saveBytes(dst, b)
+}
+
+func (r *reconciler) saveBytes(dst string, b []byte) error {
+	if r.backup == "" || r.dry {
+		return nil
+	}
+	r.saved++
+	return journal.WriteFile(filepath.Join(r.backup, dst), b, 0o644)
+}
+
+// mergeJSON deep-merges a settings file against the contribution recorded in
+// ent. Installs predating ownership tracking use the installed base instead.
+func (r *reconciler) mergeJSON(f pack.File, ent manifest.Entry) (manifest.Entry, error) {
+	owned := []byte(ent.Owned)
+	if owned == nil && ent.SHA256 != "" && !ent.Unmanaged {
+		if b, err := installedBase(ent, r.oldSel); err == nil {
+			owned = b
+		}
+	}
+	cur, _ := os.ReadFile(f.RelPath)
+	contrib, out, err := fsops.MergeJSON(f, ".", owned, r.dry)
+	if err != nil {
+		return manifest.Entry{}, err
+	}
+	if cur != nil && !bytes.Equal(cur, out) {
+		if err := r.saveBytes(f.RelPath, cur); err != nil {
+			return manifest.Entry{}, err
+		}
+	}
+	return manifest.Entry{Path: f.RelPath, SHA256: fmt.Sprintf("%x", sha256.Sum256(out)), Owned: contrib}, nil
+}
+
+// installedBase returns the pack content that ent was installed from. Bases
+// are stored at install time; older installs fall back to the embedded pack
+// when it ships a file with the recorded hash.
+func installedBase(ent manifest.Entry, sel pack.Selection) ([]byte, error) {
+	if b, err := manifest.LoadBase(ent.SHA256); err == nil {
+		return b, nil
+	}
+	rootFS, err := pack.GetEmbeddedBaseFS()
+	if err != nil {
+		return nil, err
+	}
+	files, err := pack.FilesFromDotclaudeFS(rootFS, sel)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if f.RelPath != ent.Path {
+			continue
+		}
+		b, err := f.Read()
+		if err == nil && fmt.Sprintf("%x", sha256.Sum256(b)) == ent.SHA256 {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("base for %s not found", ent.Path)
+}
+
+// fileHash returns the sha256 of the file at path, or "" if it can't be read.
+func fileHash(path string) string {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(b))
+}
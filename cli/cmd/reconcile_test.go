@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestUpdateWithMarkersWaitsForResolution(t *testing.T) {
+	newRepo(t)
+	const path = ".claude/commands/ship.md"
+	pack := func(v, ship string) string {
+		return writePack(t, v, map[string]string{"commands/ship.md": ship})
+	}
+	mustCodo(t, "init", "--no-tui", "--stacks", "", "--from", pack("1.0.0", "one\ntwo\nthree\n"))
+	if err := os.WriteFile(path, []byte("one\nmine\nthree\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	mustCodo(t, "update", "--markers", "--from", pack("2.0.0", "one\ntheirs\nthree\n"))
+	conflicted := readFile(t, path)
+	if strings.Count(conflicted, "<<<<<<< ") != 1 {
+		t.Fatalf("update --markers wrote:\n%s", conflicted)
+	}
+
+	v3 := pack("3.0.0", "one\ntheirs\nthree\nfour\n")
+	mustCodo(t, "update", "--markers", "--from", v3)
+	if got := readFile(t, path); got != conflicted {
+		t.Fatalf("update merged into unresolved markers:\n%s", got)
+	}
+
+	// Once resolved, the file merges against the version it was resolved with.
+	if err := os.WriteFile(path, []byte("one\nresolved\nthree\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	mustCodo(t, "update", "--markers", "--from", v3)
+	if got := readFile(t, path); got != "one\nresolved\nthree\nfour\n" {
+		t.Fatalf("after resolving, update wrote:\n%s", got)
+	}
+}
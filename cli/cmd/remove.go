@@ -2,13 +2,13 @@ package cmd
 
 import (
 	"fmt"
-	"io"
 	"os"
 	"path/filepath"
-	"time"
 
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/fsops"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/journal"
 	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
-	"github.com/hergert/codo-agentic-toolkit/cli/internal/statepath"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
 	"github.com/spf13/cobra"
 )
 
@@ -27,43 +27,66 @@ var removeCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		ts := time.Now().UTC().Format("20060102-150405")
 		var backup string
 		if !removeDry {
-			dir, err := statepath.BackupDir(root, ts)
-			if err != nil {
+			if backup, err = newBackupDir(root); err != nil {
 				return err
 			}
-			if err := os.MkdirAll(dir, 0o755); err != nil {
-				return err
-			}
-			backup = dir
 		}
 		for _, ent := range m.Files {
 			if ent.Unmanaged {
 				fmt.Println("~ skip unmanaged " + ent.Path)
 				if !removeDry {
-					_ = os.Remove(ent.Path + ".codo.new")
+					removeIfExists(ent.Path + ".codo.new")
+				}
+				continue
+			}
+			if ent.Policy == pack.PolicySeedOnce {
+				fmt.Println("~ keep seeded " + ent.Path)
+				continue
+			}
+			if h := fileHash(ent.Path); ent.Policy == pack.PolicyNeverOverwrite && h != "" && h != ent.SHA256 {
+				fmt.Println("~ keep edited " + ent.Path + " (" + string(ent.Policy) + ")")
+				continue
+			}
+			if ent.Owned != nil {
+				if err := removeOwnedJSON(ent, backup); err != nil {
+					return err
 				}
 				continue
 			}
 			if _, err := os.Stat(ent.Path); err == nil {
 				fmt.Println("- " + ent.Path)
 				if !removeDry {
-					dest := filepath.Join(backup, ent.Path)
-					if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
-						return err
-					}
-					if err := moveWithCopyFallback(ent.Path, dest); err != nil {
+					if err := journal.Rename(ent.Path, filepath.Join(backup, ent.Path)); err != nil {
 						return err
 					}
-					_ = os.Remove(ent.Path + ".codo.new")
+					removeIfExists(ent.Path + ".codo.new")
 				}
 			}
 		}
 		if !removeDry {
+			if err := manifest.SaveSnapshot(backup, m); err != nil {
+				return err
+			}
 			manifest.Remove()
+			var kept manifest.Manifest
+			for _, ent := range m.Files {
+				if h := fileHash(ent.Path); h != "" {
+					if ent.Owned != nil {
+						ent.SHA256 = h // codo's settings taken out, the user's kept
+					}
+					kept.Files = append(kept.Files, ent)
+				}
+			}
+			if err := recordHistory("remove", m, kept, nil); err != nil {
+				return err
+			}
 			fmt.Println("Backup at", backup)
+			fmt.Println("Run `codo restore` to bring it back")
+			if manifest.LockExists() {
+				fmt.Println("Kept " + manifest.LockPath + ", which pins the toolkit for everyone; delete it too if this repository no longer uses codo")
+			}
 		} else {
 			fmt.Println("(dry-run) Removal would back up files outside the repo")
 		}
@@ -75,30 +98,39 @@ func init() {
 	removeCmd.Flags().BoolVar(&removeDry, "dry-run", false, "Preview only; do not write files")
 }
 
-func moveWithCopyFallback(src, dst string) error {
-	if err := os.Rename(src, dst); err == nil {
-		return nil
-	} else if !os.IsNotExist(err) {
-		sin, serr := os.Open(src)
-		if serr != nil {
-			return serr
+// removeOwnedJSON backs up a settings file, then takes back only the keys and
+// rules codo contributed. The file is deleted when nothing of the user's is left.
+func removeOwnedJSON(ent manifest.Entry, backup string) error {
+	cur, err := os.ReadFile(ent.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
 		}
-		defer sin.Close()
-		out, oerr := os.Create(dst)
-		if oerr != nil {
-			return oerr
-		}
-		if _, cerr := io.Copy(out, sin); cerr != nil {
-			out.Close()
-			return cerr
-		}
-		if cerr := out.Close(); cerr != nil {
-			return cerr
-		}
-		if rerr := os.Remove(src); rerr != nil {
-			return rerr
+		return err
+	}
+	if !removeDry {
+		if err := journal.WriteFile(filepath.Join(backup, ent.Path), cur, 0o644); err != nil {
+			return err
 		}
+	}
+	kept, err := fsops.StripJSON(ent.Path, ent.Owned, removeDry)
+	if err != nil {
+		return err
+	}
+	if kept {
+		fmt.Println("~ " + ent.Path + " (codo settings removed, yours kept)")
+		return nil
+	}
+	fmt.Println("- " + ent.Path)
+	if removeDry {
 		return nil
 	}
-	return nil
+	return journal.Remove(ent.Path)
+}
+
+// removeIfExists deletes a leftover file such as a .codo.new proposal.
+func removeIfExists(path string) {
+	if _, err := os.Stat(path); err == nil {
+		_ = journal.Remove(path)
+	}
 }
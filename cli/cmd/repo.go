@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/statepath"
+)
+
+// outsideRepo marks commands that do not work on a repository; they stay in
+// the directory they were run from, where their path arguments are relative.
+const outsideRepo = "outside-repo"
+
+func init() {
+	for _, c := range []*cobra.Command{cacheListCmd, cachePruneCmd, cacheExportCmd, cacheImportCmd, upgradeCmd, completionCmd} {
+		if c.Annotations == nil {
+			c.Annotations = map[string]string{}
+		}
+		c.Annotations[outsideRepo] = "true"
+	}
+}
+
+// enterRepo moves to the top of the enclosing git work tree, so codo works
+// the same from any subdirectory. Commands that change the repository give
+// it an identity if it has none and move an install recorded the way older
+// versions did to it; the others only read.
+func enterRepo(cmd *cobra.Command) error {
+	if cmd.Annotations[outsideRepo] != "" {
+		return nil
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	root, err := statepath.RepoRoot(wd)
+	if err != nil {
+		return err
+	}
+	if root != wd {
+		initFrom = rebase(initFrom, wd, root)
+		updateFrom = rebase(updateFrom, wd, root)
+		for i, l := range initLayers {
+			initLayers[i] = rebaseLayer(l, wd, root)
+		}
+		for i, l := range updateLayers {
+			updateLayers[i] = rebaseLayer(l, wd, root)
+		}
+		if err := os.Chdir(root); err != nil {
+			return err
+		}
+	}
+	if !changesRepo(cmd) {
+		return nil
+	}
+	statepath.EnsureRepoID(root)
+	return manifest.Migrate()
+}
+
+// rebase rewrites the path of a dir: or zip: source spec given relative to
+// wd so it means the same relative to root.
+func rebase(spec, wd, root string) string {
+	for _, prefix := range []string{"dir:", "zip:"} {
+		path, ok := strings.CutPrefix(spec, prefix)
+		if !ok || filepath.IsAbs(path) {
+			continue
+		}
+		if rel, err := filepath.Rel(root, filepath.Join(wd, path)); err == nil {
+			return prefix + rel
+		}
+	}
+	return spec
+}
+
+// rebaseLayer rebases the source of a <name>=<source> layer flag.
+func rebaseLayer(layer, wd, root string) string {
+	name, spec, ok := strings.Cut(layer, "=")
+	if !ok {
+		return layer
+	}
+	return name + "=" + rebase(spec, wd, root)
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/statepath"
+)
+
+func TestRepoIdentity(t *testing.T) {
+	root := newRepo(t)
+	git(t, root, "commit", "-q", "--allow-empty", "-m", "root")
+	id := filepath.Join(root, ".git", "codo-id")
+
+	mustCodo(t, "status")
+	if _, err := os.Stat(id); !os.IsNotExist(err) {
+		t.Fatalf("status wrote the repository's identity: %v", err)
+	}
+	mustCodo(t, "init", "--no-tui", "--stacks", "", "--from", writePack(t, "1.0.0", map[string]string{"commands/a.md": "a\n"}))
+	if _, err := os.Stat(id); err != nil {
+		t.Fatalf("init gave the repository no identity: %v", err)
+	}
+
+	// From a subdirectory codo works on the top of the work tree.
+	if err := os.MkdirAll(filepath.Join(root, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	chdir(t, filepath.Join(root, "sub"))
+	mustCodo(t, "status")
+	if wd, _ := os.Getwd(); wd != root || !manifest.Exists() {
+		t.Fatalf("status from sub ran in %s, installed %v", wd, manifest.Exists())
+	}
+
+	// A clone of the same repository is not the same install.
+	clone := filepath.Join(t.TempDir(), "clone")
+	git(t, root, "clone", "-q", root, clone)
+	chdir(t, clone)
+	mustCodo(t, "status")
+	if manifest.Exists() {
+		t.Fatal("a clone found the install of the repository it was cloned from")
+	}
+	mustCodo(t, "init", "--no-tui", "--stacks", "", "--from", writePack(t, "1.0.0", map[string]string{"commands/a.md": "a\n"}))
+	if a, b := readFile(t, id), readFile(t, filepath.Join(clone, ".git", "codo-id")); a == b {
+		t.Fatalf("clone has the identity %s of its origin", a)
+	}
+}
+
+func TestOnlyChangesMoveALegacyManifest(t *testing.T) {
+	root := newRepo(t)
+	src := writePack(t, "1.0.0", map[string]string{"commands/a.md": "a\n"})
+	mustCodo(t, "init", "--no-tui", "--stacks", "", "--from", src)
+	// Put the install where older versions kept it, in the repository.
+	path, err := statepath.ManifestPath(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacy := statepath.LegacyManifestPath(root)
+	if err := os.Rename(path, legacy); err != nil {
+		t.Fatal(err)
+	}
+
+	mustCodo(t, "status")
+	if _, err := os.Stat(legacy); err != nil {
+		t.Fatalf("status moved the committed manifest: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("status wrote the manifest to codo's config directory: %v", err)
+	}
+
+	mustCodo(t, "update", "--from", src)
+	if _, err := os.Stat(legacy); !os.IsNotExist(err) {
+		t.Fatalf("update left the manifest in the repository: %v", err)
+	}
+	if m := installed(t); m.Version != "1.0.0" || m.Migrated == nil || m.Migrated.From != legacy {
+		t.Fatalf("manifest after update = %+v", m)
+	}
+}
@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/fsops"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+)
+
+var restoreList bool
+var restoreDry bool
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore [<timestamp>]",
+	Short: "Bring back the toolkit from a removal backup",
+	Long: `Restore the files ` + "`codo remove`" + ` moved out of the repository and the manifest
+as it was at removal time, from the newest backup or the one named by its
+timestamp (see --list). A file recreated since is left alone when it differs
+from the backup, which is written next to it as <file>.codo.new; settings are
+merged back into the current file. A backup taken by an update or rollback
+holds only the files those replaced, and only they are restored and recorded
+in the manifest.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		root, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		all, err := backups(root)
+		if err != nil {
+			return err
+		}
+		if restoreList {
+			if len(all) == 0 {
+				fmt.Println("No backups for this repository")
+			}
+			for _, b := range all {
+				if b.Manifest == nil {
+					fmt.Printf("%-18s %-8s %s\n", b.Name, "-", "files only")
+					continue
+				}
+				fmt.Printf("%-18s %-8s %d files\n", b.Name, b.Manifest.Version, len(b.Manifest.Files))
+			}
+			return nil
+		}
+		abortIf(manifest.Exists(), "codo is installed here; `codo rollback` returns to an earlier install.")
+		if len(all) == 0 {
+			return errors.New("no backups for this repository")
+		}
+		b := all[0]
+		if len(args) == 1 {
+			found := false
+			for _, c := range all {
+				if c.Name == args[0] {
+					b, found = c, true
+				}
+			}
+			if !found {
+				return fmt.Errorf("no backup %s (see `codo restore --list`)", args[0])
+			}
+		}
+
+		if b.Manifest == nil {
+			// Removals by older versions kept no manifest: put the files
+			// back and let init adopt them.
+			restored, err := restoreFiles(b.Dir)
+			if err != nil {
+				return err
+			}
+			if !restoreDry {
+				var after manifest.Manifest
+				for _, path := range restored {
+					after.Files = append(after.Files, manifest.Entry{Path: path, SHA256: fileHash(path)})
+				}
+				if err := recordHistory("restore", manifest.Manifest{}, after, nil); err != nil {
+					return err
+				}
+			}
+			fmt.Printf("\nRestored files from %s. No manifest was saved with this backup; run `codo init` to adopt them.\n", b.Name)
+			return nil
+		}
+		// The backup's manifest describes the whole install, but a backup
+		// taken by an update or rollback holds only the files it replaced:
+		// only what is actually restored, or still in place, is recorded.
+		m := *b.Manifest
+		m.Files = nil
+		var conflicts []string
+		for _, ent := range b.Manifest.Files {
+			saved := filepath.Join(b.Dir, ent.Path)
+			switch {
+			case ent.Unmanaged, ent.Policy == pack.PolicySeedOnce:
+				// Left in place by remove.
+				if ent.SHA256 = fileHash(ent.Path); ent.SHA256 == "" {
+					continue
+				}
+			case ent.Owned != nil:
+				owned := ent.Owned
+				f := pack.File{RelPath: ent.Path, Policy: pack.PolicyMergeJSON, Read: func() ([]byte, error) { return owned, nil }}
+				if _, _, err := fsops.MergeJSON(f, ".", nil, restoreDry); err != nil {
+					return err
+				}
+				ent.SHA256 = fileHash(ent.Path)
+			default:
+				if _, err := os.Stat(saved); err != nil {
+					// Not in the backup: an edited never-overwrite copy
+					// remove left in place is still codo's, anything else
+					// is not restored.
+					if ent.Policy != pack.PolicyNeverOverwrite || fileHash(ent.Path) == "" {
+						continue
+					}
+					break
+				}
+				f := pack.File{RelPath: ent.Path, Read: func() ([]byte, error) { return os.ReadFile(saved) }}
+				managed, err := fsops.CopySafe(f, ".", restoreDry)
+				if err != nil {
+					return err
+				}
+				if !managed {
+					conflicts = append(conflicts, ent.Path)
+				}
+			}
+			m.Files = append(m.Files, ent)
+		}
+		if restoreDry {
+			return nil
+		}
+		if err := fsops.ChmodHooks(); err != nil {
+			return err
+		}
+		if err := manifest.Save(m); err != nil {
+			return err
+		}
+		if err := recordHistory("restore", manifest.Manifest{}, m, conflicts); err != nil {
+			return err
+		}
+		fmt.Printf("\nRestored codo %s from %s: %d conflicted\n", m.Version, b.Name, len(conflicts))
+		return nil
+	},
+}
+
+func init() {
+	restoreCmd.Flags().BoolVar(&restoreList, "list", false, "List this repository's backups, newest first")
+	restoreCmd.Flags().BoolVar(&restoreDry, "dry-run", false, "Preview only")
+	rootCmd.AddCommand(restoreCmd)
+}
+
+// restoreFiles copies every file of the backup dir back into the repository
+// and returns their paths.
+func restoreFiles(dir string) ([]string, error) {
+	var restored []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() == manifest.SnapshotFile || d.Name() == rollbackFile {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		f := pack.File{RelPath: filepath.ToSlash(rel), Read: func() ([]byte, error) { return os.ReadFile(path) }}
+		restored = append(restored, f.RelPath)
+		_, err = fsops.CopySafe(f, ".", restoreDry)
+		return err
+	})
+	return restored, err
+}
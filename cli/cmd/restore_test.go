@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestRestoreUpdateBackupRecordsOnlyRestoredFiles(t *testing.T) {
+	root := newRepo(t)
+	v1 := writePack(t, "1.0.0", map[string]string{"commands/a.md": "a 1\n", "commands/b.md": "b\n"})
+	v2 := writePack(t, "2.0.0", map[string]string{"commands/a.md": "a 2\n", "commands/b.md": "b\n"})
+	mustCodo(t, "init", "--no-tui", "--stacks", "", "--from", v1)
+	mustCodo(t, "update", "--from", v2)
+	mustCodo(t, "remove")
+
+	all, err := backups(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || all[1].Manifest == nil || all[1].Manifest.Version != "1.0.0" {
+		t.Fatalf("backups = %+v, want the removal and the update's", all)
+	}
+	mustCodo(t, "restore", all[1].Name)
+
+	var paths []string
+	for _, ent := range installed(t).Files {
+		paths = append(paths, ent.Path)
+	}
+	if want := []string{".claude/commands/a.md"}; !slices.Equal(paths, want) {
+		t.Fatalf("manifest records %v, want %v", paths, want)
+	}
+	if got := readFile(t, ".claude/commands/a.md"); got != "a 1\n" {
+		t.Fatalf("a.md = %q, want the 1.0.0 copy", got)
+	}
+	if _, err := os.Stat(".claude/commands/b.md"); !os.IsNotExist(err) {
+		t.Fatalf("b.md restored from a backup that does not hold it: %v", err)
+	}
+}
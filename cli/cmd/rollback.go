@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/journal"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/merge"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+)
+
+var rollbackTo string
+var rollbackDry bool
+var rollbackMarkers bool
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Return to the pack version installed before the last update",
+	Long: `Restore the toolkit files and manifest to an earlier install. Every update
+keeps the files it replaces or removes in a backup outside the repository;
+rollback reconciles the install back to the newest one (or the newest of
+--to <version>) with the same rules as update: clean files are restored,
+local edits made since are three-way merged and overlapping hunks become
+conflicts. Each rollback steps further back: the install a rollback leaves
+is backed up too, but only --to <version> returns to it.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		abortIf(!manifest.Exists(), "No manifest found. Run `codo init` first.")
+		m, err := manifest.Open()
+		if err != nil {
+			return err
+		}
+		root, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		target, err := rollbackTarget(root, rollbackTo)
+		if err != nil {
+			return err
+		}
+		old := *target.Manifest
+		files, err := snapshotFiles(target.Dir, old)
+		if err != nil {
+			return err
+		}
+
+		backup, err := newBackupDir(root)
+		if err != nil {
+			return err
+		}
+		r := &reconciler{
+			labels:  merge.Labels{Ours: "local", Theirs: "codo " + old.Version},
+			oldSel:  m.Selection(),
+			dry:     rollbackDry,
+			markers: rollbackMarkers,
+			backup:  backup,
+		}
+		entries, err := r.run(m, files)
+		if err != nil {
+			return err
+		}
+		if !rollbackDry {
+			out := old
+			out.Files = entries
+			if err := snapshot(backup, m, out, r); err != nil {
+				return err
+			}
+			if err := journal.WriteFile(filepath.Join(backup, rollbackFile), []byte(target.Name+"\n"), 0o644); err != nil {
+				return err
+			}
+			if err := manifest.Save(out); err != nil {
+				return err
+			}
+			if err := recordHistory("rollback", m, out, r.conflicts); err != nil {
+				return err
+			}
+		}
+		fmt.Printf("\nRolled back to %s (backup %s): %d merged, %d conflicted\n", old.Version, target.Name, r.merged, len(r.conflicts))
+		return nil
+	},
+}
+
+func init() {
+	rollbackCmd.Flags().StringVar(&rollbackTo, "to", "", "Pack version to return to (default: the install before the last update)")
+	rollbackCmd.Flags().BoolVar(&rollbackDry, "dry-run", false, "Preview only")
+	rollbackCmd.Flags().BoolVar(&rollbackMarkers, "markers", false, "Write conflict markers into the file instead of <file>.codo.new")
+	rootCmd.AddCommand(rollbackCmd)
+}
+
+// rollbackTarget returns the newest backup that recorded an install of
+// version. When version is empty it is the newest install not undone by a
+// rollback: the backup a rollback took and everything down to the backup it
+// returned to are passed over, so repeated rollbacks keep going back.
+func rollbackTarget(root, version string) (backup, error) {
+	all, err := backups(root)
+	if err != nil {
+		return backup{}, err
+	}
+	var seen []string
+	skipTo := ""
+	for _, b := range all {
+		if version == "" {
+			if skipTo != "" {
+				if b.Name == skipTo {
+					skipTo = ""
+				}
+				continue
+			}
+			if b.RolledBackTo != "" {
+				skipTo = b.RolledBackTo
+				continue
+			}
+		}
+		if b.Manifest == nil {
+			continue
+		}
+		if version == "" || b.Manifest.Version == strings.TrimPrefix(version, "v") || b.Manifest.Tag == version {
+			return b, nil
+		}
+		seen = append(seen, b.Manifest.Version)
+	}
+	if len(seen) == 0 {
+		return backup{}, errors.New("no earlier install of this repository is backed up; updates record one from now on")
+	}
+	return backup{}, fmt.Errorf("no backed-up install of version %s (have: %s)", version, strings.Join(seen, ", "))
+}
+
+// snapshotFiles rebuilds the file set of the install m recorded in the backup
+// dir. Pack content comes from the stored bases, falling back to the copy in
+// the backup; settings come from the recorded contribution.
+func snapshotFiles(dir string, m manifest.Manifest) ([]pack.File, error) {
+	var files []pack.File
+	for _, ent := range m.Files {
+		ent := ent
+		saved := filepath.Join(dir, ent.Path)
+		f := pack.File{RelPath: ent.Path, Policy: ent.Policy, Layer: ent.Layer}
+		switch {
+		case ent.Owned != nil:
+			f.Policy = pack.PolicyMergeJSON
+			f.Read = func() ([]byte, error) { return ent.Owned, nil }
+		case ent.Policy == pack.PolicySeedOnce || ent.Policy == pack.PolicyNeverOverwrite || ent.Unmanaged:
+			// The project's own copy: whatever was there then, or is now.
+			b, err := os.ReadFile(saved)
+			if err != nil {
+				if b, err = os.ReadFile(ent.Path); err != nil {
+					continue
+				}
+			}
+			f.Read = func() ([]byte, error) { return b, nil }
+		default:
+			b, err := manifest.LoadBase(ent.SHA256)
+			if err != nil {
+				if b, err = os.ReadFile(saved); err != nil {
+					return nil, fmt.Errorf("cannot restore %s: its %s content is no longer stored", ent.Path, m.Version)
+				}
+			}
+			f.Read = func() ([]byte, error) { return b, nil }
+		}
+		if f.Policy == "" {
+			f.Policy = pack.PolicyManaged
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// snapshot records the install before in its backup when the change to
+// after replaced files or moved to another pack, making it a rollback target.
+func snapshot(dir string, before, after manifest.Manifest, r *reconciler) error {
+	pin := func(m manifest.Manifest) []any { return []any{m.Version, m.Source, m.Tag, m.Digest, m.Layers} }
+	if r.saved == 0 && reflect.DeepEqual(pin(before), pin(after)) {
+		return nil
+	}
+	return manifest.SaveSnapshot(dir, before)
+}
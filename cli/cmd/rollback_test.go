@@ -0,0 +1,33 @@
+package cmd
+
+import "testing"
+
+func TestRollbackTwiceGoesFurtherBack(t *testing.T) {
+	newRepo(t)
+	var packs []string
+	for _, v := range []string{"1.0.0", "2.0.0", "3.0.0"} {
+		packs = append(packs, writePack(t, v, map[string]string{"commands/ship.md": "ship " + v + "\n"}))
+	}
+	mustCodo(t, "init", "--no-tui", "--stacks", "", "--from", packs[0])
+	mustCodo(t, "update", "--from", packs[1])
+	mustCodo(t, "update", "--from", packs[2])
+
+	for _, want := range []string{"2.0.0", "1.0.0"} {
+		mustCodo(t, "rollback")
+		if m := installed(t); m.Version != want {
+			t.Fatalf("rolled back to %s, want %s", m.Version, want)
+		}
+		if got := readFile(t, ".claude/commands/ship.md"); got != "ship "+want+"\n" {
+			t.Fatalf("ship.md = %q after rolling back to %s", got, want)
+		}
+	}
+	if err := codo(t, "rollback"); err == nil {
+		t.Fatalf("rolled back past the first install to %s", installed(t).Version)
+	}
+
+	// The installs rollbacks left are still reachable by version.
+	mustCodo(t, "rollback", "--to", "3.0.0")
+	if m := installed(t); m.Version != "3.0.0" {
+		t.Fatalf("rollback --to 3.0.0 went to %s", m.Version)
+	}
+}
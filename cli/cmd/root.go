@@ -5,26 +5,52 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/journal"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
 )
 
 // Set via -ldflags "-X github.com/hergert/codo-agentic-toolkit/cli/cmd.version=vX.Y.Z"
 var version = "dev"
 
-func Execute() { cobra.CheckErr(rootCmd.Execute()) }
+func Execute() { cobra.CheckErr(endJournal(rootCmd.Execute())) }
 
 var rootCmd = &cobra.Command{
 	Use:   "codo",
 	Short: "Manage the Codo Agentic Toolkit in any repo",
 	Long:  "Install, update, remove, and check status of the Codo toolkit with safe conflict handling.",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// Arguments and flags are checked by now; later errors are about the
+		// repository or the network, and the usage text would bury them.
+		cmd.SilenceUsage = true
+		v, err := verifier()
+		if err != nil {
+			return err
+		}
+		if v.Insecure {
+			fmt.Fprintln(os.Stderr, "warning: --insecure: signatures of downloaded packs and releases are not checked")
+		}
+		pack.Verifier = v
+		if err := enterRepo(cmd); err != nil {
+			return err
+		}
+		return beginJournal(cmd)
+	},
 }
 
 func init() {
 	rootCmd.Version = version
+	manifest.CLIVersion = version
+	rootCmd.PersistentFlags().BoolVar(&insecure, "insecure", false, "Accept unsigned or mis-signed packs and releases")
 	rootCmd.AddCommand(initCmd, updateCmd, removeCmd, statusCmd, doctorCmd, upgradeCmd)
 }
 
 func abortIf(cond bool, msg string) {
 	if cond {
+		if j := journal.Active(); j != nil {
+			_ = j.Rollback()
+		}
 		fmt.Fprintln(os.Stderr, msg)
 		os.Exit(1)
 	}
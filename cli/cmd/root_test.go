@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestUsageOnlyForBadInvocations(t *testing.T) {
+	newRepo(t)
+	mustCodo(t, "init", "--no-tui", "--stacks", "", "--from", writePack(t, "1.0.0", map[string]string{"commands/ship.md": "ship\n"}))
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetErr(&out)
+	t.Cleanup(func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+	})
+
+	if err := codo(t, "update", "--from", "dir:"+t.TempDir()+"/missing"); err == nil {
+		t.Fatal("update from a missing pack succeeded")
+	}
+	if strings.Contains(out.String(), "Usage:") {
+		t.Fatalf("a failed update printed usage:\n%s", out.String())
+	}
+	out.Reset()
+	if err := codo(t, "lock", "extra"); err == nil {
+		t.Fatal("lock accepted an argument")
+	}
+	if !strings.Contains(out.String(), "Usage:") {
+		t.Fatalf("a bad invocation printed no usage:\n%s", out.String())
+	}
+}
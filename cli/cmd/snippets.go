@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/fsops"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+)
+
+var snippetsCmd = &cobra.Command{
+	Use:   "snippets",
+	Short: "List, enable or disable optional hook snippets",
+}
+
+var snippetsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Show available hook snippets and which are enabled",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		abortIf(!manifest.Exists(), "No manifest found. Run `codo init` first.")
+		m, err := manifest.Open()
+		if err != nil {
+			return err
+		}
+		src, err := installedPack(m)
+		if err != nil {
+			return err
+		}
+		snips, err := src.Layers().Snippets()
+		if err != nil {
+			return err
+		}
+		if len(snips) == 0 {
+			fmt.Println("No hook snippets in this pack")
+			return nil
+		}
+		suggested := pack.SuggestSnippets(snips, m.Stacks)
+		for _, s := range snips {
+			box := " "
+			if slices.Contains(m.Snippets, s.Name) {
+				box = "x"
+			}
+			note := ""
+			if box == " " && slices.Contains(suggested, s.Name) {
+				note = " (suggested)"
+			}
+			fmt.Printf("[%s] %-24s %-12s %s%s\n", box, s.Name, s.Event, s.Description, note)
+		}
+		return nil
+	},
+}
+
+var snippetsEnableCmd = &cobra.Command{
+	Use:   "enable <name>",
+	Short: "Register a hook snippet in settings",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setSnippet(args[0], true)
+	},
+}
+
+var snippetsDisableCmd = &cobra.Command{
+	Use:   "disable <name>",
+	Short: "Unregister a hook snippet from settings",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setSnippet(args[0], false)
+	},
+}
+
+func init() {
+	snippetsCmd.AddCommand(snippetsListCmd, snippetsEnableCmd, snippetsDisableCmd)
+	rootCmd.AddCommand(snippetsCmd)
+}
+
+// setSnippet recomposes the hooks settings file with the snippet added or
+// removed and merges it against the contribution recorded in the manifest,
+// leaving every other installed file alone.
+func setSnippet(name string, enable bool) error {
+	abortIf(!manifest.Exists(), "No manifest found. Run `codo init` first.")
+	m, err := manifest.Open()
+	if err != nil {
+		return err
+	}
+	if slices.Contains(m.Snippets, name) == enable {
+		if enable {
+			fmt.Printf("Snippet %s is already enabled\n", name)
+		} else {
+			fmt.Printf("Snippet %s is not enabled\n", name)
+		}
+		return nil
+	}
+	src, err := installedPack(m)
+	if err != nil {
+		return err
+	}
+
+	sel := m.Selection()
+	if enable {
+		sel.Snippets = append(slices.Clone(sel.Snippets), name)
+		slices.Sort(sel.Snippets)
+	} else {
+		sel.Snippets = slices.DeleteFunc(slices.Clone(sel.Snippets), func(s string) bool { return s == name })
+	}
+	files, err := src.Layers().Files(sel)
+	if err != nil {
+		return err
+	}
+	target, err := pack.HooksTargetPath(sel.HooksTarget)
+	if err != nil {
+		return err
+	}
+	i := slices.IndexFunc(files, func(f pack.File) bool { return f.RelPath == target })
+	if i < 0 {
+		return fmt.Errorf("pack does not provide %s", target)
+	}
+
+	var prev manifest.Entry
+	j := slices.IndexFunc(m.Files, func(e manifest.Entry) bool { return e.Path == target })
+	if j >= 0 {
+		prev = m.Files[j]
+	}
+	contrib, out, err := fsops.MergeJSON(files[i], ".", prev.Owned, false)
+	if err != nil {
+		return err
+	}
+	ent := manifest.Entry{Path: target, SHA256: fmt.Sprintf("%x", sha256.Sum256(out)), Owned: contrib, Layer: files[i].Layer, Stack: files[i].Stack}
+	if j >= 0 {
+		m.Files[j] = ent
+	} else {
+		m.Files = append(m.Files, ent)
+	}
+	m.Snippets = sel.Snippets
+	if err := manifest.Save(m); err != nil {
+		return err
+	}
+	if enable {
+		fmt.Printf("Enabled snippet %s in %s\n", name, target)
+	} else {
+		fmt.Printf("Disabled snippet %s in %s\n", name, target)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/settings"
+)
+
+func TestSnippetsEnableDisableRemove(t *testing.T) {
+	newRepo(t)
+	src := writePack(t, "1.0.0", map[string]string{
+		"settings.json": `{"model":"opus"}`,
+		"hooks.json":    `{"PreToolUse":[{"matcher":"*","hooks":[{"type":"command","command":"guard"}]}]}`,
+		"snippets/hooks/go.warn.json": `{"description":"warn","event":"PostToolUse",
+			"hooks":[{"matcher":"Bash","hooks":[{"type":"command","command":"warn"}]}]}`,
+	})
+	mustCodo(t, "init", "--no-tui", "--stacks", "", "--from", src)
+	const path = ".claude/settings.json"
+	readSettings := func() map[string]any {
+		t.Helper()
+		var s map[string]any
+		if err := json.Unmarshal([]byte(readFile(t, path)), &s); err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	// The user's own setting, added the way an editor would keep the file's order.
+	o, err := settings.Parse([]byte(readFile(t, path)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.Set("theme", "dark")
+	if err := os.WriteFile(path, settings.Encode(o), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	mustCodo(t, "snippets", "enable", "go.warn")
+	if got := readFile(t, path); !strings.Contains(got, `"warn"`) || !strings.Contains(got, `"guard"`) || !strings.Contains(got, `"theme"`) {
+		t.Fatalf("after enable:\n%s", got)
+	}
+	if m := installed(t); !slices.Equal(m.Snippets, []string{"go.warn"}) {
+		t.Fatalf("manifest snippets = %v", m.Snippets)
+	}
+	mustCodo(t, "snippets", "enable", "go.warn")
+	if got := readFile(t, path); strings.Count(got, `"warn"`) != 1 {
+		t.Fatalf("enabling twice registered the snippet twice:\n%s", got)
+	}
+
+	mustCodo(t, "snippets", "disable", "go.warn")
+	if got := readFile(t, path); strings.Contains(got, `"warn"`) || !strings.Contains(got, `"guard"`) || !strings.Contains(got, `"theme"`) {
+		t.Fatalf("after disable:\n%s", got)
+	}
+	if m := installed(t); len(m.Snippets) != 0 {
+		t.Fatalf("manifest snippets = %v after disable", m.Snippets)
+	}
+
+	mustCodo(t, "snippets", "enable", "go.warn")
+	mustCodo(t, "remove")
+	s := readSettings()
+	if _, ok := s["hooks"]; ok || s["theme"] != "dark" || s["model"] != nil {
+		t.Fatalf("after remove, settings.json = %v; want only the user's keys", s)
+	}
+}
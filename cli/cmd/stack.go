@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/merge"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+)
+
+var stackDry bool
+
+var stackCmd = &cobra.Command{
+	Use:   "stack",
+	Short: "Add or remove stacks on an existing install",
+}
+
+var stackAddCmd = &cobra.Command{
+	Use:   "add <key>",
+	Short: "Install a stack's overlay files and settings",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeStack(args[0], true)
+	},
+}
+
+var stackRemoveCmd = &cobra.Command{
+	Use:   "remove <key>",
+	Short: "Take back a stack's files and settings, restoring the base versions",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return changeStack(args[0], false)
+	},
+}
+
+func init() {
+	stackCmd.PersistentFlags().BoolVar(&stackDry, "dry-run", false, "Preview only")
+	stackCmd.AddCommand(stackAddCmd, stackRemoveCmd)
+	rootCmd.AddCommand(stackCmd)
+}
+
+// changeStack recomputes the file set from the installed pack with key added
+// to or dropped from the selection and reconciles the install with it.
+func changeStack(key string, add bool) error {
+	abortIf(!manifest.Exists(), "No manifest found. Run `codo init` first.")
+	m, err := manifest.Open()
+	if err != nil {
+		return err
+	}
+	if slices.Contains(m.Stacks, key) == add {
+		if add {
+			fmt.Printf("Stack %s is already installed\n", key)
+		} else {
+			fmt.Printf("Stack %s is not installed\n", key)
+		}
+		return nil
+	}
+	src, err := installedPack(m)
+	if err != nil {
+		return err
+	}
+	packs := src.Layers()
+	catalog, err := packs.Catalog()
+	if err != nil {
+		return err
+	}
+
+	sel := m.Selection()
+	if add {
+		sel.Stacks, err = pack.ResolveStacks(catalog, append(slices.Clone(sel.Stacks), key))
+		if err != nil {
+			return err
+		}
+		for _, s := range sel.Stacks {
+			if s != key && !slices.Contains(m.Stacks, s) {
+				fmt.Printf("Adding stack %s (required by %s)\n", s, key)
+			}
+		}
+		for _, s := range packs.MissingStacks([]string{key}) {
+			fmt.Printf("warning: stack %q has no content in the %s pack\n", s, src.Source)
+		}
+	} else {
+		sel.Stacks = slices.DeleteFunc(slices.Clone(sel.Stacks), func(s string) bool { return s == key })
+		for _, s := range catalog {
+			if slices.Contains(sel.Stacks, s.Key) && slices.Contains(s.Requires, key) {
+				return fmt.Errorf("stack %s is required by %s; remove that first", key, s.Key)
+			}
+		}
+	}
+	files, err := packs.Files(sel)
+	if err != nil {
+		return err
+	}
+
+	r := &reconciler{
+		labels: merge.Labels{Ours: "local", Theirs: "codo " + src.Version},
+		oldSel: m.Selection(),
+		dry:    stackDry,
+	}
+	entries, err := r.run(m, files)
+	if err != nil {
+		return err
+	}
+	if !stackDry {
+		before := m
+		m.Files = entries
+		m.Stacks = sel.Stacks
+		if err := manifest.Save(m); err != nil {
+			return err
+		}
+		command := "stack add"
+		if !add {
+			command = "stack remove"
+		}
+		if err := recordHistory(command, before, m, r.conflicts); err != nil {
+			return err
+		}
+	}
+	verb := "Added"
+	if !add {
+		verb = "Removed"
+	}
+	fmt.Printf("\n%s stack %s: %d merged, %d conflicted\n", verb, key, r.merged, len(r.conflicts))
+	return nil
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// stackPack writes a pack with go and python stacks whose CLAUDE.md sections
+// carry the version.
+func stackPack(t *testing.T, version string) string {
+	return writePack(t, version, map[string]string{
+		"../pack.json":               `{"version":"` + version + `","stacks":[{"key":"go","label":"Go"},{"key":"python","label":"Python"}]}`,
+		"../CLAUDE.md":               "# Toolkit\n",
+		"../stacks/go/CLAUDE.md":     "## Go " + version + "\n",
+		"../stacks/python/CLAUDE.md": "## Python " + version + "\n",
+		"commands/ship.md":           "ship\n",
+	})
+}
+
+func TestStackSectionsFollowTheStacks(t *testing.T) {
+	newRepo(t)
+	if err := os.WriteFile("CLAUDE.md", []byte("# Our project\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	mustCodo(t, "init", "--no-tui", "--stacks", "go", "--from", stackPack(t, "1.0.0"))
+	if got := readFile(t, ".claude/rules/go.md"); got != "## Go 1.0.0\n" {
+		t.Fatalf("go rules = %q", got)
+	}
+
+	mustCodo(t, "stack", "add", "python")
+	if got := readFile(t, ".claude/rules/python.md"); got != "## Python 1.0.0\n" {
+		t.Fatalf("python rules = %q", got)
+	}
+	mustCodo(t, "stack", "remove", "go")
+	if _, err := os.Stat(".claude/rules/go.md"); !os.IsNotExist(err) {
+		t.Fatalf("go rules still installed after stack remove: %v", err)
+	}
+
+	mustCodo(t, "update", "--from", stackPack(t, "2.0.0"))
+	if got := readFile(t, ".claude/rules/python.md"); got != "## Python 2.0.0\n" {
+		t.Fatalf("python rules = %q after update", got)
+	}
+	if got := readFile(t, "CLAUDE.md"); got != "# Our project\n" {
+		t.Fatalf("the project's CLAUDE.md was rewritten: %q", got)
+	}
+}
+
+func TestStackAddAndRemove(t *testing.T) {
+	newRepo(t)
+	src := writePack(t, "1.0.0", map[string]string{
+		"../pack.json": `{"version":"1.0.0","stacks":[
+			{"key":"go","label":"Go"},
+			{"key":"gin","label":"Gin","requires":["go"]}]}`,
+		"commands/review.md":              "base review\n",
+		"../stacks/go/commands/review.md": "go review\n",
+		"../stacks/go/commands/vet.md":    "vet\n",
+		"../stacks/go/agents/gopher.md":   "gopher\n",
+		"../stacks/gin/commands/route.md": "route\n",
+	})
+	mustCodo(t, "init", "--no-tui", "--stacks", "", "--from", src)
+
+	mustCodo(t, "stack", "add", "go")
+	if m := installed(t); len(m.Stacks) != 1 || m.Stacks[0] != "go" {
+		t.Fatalf("stacks = %v after adding go", m.Stacks)
+	}
+	for path, want := range map[string]string{
+		".claude/commands/review.md": "go review\n",
+		".claude/commands/vet.md":    "vet\n",
+		".claude/agents/gopher.md":   "gopher\n",
+	} {
+		if got := readFile(t, path); got != want {
+			t.Fatalf("%s = %q after adding go, want %q", path, got, want)
+		}
+	}
+
+	mustCodo(t, "stack", "add", "gin")
+	if err := codo(t, "stack", "remove", "go"); err == nil || !strings.Contains(err.Error(), "required by gin") {
+		t.Fatalf("removing go under gin: %v", err)
+	}
+	if m := installed(t); !slices.Equal(m.Stacks, []string{"go", "gin"}) {
+		t.Fatalf("stacks = %v after the refused removal", m.Stacks)
+	}
+	mustCodo(t, "stack", "remove", "gin")
+
+	// An edited stack-only file is the user's and stays; the rest go.
+	if err := os.WriteFile(".claude/agents/gopher.md", []byte("gopher, ours\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	mustCodo(t, "stack", "remove", "go")
+	for _, path := range []string{".claude/commands/vet.md", ".claude/commands/route.md"} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Fatalf("%s still installed after removing its stack: %v", path, err)
+		}
+	}
+	if got := readFile(t, ".claude/agents/gopher.md"); got != "gopher, ours\n" {
+		t.Fatalf("edited gopher.md = %q after removing go", got)
+	}
+	if got := readFile(t, ".claude/commands/review.md"); got != "base review\n" {
+		t.Fatalf("review.md = %q after removing go, want the base version back", got)
+	}
+	if m := installed(t); len(m.Stacks) != 0 {
+		t.Fatalf("stacks = %v after removing every stack", m.Stacks)
+	}
+}
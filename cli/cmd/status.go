@@ -4,12 +4,17 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
 	"github.com/spf13/cobra"
 )
 
-var strictFlag bool
+var (
+	strictFlag    bool
+	noUpdateCheck bool
+)
 
 var statusCmd = &cobra.Command{
 	Use:   "status",
@@ -17,6 +22,9 @@ var statusCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if !manifest.Exists() {
 			fmt.Println("codo: not installed")
+			if l, err := manifest.OpenLock(); err == nil {
+				fmt.Printf("%s records pack %s (stacks: %s); run `codo init` to install it\n", manifest.LockPath, l.Version, strings.Join(l.Stacks, ", "))
+			}
 			return nil
 		}
 		m, err := manifest.Open()
@@ -25,16 +33,54 @@ var statusCmd = &cobra.Command{
 		}
 		var drift []string
 		for _, ent := range m.Files {
+			if ent.Policy == pack.PolicySeedOnce {
+				continue // the project's to edit
+			}
 			b, err := os.ReadFile(ent.Path)
 			if err != nil {
-				drift = append(drift, "missing "+ent.Path)
+				drift = append(drift, "missing "+ent.Path+origin(ent))
 				continue
 			}
 			if fmt.Sprintf("%x", sha256.Sum256(b)) != ent.SHA256 {
-				drift = append(drift, "~ "+ent.Path)
+				drift = append(drift, "~ "+ent.Path+origin(ent))
+			}
+			if info, err := os.Stat(ent.Path); err == nil && ent.Mode != "" && manifest.FileMode(info.Mode()) != ent.Mode {
+				drift = append(drift, fmt.Sprintf("mode %s %s (installed %s)", ent.Path, manifest.FileMode(info.Mode()), ent.Mode))
+			}
+		}
+		if m.Source != "" && m.Source != m.Version {
+			fmt.Printf("Installed version: %s (from %s)\n", m.Version, m.Source)
+		} else {
+			fmt.Println("Installed version:", m.Version)
+		}
+		if m.InstalledAt != "" {
+			fmt.Printf("Installed: %s, updated %s by codo %s\n", m.InstalledAt, m.UpdatedAt, m.CLIVersion)
+		}
+		if m.Digest != "" {
+			fmt.Printf("Pack: %s sha256:%s\n  %s\n", m.Tag, m.Digest, m.URL)
+		}
+		if m.Migrated != nil {
+			if _, err := os.Stat(m.Migrated.From); err == nil {
+				fmt.Printf("Manifest: at %s, where older codo versions kept it;\n  the next command that changes the install moves it to codo's config directory\n", m.Migrated.From)
+			} else {
+				fmt.Printf("Manifest: moved from %s, where older codo versions kept it;\n  it is now keyed by the repository's identity in its git directory, which linked\n  worktrees share and a moved checkout keeps, while every clone gets its own\n", m.Migrated.From)
+			}
+		}
+		for _, l := range m.Layers {
+			fmt.Printf("Layer %s: %s (from %s)\n", l.Name, l.Version, l.Source)
+		}
+		if l, err := manifest.OpenLock(); err == nil {
+			if lockMatches(l, m) {
+				fmt.Printf("Lock: %s matches this install\n", manifest.LockPath)
+			} else {
+				fmt.Printf("Lock: %s records pack %s, which differs from this install\n", manifest.LockPath, l.Version)
+			}
+		}
+		if !noUpdateCheck && os.Getenv("CODO_NO_UPDATE_CHECK") == "" {
+			if rel, ok := checkOutdated(m); ok {
+				fmt.Printf("Update available: %s (see `codo outdated`)\n", rel.Tag)
 			}
 		}
-		fmt.Println("Installed version:", m.Version)
 		if len(drift) == 0 {
 			fmt.Println("No drift")
 		} else {
@@ -50,6 +96,23 @@ var statusCmd = &cobra.Command{
 	},
 }
 
+// origin describes where a drifted file came from, when it is not the base
+// of a single pack.
+func origin(ent manifest.Entry) string {
+	var from []string
+	if ent.Stack != "" {
+		from = append(from, "stack "+ent.Stack)
+	}
+	if ent.Layer != "" {
+		from = append(from, "layer "+ent.Layer)
+	}
+	if len(from) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(from, ", ") + ")"
+}
+
 func init() {
 	statusCmd.Flags().BoolVar(&strictFlag, "strict", false, "Exit non-zero if drift exists")
+	statusCmd.Flags().BoolVar(&noUpdateCheck, "no-update-check", false, "Skip checking for a newer pack release (or set CODO_NO_UPDATE_CHECK=1)")
 }
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+)
+
+// installEmbedded installs from the embedded pack, which follows upstream
+// releases.
+func installEmbedded(t *testing.T) {
+	t.Helper()
+	embedPack(t, writePack(t, "1.0.0", map[string]string{"commands/ship.md": "ship\n"}))
+	mustCodo(t, "init", "--no-tui", "--stacks", "", "--from", "embedded")
+}
+
+// releasesServer stands in for the releases page with handler.
+func releasesServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	saved := pack.DefaultReleases.BaseURL
+	pack.DefaultReleases.BaseURL = srv.URL
+	t.Cleanup(func() { pack.DefaultReleases.BaseURL = saved })
+}
+
+func TestStatusUpdateCheckCanBeSkipped(t *testing.T) {
+	newRepo(t)
+	installEmbedded(t)
+	var hits atomic.Int32
+	releasesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		http.NotFound(w, r)
+	})
+
+	mustCodo(t, "status", "--no-update-check")
+	t.Setenv("CODO_NO_UPDATE_CHECK", "1")
+	mustCodo(t, "status")
+	if n := hits.Load(); n != 0 {
+		t.Fatalf("status made %d requests with the update check off", n)
+	}
+	t.Setenv("CODO_NO_UPDATE_CHECK", "")
+	mustCodo(t, "status")
+	if hits.Load() == 0 {
+		t.Fatal("status did not check for updates")
+	}
+}
+
+func TestStatusUpdateCheckIsBounded(t *testing.T) {
+	newRepo(t)
+	installEmbedded(t)
+	stop := make(chan struct{})
+	releasesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-stop:
+		case <-r.Context().Done():
+		}
+	})
+	defer close(stop)
+
+	start := time.Now()
+	mustCodo(t, "status")
+	if d := time.Since(start); d > updateCheckTimeout+time.Second {
+		t.Fatalf("status took %v against releases that never answer", d)
+	}
+}
@@ -0,0 +1,255 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/fsops"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/journal"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+)
+
+var syncCheck bool
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Make the toolkit files match " + manifest.LockPath,
+	Long: `Install the pack ` + manifest.LockPath + ` records, exactly: missing files are
+installed, files codo installed and nobody edited are brought to the locked
+version, files codo installed that the lock drops are removed, and local
+modifications are reported and left alone. It fails when the pack resolves
+to a digest other than the locked one.
+
+Local changes the lock records (see ` + "`codo lock`" + `) are expected rather than
+reported. With --check nothing is written and any difference fails the
+command, for CI.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		l, err := manifest.OpenLock()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("no %s in this repository (write one with `codo lock`)", manifest.LockPath)
+			}
+			return err
+		}
+		src, err := lockedPack(l)
+		if err != nil {
+			return err
+		}
+		files, err := src.Layers().Files(l.Manifest().Selection())
+		if err != nil {
+			return err
+		}
+		if err := checkLockedFiles(files, l); err != nil {
+			return err
+		}
+		local := map[string]manifest.Entry{}
+		var before manifest.Manifest
+		if manifest.Exists() {
+			if before, err = manifest.Open(); err != nil {
+				return err
+			}
+			for _, ent := range before.Files {
+				local[ent.Path] = ent
+			}
+		}
+
+		s := &syncer{check: syncCheck, local: local}
+		entries, err := s.run(l, files)
+		if err != nil {
+			return err
+		}
+		if syncCheck {
+			if s.diff > 0 {
+				return fmt.Errorf("%d files differ from %s (run `codo sync`)", s.diff, manifest.LockPath)
+			}
+			fmt.Printf("In sync with %s (pack %s)\n", manifest.LockPath, l.Version)
+			return nil
+		}
+		if err := fsops.ChmodHooks(); err != nil {
+			return err
+		}
+		m := l.Manifest()
+		m.Files = entries
+		m.InstalledAt = before.InstalledAt
+		if err := manifest.Save(m); err != nil {
+			return err
+		}
+		if s.diff > 0 {
+			if err := recordHistory("sync", before, m, s.modified); err != nil {
+				return err
+			}
+		}
+		if len(s.modified) > 0 {
+			fmt.Printf("\nSynced to pack %s; %d files keep local changes the lock does not record:\n", l.Version, len(s.modified))
+			for _, p := range s.modified {
+				fmt.Println("  " + p)
+			}
+			fmt.Println("Revert them to match the lock, or record them with `codo lock`.")
+			return nil
+		}
+		fmt.Printf("\nSynced to pack %s as recorded in %s\n", l.Version, manifest.LockPath)
+		return nil
+	},
+}
+
+func init() {
+	syncCmd.Flags().BoolVar(&syncCheck, "check", false, "Only report differences, failing if there are any")
+	rootCmd.AddCommand(syncCmd)
+}
+
+// syncer compares the working tree with a lock and, unless checking, brings
+// it in line.
+type syncer struct {
+	check bool
+	local map[string]manifest.Entry // this machine's manifest, if installed
+
+	diff     int
+	modified []string
+}
+
+// run syncs every locked file and returns the manifest entries of the result.
+func (s *syncer) run(l manifest.Lock, files []pack.File) ([]manifest.Entry, error) {
+	byPath := map[string]pack.File{}
+	for _, f := range files {
+		byPath[f.RelPath] = f
+	}
+	locked := map[string]bool{}
+	var entries []manifest.Entry
+	for _, lf := range l.Files {
+		locked[lf.Path] = true
+		f, ok := byPath[lf.Path]
+		ent := manifest.Entry{Path: lf.Path, SHA256: lf.SHA256, Unmanaged: lf.Unmanaged, Layer: f.Layer, Stack: f.Stack}
+		if lf.Policy == pack.PolicySeedOnce || lf.Policy == pack.PolicyNeverOverwrite {
+			ent.Policy = lf.Policy
+		}
+		cur := fileHash(lf.Path)
+		switch {
+		case !ok || lf.Unmanaged:
+			// The project's own file: not the pack's to change.
+		case lf.Policy == pack.PolicySeedOnce || lf.Policy == pack.PolicyNeverOverwrite:
+			if cur == "" {
+				s.diff++
+				if _, err := fsops.Seed(f, ".", s.check); err != nil {
+					return nil, err
+				}
+			}
+			if !s.check {
+				ent.SHA256 = fileHash(lf.Path)
+			}
+		case lf.Policy == pack.PolicyMergeJSON:
+			if cur == lf.SHA256 {
+				ent.Owned = s.local[lf.Path].Owned
+				if ent.Owned == nil {
+					b, err := f.Read()
+					if err != nil {
+						return nil, err
+					}
+					ent.Owned = b
+				}
+				break
+			}
+			contrib, out, err := fsops.MergeJSON(f, ".", s.local[lf.Path].Owned, s.check)
+			if err != nil {
+				return nil, err
+			}
+			ent.Owned = contrib
+			s.diff++
+			if fmt.Sprintf("%x", sha256.Sum256(out)) != lf.SHA256 {
+				s.flag(lf.Path)
+			}
+		default:
+			b, err := f.Read()
+			if err != nil {
+				return nil, err
+			}
+			want := lf.SHA256
+			if lf.Local != "" {
+				want = lf.Local
+			}
+			write := false
+			switch {
+			case cur == want:
+			case lf.Local != "":
+				// The team's changes are in the repository, not the pack.
+				fmt.Println("! " + lf.Path + " (not the local changes the lock records)")
+				s.modified = append(s.modified, lf.Path)
+			case cur == "":
+				fmt.Println("+ " + lf.Path)
+				write = true
+			case s.clean(lf.Path, cur):
+				fmt.Println("~ " + lf.Path)
+				write = true
+			default:
+				s.flag(lf.Path)
+			}
+			if cur != want {
+				s.diff++
+			}
+			if s.check {
+				break
+			}
+			if write {
+				if err := journal.WriteFile(lf.Path, b, 0o644); err != nil {
+					return nil, err
+				}
+			}
+			if err := manifest.SaveBase(b); err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, ent)
+	}
+	// Files this machine installed that the lock no longer has.
+	var dropped []string
+	for path := range s.local {
+		if !locked[path] {
+			dropped = append(dropped, path)
+		}
+	}
+	slices.Sort(dropped)
+	for _, path := range dropped {
+		ent := s.local[path]
+		cur := fileHash(path)
+		if cur == "" || ent.Unmanaged || ent.Policy == pack.PolicySeedOnce || ent.Policy == pack.PolicyNeverOverwrite || ent.Owned != nil {
+			continue
+		}
+		s.diff++
+		if cur != ent.SHA256 {
+			fmt.Println("! " + path + " (not in the lock; kept for its local changes)")
+			s.modified = append(s.modified, path)
+			continue
+		}
+		fmt.Println("- " + path)
+		if !s.check {
+			if err := journal.Remove(path); err != nil {
+				return nil, err
+			}
+		}
+	}
+	slices.SortFunc(entries, func(a, b manifest.Entry) int { return strings.Compare(a.Path, b.Path) })
+	return entries, nil
+}
+
+// clean reports whether the file at path, with hash cur, is pack content
+// nobody edited: as this machine installed it, or some pack version's copy.
+func (s *syncer) clean(path, cur string) bool {
+	if ent, ok := s.local[path]; ok && !ent.Unmanaged && ent.SHA256 == cur {
+		return true
+	}
+	_, err := manifest.LoadBase(cur)
+	return err == nil
+}
+
+// flag reports a file whose local changes keep it from matching the lock.
+func (s *syncer) flag(path string) {
+	fmt.Println("! " + path + " (local changes)")
+	s.modified = append(s.modified, path)
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
+)
+
+func TestLockSyncRoundTrip(t *testing.T) {
+	newRepo(t)
+	src := writePack(t, "1.0.0", map[string]string{"commands/a.md": "a\n", "commands/b.md": "b\n"})
+	mustCodo(t, "init", "--no-tui", "--stacks", "", "--from", src, "--lock")
+	l, err := manifest.OpenLock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.HasPrefix(l.Source, "dir:/") {
+		t.Fatalf("lock records the absolute source %s", l.Source)
+	}
+	mustCodo(t, "sync", "--check")
+
+	// A file deleted is put back by sync.
+	if err := os.Remove(".claude/commands/b.md"); err != nil {
+		t.Fatal(err)
+	}
+	if err := codo(t, "sync", "--check"); err == nil {
+		t.Fatal("sync --check passed with a locked file missing")
+	}
+	mustCodo(t, "sync")
+	if got := readFile(t, ".claude/commands/b.md"); got != "b\n" {
+		t.Fatalf("b.md = %q after sync", got)
+	}
+
+	// Local changes are reported until `codo lock` records them.
+	if err := os.WriteFile(".claude/commands/a.md", []byte("a, our way\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	mustCodo(t, "sync")
+	if got := readFile(t, ".claude/commands/a.md"); got != "a, our way\n" {
+		t.Fatalf("sync overwrote local changes: %q", got)
+	}
+	if err := codo(t, "sync", "--check"); err == nil {
+		t.Fatal("sync --check passed with unrecorded local changes")
+	}
+	mustCodo(t, "lock")
+	mustCodo(t, "sync", "--check")
+	if err := os.WriteFile(".claude/commands/a.md", []byte("a, someone else's way\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := codo(t, "sync", "--check"); err == nil {
+		t.Fatal("sync --check passed with changes other than the locked ones")
+	}
+
+	mustCodo(t, "remove")
+	if !manifest.LockExists() {
+		t.Fatal("remove deleted the lock")
+	}
+}
+
+func TestPreviewsGiveACloneNoIdentity(t *testing.T) {
+	root := newRepo(t)
+	for rel, data := range map[string]string{
+		"vendor/pack/pack.json":               `{"version":"1.0.0"}`,
+		"vendor/pack/dotclaude/commands/a.md": "a\n",
+	} {
+		if err := os.MkdirAll(filepath.Dir(rel), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(rel, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mustCodo(t, "init", "--no-tui", "--stacks", "", "--from", "dir:vendor/pack", "--lock")
+	git(t, root, "add", "-A")
+	git(t, root, "commit", "-q", "-m", "codo")
+
+	// CI checks a fresh clone against the lock.
+	clone := filepath.Join(t.TempDir(), "clone")
+	git(t, root, "clone", "-q", root, clone)
+	chdir(t, clone)
+	mustCodo(t, "sync", "--check")
+	mustCodo(t, "init", "--dry-run", "--no-tui", "--stacks", "", "--from", "dir:vendor/pack")
+	if _, err := os.Stat(filepath.Join(clone, ".git", "codo-id")); !os.IsNotExist(err) {
+		t.Fatalf("a preview gave the clone an identity: %v", err)
+	}
+	mustCodo(t, "sync")
+	if _, err := os.Stat(filepath.Join(clone, ".git", "codo-id")); err != nil {
+		t.Fatalf("sync gave the clone no identity: %v", err)
+	}
+}
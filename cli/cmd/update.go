@@ -1,19 +1,20 @@
 package cmd
 
 import (
-	"crypto/sha256"
 	"fmt"
-	"io/fs"
 	"os"
-	"path/filepath"
 
 	"github.com/hergert/codo-agentic-toolkit/cli/internal/manifest"
-	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/merge"
 	"github.com/spf13/cobra"
 )
 
 var updateTo string
 var updateDry bool
+var updateMarkers bool
+var updateHooksTarget string
+var updateFrom string
+var updateLayers []string
 
 var updateCmd = &cobra.Command{
 	Use:   "update",
@@ -25,188 +26,77 @@ var updateCmd = &cobra.Command{
 			return err
 		}
 
-		// Pack resolution for update (similar to init)
-		var rootFS fs.FS
-		var packSource string
-
-		if _, err := os.Stat("pack"); err == nil {
-			// Use local pack for development
-			rootFS = os.DirFS("pack")
-			packSource = "local"
-			fmt.Println("Using local pack directory for update")
-		} else {
-			// Try to download pack from GitHub
-			versionToFetch := updateTo
-			if versionToFetch == "" {
-				versionToFetch = "latest"
-			}
-			fmt.Printf("Downloading pack version: %s...\n", versionToFetch)
-
-			packPath, err := pack.Resolve(versionToFetch)
-			if err == nil {
-				rootFS = os.DirFS(packPath)
-				packSource = versionToFetch
-				fmt.Printf("Downloaded pack from GitHub releases\n")
-			} else {
-				// Fall back to embedded base
-				fmt.Printf("Download failed (%v), using embedded base pack\n", err)
-				rootFS, err = pack.GetEmbeddedBaseFS()
-				if err != nil {
-					return fmt.Errorf("failed to load embedded pack: %w", err)
-				}
-				packSource = "embedded-base"
+		from := updateFrom
+		if from == "" {
+			from = updateSource(m)
+		}
+		layers := m.Layers
+		if len(updateLayers) > 0 {
+			if layers, err = parseLayers(updateLayers); err != nil {
+				return err
 			}
 		}
-
-		files, err := pack.FilesFromDotclaudeFS(rootFS, m.Stacks)
+		src, err := resolvePack(from, updateTo, false)
 		if err != nil {
 			return err
 		}
-
-		// Build map of new contents
-		newMap := map[string][]byte{}
-		for _, f := range files {
-			b, err := f.Read()
-			if err == nil {
-				newMap[f.RelPath] = b
-			}
+		if err := openLayers(&src, layers); err != nil {
+			return err
 		}
 
-		// Track which files exist in the old manifest and unmanaged entries
-		oldSet := map[string]manifest.Entry{}
-		unmanaged := map[string]bool{}
-		for _, ent := range m.Files {
-			oldSet[ent.Path] = ent
-			if ent.Unmanaged {
-				unmanaged[ent.Path] = true
-			}
+		sel := m.Selection()
+		if updateHooksTarget != "" {
+			sel.HooksTarget = updateHooksTarget
 		}
-
-		// Process each file from the old manifest
-		for _, ent := range m.Files {
-			dst := ent.Path
-			nb, ok := newMap[dst]
-			if !ok {
-				// File removed upstream - handle safely
-				if ent.Unmanaged {
-					fmt.Println("~ skip unmanaged " + dst)
-					if !updateDry {
-						_ = os.Remove(dst + ".codo.new")
-					}
-					delete(unmanaged, dst)
-					continue
-				}
-				cur, err := os.ReadFile(dst)
-				if err != nil {
-					// File already gone, nothing to do
-					continue
-				}
-				curHash := fmt.Sprintf("%x", sha256.Sum256(cur))
-				if curHash == ent.SHA256 {
-					// File is clean (unmodified) - safe to remove
-					fmt.Println("- " + dst)
-					if !updateDry {
-						if err := os.Remove(dst); err != nil {
-							return err
-						}
-					}
-				} else {
-					// File has local modifications - keep it and notify user
-					note := dst + ".codo.removed.suggested"
-					fmt.Println("! modified & removed upstream → " + note)
-					if !updateDry {
-						msg := []byte("Upstream removed this file, but you have local changes.\nConsider removing it manually if no longer needed.\n")
-						if err := os.WriteFile(note, msg, 0o644); err != nil {
-							return err
-						}
-					}
-				}
-				continue
-			}
-
-			// File exists in new pack - check if it needs updating
-			cur, err := os.ReadFile(dst)
-			if err != nil {
-				// Missing → treat as clean overwrite
-				fmt.Println("+ " + dst)
-				if !updateDry {
-					if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
-						return err
-					}
-					if err := os.WriteFile(dst, nb, 0o644); err != nil {
-						return err
-					}
-				}
-				delete(unmanaged, dst)
-				continue
-			}
-			curHash := fmt.Sprintf("%x", sha256.Sum256(cur))
-			newHash := fmt.Sprintf("%x", sha256.Sum256(nb))
-			if ent.Unmanaged {
-				if curHash == newHash {
-					fmt.Println("= " + dst)
-					delete(unmanaged, dst)
-				} else {
-					out := dst + ".codo.new"
-					fmt.Println("! conflict → " + out)
-					if !updateDry {
-						if err := os.WriteFile(out, nb, 0o644); err != nil {
-							return err
-						}
-					}
-					unmanaged[dst] = true
-				}
-				continue
-			}
-			if curHash == ent.SHA256 {
-				// clean → overwrite
-				fmt.Println("~ " + dst)
-				if !updateDry {
-					if err := os.WriteFile(dst, nb, 0o644); err != nil {
-						return err
-					}
-				}
-				delete(unmanaged, dst)
-			} else {
-				// diverged → write .codo.new
-				out := dst + ".codo.new"
-				fmt.Println("! conflict → " + out)
-				if !updateDry {
-					if err := os.WriteFile(out, nb, 0o644); err != nil {
-						return err
-					}
-				}
-				delete(unmanaged, dst)
-			}
+		files, err := src.Layers().Files(sel)
+		if err != nil {
+			return err
 		}
 
-		// Add any new files that weren't in the old manifest
-		for path, content := range newMap {
-			if _, exists := oldSet[path]; !exists {
-				fmt.Println("+ " + path)
-				if !updateDry {
-					// Ensure directory exists
-					if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
-						return err
-					}
-					if err := os.WriteFile(path, content, 0o644); err != nil {
-						return err
-					}
-				}
-				delete(unmanaged, path)
-			}
+		root, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		backup, err := newBackupDir(root)
+		if err != nil {
+			return err
+		}
+		r := &reconciler{
+			labels:  merge.Labels{Ours: "local", Theirs: "codo " + src.Version},
+			oldSel:  m.Selection(),
+			dry:     updateDry,
+			markers: updateMarkers,
+			backup:  backup,
+		}
+		entries, err := r.run(m, files)
+		if err != nil {
+			return err
 		}
 		if !updateDry {
-			newVersion := packSource
-			if err := manifest.WriteWithStacks(files, newVersion, m.Stacks, unmanaged); err != nil {
+			out := m
+			src.stamp(&out)
+			out.Files = entries
+			out.HooksTarget = sel.HooksTarget
+			if err := snapshot(backup, m, out, r); err != nil {
+				return err
+			}
+			if err := manifest.Save(out); err != nil {
+				return err
+			}
+			if err := recordHistory("update", m, out, r.conflicts); err != nil {
 				return err
 			}
 		}
+		fmt.Printf("\nUpdate complete: %d merged, %d conflicted\n", r.merged, len(r.conflicts))
 		return nil
 	},
 }
 
 func init() {
 	updateCmd.Flags().StringVar(&updateTo, "to", "", "Version/tag to update to (e.g. v1.2.0)")
+	updateCmd.Flags().StringVar(&updateFrom, "from", "", "Switch to another pack source (default: the one recorded at install)")
+	updateCmd.Flags().StringArrayVar(&updateLayers, "layer", nil, "Replace the layered packs: <name>=<source>, repeatable (default: the recorded layers)")
 	updateCmd.Flags().BoolVar(&updateDry, "dry-run", false, "Preview only")
+	updateCmd.Flags().StringVar(&updateHooksTarget, "hooks-target", "", "Move hook registrations to settings or local (default: keep current)")
+	updateCmd.Flags().BoolVar(&updateMarkers, "markers", false, "Write conflict markers into the file instead of <file>.codo.new")
 }
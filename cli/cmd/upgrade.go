@@ -20,6 +20,8 @@ import (
 	update "github.com/inconshreveable/go-update"
 	"github.com/rhysd/go-github-selfupdate/selfupdate"
 	"github.com/spf13/cobra"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
 )
 
 const releaseRepo = "hergert/codo-agentic-toolkit"
@@ -131,6 +133,14 @@ func fetchExpectedChecksum(tag, asset string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	sig, err := fetchSignature(url + ".minisig")
+	if err != nil {
+		return "", err
+	}
+	// The checksums cover the archives; their signature covers the checksums.
+	if _, err := pack.Verifier.Verify("checksums.txt", data, sig); err != nil {
+		return "", err
+	}
 
 	lines := strings.Split(string(data), "\n")
 	for _, line := range lines {
@@ -150,6 +160,23 @@ func fetchExpectedChecksum(tag, asset string) (string, error) {
 	return "", fmt.Errorf("checksum for %s not found", asset)
 }
 
+// fetchSignature downloads a detached signature; it returns nil if the
+// release has none.
+func fetchSignature(url string) ([]byte, error) {
+	resp, err := upgradeHTTPClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("download signature: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download signature: %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+}
+
 func downloadReleaseAsset(tag, asset string) ([]byte, error) {
 	url := fmt.Sprintf("https://github.com/%s/releases/download/%s/%s", releaseRepo, tag, asset)
 	resp, err := upgradeHTTPClient.Get(url)
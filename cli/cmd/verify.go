@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/signature"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/statepath"
+)
+
+// releaseKey is the minisign public key releases are signed with. The release
+// workflow refuses to publish when it differs from the MINISIGN_PUBLIC_KEY
+// variable. While it is empty only released binaries, which get the key
+// through publicKey, verify downloads; other builds warn and install the
+// cached or embedded pack unless trusted_keys has a key.
+const releaseKey = ""
+
+// publicKey is the key set this binary trusts, comma-separated while a key is
+// being rotated. -ldflags "-X github.com/hergert/codo-agentic-toolkit/cli/cmd.publicKey=RW..."
+// overrides it.
+var publicKey = releaseKey
+
+var insecure bool
+
+// verifier returns the signature policy for downloads: the keys built into
+// this binary plus those in the user's trusted keys file.
+func verifier() (signature.Verifier, error) {
+	v := signature.Verifier{Insecure: insecure}
+	keys, err := signature.ParseKeys(strings.ReplaceAll(publicKey, ",", "\n"))
+	if err != nil {
+		return v, fmt.Errorf("built-in public key: %w", err)
+	}
+	path, err := statepath.TrustedKeysPath()
+	if err != nil {
+		return v, err
+	}
+	b, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return v, err
+	}
+	more, err := signature.ParseKeys(string(b))
+	if err != nil {
+		return v, fmt.Errorf("%s: %w", path, err)
+	}
+	v.Keys = append(keys, more...)
+	return v, nil
+}
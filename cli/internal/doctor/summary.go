@@ -7,6 +7,8 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
 )
 
 type Item struct {
@@ -18,12 +20,16 @@ type Summary struct {
 	Items []Item
 }
 
-func Collect(root string) Summary {
+// Collect runs the checks for the repository at root. Stacks are detected
+// with the same catalog rules `codo init` uses.
+func Collect(root string, catalog []pack.Stack) Summary {
+	detected := pack.Detect(catalog, os.DirFS(root))
 	items := []Item{
+		checkStacks(detected),
 		checkGo(),
 		checkGoImports(),
 		checkNode(),
-		checkTSDeps(root),
+		checkTSDeps(root, detected),
 		checkPython3(),
 		checkPythonTools(),
 		checkDart(),
@@ -31,6 +37,18 @@ func Collect(root string) Summary {
 	return Summary{Items: items}
 }
 
+func checkStacks(detected []pack.Detection) Item {
+	const label = "Stacks"
+	if len(detected) == 0 {
+		return Item{Label: label, Detail: "none detected"}
+	}
+	found := make([]string, len(detected))
+	for i, d := range detected {
+		found[i] = d.String()
+	}
+	return Item{Label: label, Detail: "detected " + strings.Join(found, ", ")}
+}
+
 func checkGo() Item {
 	const label = "Go"
 	path, err := exec.LookPath("go")
@@ -75,8 +93,11 @@ func checkNode() Item {
 	return Item{Label: label, Detail: fmt.Sprintf("ok (%s)", strings.TrimSpace(string(out)))}
 }
 
-func checkTSDeps(root string) Item {
+func checkTSDeps(root string, detected []pack.Detection) Item {
 	const label = "TS/JS devDeps (project)"
+	if !pack.Has(detected, "typescript") {
+		return Item{Label: label, Detail: "no TypeScript/Node project detected (skipped)"}
+	}
 	pkgPath := filepath.Join(root, "package.json")
 	data, err := os.ReadFile(pkgPath)
 	if err != nil {
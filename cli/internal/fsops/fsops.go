@@ -1,13 +1,16 @@
 package fsops
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/journal"
 	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/settings"
 )
 
 func sha256File(path string) (string, error) {
@@ -25,11 +28,6 @@ func sha256File(path string) (string, error) {
 
 func CopySafe(f pack.File, projectRoot string, dry bool) (bool, error) {
 	dst := filepath.Join(projectRoot, f.RelPath)
-	if !dry {
-		if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
-			return false, err
-		}
-	}
 	srcBytes, err := f.Read()
 	if err != nil {
 		return false, err
@@ -50,7 +48,7 @@ func CopySafe(f pack.File, projectRoot string, dry bool) (bool, error) {
 		if dry {
 			return false, nil
 		}
-		if err := os.WriteFile(tmp, srcBytes, 0o644); err != nil {
+		if err := journal.WriteFile(tmp, srcBytes, 0o644); err != nil {
 			return false, err
 		}
 		return false, nil
@@ -60,24 +58,120 @@ func CopySafe(f pack.File, projectRoot string, dry bool) (bool, error) {
 	if dry {
 		return true, nil
 	}
-	if err := os.WriteFile(dst, srcBytes, 0o644); err != nil {
+	if err := journal.WriteFile(dst, srcBytes, 0o644); err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
+// Seed installs f only if the project has no copy yet; an existing file is
+// left alone whatever its content. It reports whether f was written.
+func Seed(f pack.File, projectRoot string, dry bool) (bool, error) {
+	dst := filepath.Join(projectRoot, f.RelPath)
+	if _, err := os.Stat(dst); err == nil {
+		fmt.Println("= " + f.RelPath + " (" + string(f.Policy) + ", kept)")
+		return false, nil
+	}
+	b, err := f.Read()
+	if err != nil {
+		return false, err
+	}
+	fmt.Println("+ " + f.RelPath)
+	if dry {
+		return true, nil
+	}
+	return true, journal.WriteFile(dst, b, 0o644)
+}
+
+// ChmodHooks marks hook scripts executable, including those stack overlays add.
 func ChmodHooks() error {
-	files := []string{
-		filepath.Join(".claude", "hooks", "pre_tool_use.py"),
-		filepath.Join(".claude", "hooks", "post_tool_use.py"),
-		filepath.Join(".claude", "hooks", "user_prompt_submit.py"),
+	files, err := filepath.Glob(filepath.Join(".claude", "hooks", "*"))
+	if err != nil {
+		return err
 	}
 	for _, p := range files {
-		if _, err := os.Stat(p); err == nil {
-			if err := os.Chmod(p, 0o755); err != nil {
+		switch filepath.Ext(p) {
+		case ".py", ".sh":
+		default:
+			continue
+		}
+		if info, err := os.Stat(p); err == nil && info.Mode().IsRegular() {
+			if err := journal.Chmod(p, 0o755); err != nil {
 				return err
 			}
 		}
 	}
 	return nil
 }
+
+// MergeJSON deep-merges the pack's JSON contribution f into the project's
+// copy. owned is the contribution recorded at the previous install (nil on
+// first install); what it holds and upstream dropped is removed, while keys
+// and rules added locally are kept. It returns the new contribution to record
+// as owned and the resulting file content.
+func MergeJSON(f pack.File, projectRoot string, owned []byte, dry bool) ([]byte, []byte, error) {
+	dst := filepath.Join(projectRoot, f.RelPath)
+	contrib, err := f.Read()
+	if err != nil {
+		return nil, nil, err
+	}
+	theirs, err := settings.Parse(contrib)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", f.RelPath, err)
+	}
+	base, err := settings.Parse(owned)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: recorded contribution: %w", f.RelPath, err)
+	}
+
+	cur, err := os.ReadFile(dst)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, nil, err
+		}
+		fmt.Println("+ " + f.RelPath)
+		out := settings.Encode(theirs)
+		return contrib, out, writeFile(dst, out, dry)
+	}
+	ours, err := settings.Parse(cur)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s is not valid JSON (%w); fix it and re-run", f.RelPath, err)
+	}
+	out := settings.Encode(settings.Merge(base, ours, theirs))
+	if bytes.Equal(out, cur) {
+		fmt.Println("= " + f.RelPath)
+		return contrib, cur, nil
+	}
+	fmt.Println("~ merged " + f.RelPath)
+	return contrib, out, writeFile(dst, out, dry)
+}
+
+// StripJSON removes the owned contribution from the JSON file at path and
+// reports whether anything the user added remains. When nothing remains the
+// file is left for the caller to delete.
+func StripJSON(path string, owned []byte, dry bool) (bool, error) {
+	cur, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	ours, err := settings.Parse(cur)
+	if err != nil {
+		return false, fmt.Errorf("%s is not valid JSON: %w", path, err)
+	}
+	base, err := settings.Parse(owned)
+	if err != nil {
+		return false, err
+	}
+	rest := settings.Merge(base, ours, settings.NewObject())
+	if rest.Len() == 0 {
+		return false, nil
+	}
+	return true, writeFile(path, settings.Encode(rest), dry)
+}
+
+func writeFile(path string, b []byte, dry bool) error {
+	if dry {
+		return nil
+	}
+	return journal.WriteFile(path, b, 0o644)
+}
@@ -0,0 +1,77 @@
+// Package history keeps a log of the changes codo made to a repository: one
+// JSON event per line in a file under statepath, appended by each command
+// that installs, updates or removes the toolkit.
+package history
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/journal"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/statepath"
+)
+
+// Event is one command's change to the repository.
+type Event struct {
+	// Time is when the command finished (RFC 3339, UTC).
+	Time       string `json:"time"`
+	Command    string `json:"command"`
+	CLIVersion string `json:"cli_version"`
+	// From and To are the pack versions installed before and after; empty
+	// when there was none.
+	From       string   `json:"from,omitempty"`
+	To         string   `json:"to,omitempty"`
+	Added      []string `json:"added,omitempty"`
+	Updated    []string `json:"updated,omitempty"`
+	Conflicted []string `json:"conflicted,omitempty"`
+	Removed    []string `json:"removed,omitempty"`
+}
+
+// Append adds e to the history of the repository at root. The write goes
+// through the journal, so it is undone with the command's other changes.
+func Append(root string, e Event) error {
+	path, err := statepath.HistoryPath(root)
+	if err != nil {
+		return err
+	}
+	b, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return journal.WriteFile(path, append(append(b, line...), '\n'), 0o644)
+}
+
+// Read returns the history of the repository at root, oldest first.
+func Read(root string) ([]Event, error) {
+	path, err := statepath.HistoryPath(root)
+	if err != nil {
+		return nil, err
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var events []Event
+	for i, line := range bytes.Split(b, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var e Event
+		if err := json.Unmarshal(line, &e); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, i+1, err)
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
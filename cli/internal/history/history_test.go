@@ -0,0 +1,57 @@
+package history
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/journal"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/statepath"
+)
+
+func TestAppendAndRead(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	root := t.TempDir()
+
+	if events, err := Read(root); err != nil || events != nil {
+		t.Fatalf("Read of an empty history = %v, %v", events, err)
+	}
+	for _, e := range []Event{
+		{Time: "2025-01-02T15:04:05Z", Command: "init", To: "1.0.0", Added: []string{"CLAUDE.md"}},
+		{Time: "2025-01-03T15:04:05Z", Command: "update", From: "1.0.0", To: "1.1.0", Updated: []string{"CLAUDE.md"}},
+	} {
+		if err := Append(root, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// An event appended by a command that fails is rolled back with it.
+	j, err := journal.Begin(t.TempDir(), "remove")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Append(root, Event{Command: "remove", From: "1.1.0"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := j.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := Read(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].Command != "init" || events[1].Command != "update" || events[1].From != "1.0.0" {
+		t.Fatalf("events = %+v, want init then update", events)
+	}
+
+	path, err := statepath.HistoryPath(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("{not json\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Read(root); err == nil {
+		t.Fatal("read a corrupt history")
+	}
+}
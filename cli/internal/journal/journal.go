@@ -0,0 +1,402 @@
+// Package journal makes the file changes of a codo command transactional.
+//
+// Before a file is first written, renamed or removed its original state is
+// recorded in a journal under statepath, with a backup copy when it existed.
+// Writes go to a temp file renamed into place. Commit discards the journal;
+// Rollback puts every touched path back the way it was. A journal left by a
+// crashed or killed process is found by Pending and can be rolled back later.
+package journal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const journalFile = "journal.json"
+
+// Op is the original state of a path the transaction touched.
+type Op struct {
+	Path    string      `json:"path"`
+	Existed bool        `json:"existed"`
+	Dir     bool        `json:"dir,omitempty"` // a directory the transaction created
+	Mode    fs.FileMode `json:"mode,omitempty"`
+	Backup  string      `json:"backup,omitempty"` // copy of the original, in the journal directory
+}
+
+// Journal records a transaction in progress.
+type Journal struct {
+	Command string    `json:"command"`
+	Started time.Time `json:"started"`
+	PID     int       `json:"pid"`
+	Ops     []Op      `json:"ops"`
+
+	dir  string
+	mu   sync.Mutex
+	seen map[string]bool
+	done chan struct{}
+}
+
+// PendingError reports a journal left by an earlier command.
+type PendingError struct{ Journal *Journal }
+
+func (e *PendingError) Error() string {
+	if e.Journal.Running() {
+		return fmt.Sprintf("`codo %s` (pid %d, started %s) is still changing this repository; wait for it to finish",
+			e.Journal.Command, e.Journal.PID, e.Journal.Started.Local().Format("2006-01-02 15:04"))
+	}
+	return fmt.Sprintf("an interrupted `codo %s` (%s) left changes half-applied; run `codo recover`",
+		e.Journal.Command, e.Journal.Started.Local().Format("2006-01-02 15:04"))
+}
+
+var (
+	activeMu sync.Mutex
+	active   *Journal
+)
+
+// Begin starts a transaction recorded in dir and makes it the one WriteFile,
+// Remove, Chmod and Rename go through. An interrupt (SIGINT, SIGTERM) rolls
+// it back and exits. It fails with a *PendingError when dir holds a journal
+// that was never committed or rolled back.
+func Begin(dir, command string) (*Journal, error) {
+	if j, err := Pending(dir); err != nil {
+		return nil, err
+	} else if j != nil {
+		return nil, &PendingError{Journal: j}
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	j := &Journal{Command: command, Started: time.Now().UTC(), PID: os.Getpid(), dir: dir, seen: map[string]bool{}, done: make(chan struct{})}
+	if err := j.save(); err != nil {
+		return nil, err
+	}
+	activeMu.Lock()
+	active = j
+	activeMu.Unlock()
+	go j.rollbackOnSignal(j.done)
+	return j, nil
+}
+
+// Pending returns the journal left in dir, or nil if there is none. The
+// command that wrote it may still be running; see Running.
+func Pending(dir string) (*Journal, error) {
+	b, err := os.ReadFile(filepath.Join(dir, journalFile))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	j := &Journal{dir: dir, seen: map[string]bool{}}
+	if err := json.Unmarshal(b, j); err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Join(dir, journalFile), err)
+	}
+	for _, op := range j.Ops {
+		j.seen[op.Path] = true
+	}
+	return j, nil
+}
+
+// Running reports whether the process that began the transaction is still
+// alive, so the journal belongs to a command in progress rather than one that
+// was interrupted. A journal of this process is never another run's.
+func (j *Journal) Running() bool {
+	return j.PID != os.Getpid() && alive(j.PID)
+}
+
+// alive reports whether a process with the given id exists. Windows only
+// finds running processes; elsewhere signal 0 probes without delivering one.
+func alive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	err = p.Signal(syscall.Signal(0))
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
+
+// Active returns the transaction in progress, or nil.
+func Active() *Journal {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	return active
+}
+
+// Commit keeps the changes and discards the journal.
+func (j *Journal) Commit() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.finish()
+	return os.RemoveAll(j.dir)
+}
+
+// Rollback restores every path the transaction touched, newest first, and
+// discards the journal. If a path cannot be restored the journal is kept so
+// the rollback can be retried.
+func (j *Journal) Rollback() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.finish()
+	return j.rollback()
+}
+
+func (j *Journal) rollback() error {
+	var errs []error
+	for i := len(j.Ops) - 1; i >= 0; i-- {
+		if err := j.undo(j.Ops[i]); err != nil {
+			errs = append(errs, fmt.Errorf("restore %s: %w", j.Ops[i].Path, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return os.RemoveAll(j.dir)
+}
+
+func (j *Journal) undo(op Op) error {
+	switch {
+	case op.Dir:
+		// Left in place if something else was put in it meanwhile.
+		if err := os.Remove(op.Path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			if entries, rerr := os.ReadDir(op.Path); rerr == nil && len(entries) > 0 {
+				return nil
+			}
+			return err
+		}
+		return nil
+	case !op.Existed:
+		if err := os.Remove(op.Path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	b, err := os.ReadFile(filepath.Join(j.dir, op.Backup))
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(op.Path), 0o755); err != nil {
+		return err
+	}
+	return writeAtomic(op.Path, b, op.Mode)
+}
+
+// Changed returns the paths the transaction touched, in order.
+func (j *Journal) Changed() []string {
+	var out []string
+	for _, op := range j.Ops {
+		if !op.Dir {
+			out = append(out, op.Path)
+		}
+	}
+	return out
+}
+
+// finish stops the interrupt handler and unsets the active transaction.
+// Callers hold j.mu.
+func (j *Journal) finish() {
+	activeMu.Lock()
+	if active == j {
+		active = nil
+	}
+	activeMu.Unlock()
+	if j.done != nil {
+		close(j.done)
+		j.done = nil
+	}
+}
+
+func (j *Journal) rollbackOnSignal(done <-chan struct{}) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(ch)
+	select {
+	case <-done:
+		return
+	case <-ch:
+	}
+	// Holding the lock waits out the change in flight and keeps the
+	// interrupted command from making another.
+	j.mu.Lock()
+	if err := j.rollback(); err != nil {
+		fmt.Fprintf(os.Stderr, "\nInterrupted; rollback failed: %v\nRun `codo recover` to retry.\n", err)
+	} else {
+		fmt.Fprintln(os.Stderr, "\nInterrupted; all changes rolled back.")
+	}
+	os.Exit(130)
+}
+
+// touch records the original state of path before its first change.
+// Callers hold j.mu.
+func (j *Journal) touch(path string) error {
+	if j.seen[path] {
+		return nil
+	}
+	// Directories the change will create go first, outermost first, so
+	// rollback removes them after their contents.
+	var missing []string
+	for dir := filepath.Dir(path); ; dir = filepath.Dir(dir) {
+		if _, err := os.Lstat(dir); err == nil || dir == filepath.Dir(dir) {
+			break
+		}
+		missing = append(missing, dir)
+	}
+	for i := len(missing) - 1; i >= 0; i-- {
+		if !j.seen[missing[i]] {
+			j.seen[missing[i]] = true
+			j.Ops = append(j.Ops, Op{Path: missing[i], Dir: true})
+		}
+	}
+
+	op := Op{Path: path}
+	info, err := os.Lstat(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return err
+	case !info.Mode().IsRegular():
+		return fmt.Errorf("%s is not a regular file", path)
+	default:
+		op.Existed = true
+		op.Mode = info.Mode().Perm()
+		op.Backup = strconv.Itoa(len(j.Ops))
+		if err := copyFile(path, filepath.Join(j.dir, op.Backup)); err != nil {
+			return fmt.Errorf("back up %s: %w", path, err)
+		}
+	}
+	j.seen[path] = true
+	j.Ops = append(j.Ops, op)
+	return j.save()
+}
+
+// save writes the journal out. It is replaced atomically so a crash leaves
+// either the old or the new record.
+func (j *Journal) save() error {
+	b, err := json.MarshalIndent(j, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeAtomic(filepath.Join(j.dir, journalFile), b, 0o644)
+}
+
+// do runs change on name, first journaling it when a transaction is active.
+func do(names []string, change func() error) error {
+	j := Active()
+	if j == nil {
+		return change()
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, name := range names {
+		abs, err := filepath.Abs(name)
+		if err != nil {
+			return err
+		}
+		if err := j.touch(abs); err != nil {
+			return err
+		}
+	}
+	return change()
+}
+
+// WriteFile writes data to name through a temp file renamed into place,
+// creating parent directories as needed. An existing file keeps its mode.
+func WriteFile(name string, data []byte, perm fs.FileMode) error {
+	return do([]string{name}, func() error {
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			return err
+		}
+		if info, err := os.Stat(name); err == nil {
+			perm = info.Mode().Perm()
+		}
+		return writeAtomic(name, data, perm)
+	})
+}
+
+// Remove removes the file name.
+func Remove(name string) error {
+	return do([]string{name}, func() error { return os.Remove(name) })
+}
+
+// Chmod changes the mode of the file name.
+func Chmod(name string, mode fs.FileMode) error {
+	return do([]string{name}, func() error { return os.Chmod(name, mode) })
+}
+
+// Rename moves the file src to dst, copying when they are on different
+// file systems.
+func Rename(src, dst string) error {
+	return do([]string{src, dst}, func() error {
+		if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+			return err
+		}
+		if err := os.Rename(src, dst); err == nil || errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		if err := copyFile(src, dst); err != nil {
+			return err
+		}
+		return os.Remove(src)
+	})
+}
+
+func writeAtomic(name string, data []byte, perm fs.FileMode) error {
+	f, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".codo-*")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp, perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp, name)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
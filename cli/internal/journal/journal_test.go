@@ -0,0 +1,162 @@
+package journal
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRollbackRestoresTouchedFiles(t *testing.T) {
+	repo := t.TempDir()
+	dir := filepath.Join(t.TempDir(), "journal")
+	edited := filepath.Join(repo, "CLAUDE.md")
+	removed := filepath.Join(repo, "old.md")
+	created := filepath.Join(repo, ".claude", "agents", "new.md")
+	moved := filepath.Join(repo, "moved.md")
+	backup := filepath.Join(t.TempDir(), "backup", "moved.md")
+	for path, s := range map[string]string{edited: "mine\n", removed: "old\n", moved: "moved\n"} {
+		if err := os.WriteFile(path, []byte(s), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	j, err := Begin(dir, "update")
+	if err != nil {
+		t.Fatal(err)
+	}
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	must(WriteFile(edited, []byte("first\n"), 0o644))
+	must(WriteFile(edited, []byte("second\n"), 0o644))
+	must(Remove(removed))
+	must(WriteFile(created, []byte("new\n"), 0o644))
+	must(Rename(moved, backup))
+	if _, err := Begin(dir, "init"); !errors.As(err, new(*PendingError)) {
+		t.Fatalf("second Begin: %v, want a PendingError", err)
+	}
+	must(j.Rollback())
+
+	if b, err := os.ReadFile(edited); err != nil || string(b) != "mine\n" {
+		t.Fatalf("CLAUDE.md = %q, %v", b, err)
+	}
+	if info, _ := os.Stat(edited); info.Mode().Perm() != 0o600 {
+		t.Fatalf("CLAUDE.md mode = %v, want 0600", info.Mode().Perm())
+	}
+	if b, err := os.ReadFile(removed); err != nil || string(b) != "old\n" {
+		t.Fatalf("old.md = %q, %v", b, err)
+	}
+	if b, err := os.ReadFile(moved); err != nil || string(b) != "moved\n" {
+		t.Fatalf("moved.md = %q, %v", b, err)
+	}
+	for _, gone := range []string{filepath.Join(repo, ".claude"), filepath.Dir(backup), dir} {
+		if _, err := os.Stat(gone); !os.IsNotExist(err) {
+			t.Fatalf("%s survived the rollback", gone)
+		}
+	}
+	if Active() != nil {
+		t.Fatal("transaction still active after rollback")
+	}
+}
+
+func TestPendingJournalRollsBackLater(t *testing.T) {
+	repo := t.TempDir()
+	dir := filepath.Join(t.TempDir(), "journal")
+	path := filepath.Join(repo, "settings.json")
+	if err := os.WriteFile(path, []byte("{}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	j, err := Begin(dir, "init")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFile(path, []byte(`{"hooks":{}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// The process dies here: nothing commits or rolls back.
+	j.finish()
+
+	left, err := Pending(dir)
+	if err != nil || left == nil {
+		t.Fatalf("Pending = %v, %v", left, err)
+	}
+	if left.Command != "init" || len(left.Changed()) != 1 || left.Changed()[0] != path {
+		t.Fatalf("leftover journal = %+v", left)
+	}
+	if err := left.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := os.ReadFile(path); string(b) != "{}\n" {
+		t.Fatalf("settings.json = %q after recovery", b)
+	}
+	if left, _ := Pending(dir); left != nil {
+		t.Fatal("journal survived the rollback")
+	}
+}
+
+func TestCommitKeepsChanges(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "journal")
+	path := filepath.Join(t.TempDir(), "a", "b.md")
+	j, err := Begin(dir, "init")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFile(path, []byte("b\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := j.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := os.ReadFile(path); string(b) != "b\n" {
+		t.Fatalf("b.md = %q", b)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Fatal("journal survived the commit")
+	}
+}
+
+func TestJournalOfALiveProcessIsStillRunning(t *testing.T) {
+	sleep, err := exec.LookPath("sleep")
+	if err != nil {
+		t.Skip("sleep not installed")
+	}
+	other := exec.Command(sleep, "60")
+	if err := other.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = other.Process.Kill() })
+
+	dir := filepath.Join(t.TempDir(), "journal")
+	j, err := Begin(dir, "update")
+	if err != nil {
+		t.Fatal(err)
+	}
+	j.finish()
+	// The journal is the other process's, which is busy with it.
+	j.PID = other.Process.Pid
+	if err := j.save(); err != nil {
+		t.Fatal(err)
+	}
+	left, err := Pending(dir)
+	if err != nil || left == nil || !left.Running() {
+		t.Fatalf("Pending = %+v, %v; want the running update's journal", left, err)
+	}
+	if _, err := Begin(dir, "init"); err == nil || !strings.Contains(err.Error(), "still changing") {
+		t.Fatalf("Begin during a running update: %v", err)
+	}
+
+	_ = other.Process.Kill()
+	_ = other.Wait()
+	if left.Running() {
+		t.Fatal("journal still running after its process exited")
+	}
+	if _, err := Begin(dir, "init"); err == nil || !strings.Contains(err.Error(), "interrupted") {
+		t.Fatalf("Begin after the update died: %v", err)
+	}
+}
@@ -0,0 +1,186 @@
+package manifest
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/journal"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+)
+
+// LockPath is where a repository commits its lock, relative to its root.
+const LockPath = ".claude/codo.lock"
+
+// lockVersion is the lock format written by this version.
+const lockVersion = 1
+
+// Lock is the part of a manifest a team commits: the pinned pack, the
+// selection and the hash of every installed file. Unlike the manifest it holds
+// nothing specific to one machine, so it only changes when the install does.
+type Lock struct {
+	LockVersion int    `json:"lock_version"`
+	Source      string `json:"source"`
+	Version     string `json:"version"`
+	Tag         string `json:"tag,omitempty"`
+	Digest      string `json:"digest,omitempty"`
+	URL         string `json:"url,omitempty"`
+	// Layers are the packs composed over the upstream one, in order.
+	Layers      []Layer      `json:"layers,omitempty"`
+	Stacks      []string     `json:"stacks,omitempty"`
+	Snippets    []string     `json:"snippets,omitempty"`
+	HooksTarget string       `json:"hooks_target,omitempty"`
+	Files       []LockedFile `json:"files"`
+}
+
+// LockedFile is the recorded state of one installed file; see Entry. Local
+// is the hash of a managed file's local changes the team accepted with
+// `codo lock`: the file is expected to hold them rather than the pack's copy,
+// whose hash SHA256 still is.
+type LockedFile struct {
+	Path      string      `json:"path"`
+	SHA256    string      `json:"sha256"`
+	Local     string      `json:"local,omitempty"`
+	Policy    pack.Policy `json:"policy,omitempty"`
+	Unmanaged bool        `json:"unmanaged,omitempty"`
+}
+
+// NewLock returns the lock of the install m.
+func NewLock(m Manifest) Lock {
+	l := Lock{
+		LockVersion: lockVersion,
+		Source:      portable(m.PackSource()),
+		Version:     m.Version,
+		Tag:         m.Tag,
+		Digest:      m.Digest,
+		URL:         m.URL,
+		Stacks:      m.Stacks,
+		Snippets:    m.Snippets,
+		HooksTarget: m.HooksTarget,
+		Files:       []LockedFile{},
+	}
+	for _, layer := range m.Layers {
+		layer.Source = portable(layer.Source)
+		l.Layers = append(l.Layers, layer)
+	}
+	for _, ent := range m.Files {
+		policy := ent.Policy
+		switch {
+		case ent.Owned != nil:
+			policy = pack.PolicyMergeJSON
+		case policy == pack.PolicyManaged:
+			policy = "" // the default, as locks have always left it
+		}
+		l.Files = append(l.Files, LockedFile{Path: ent.Path, SHA256: ent.SHA256, Policy: policy, Unmanaged: ent.Unmanaged})
+	}
+	slices.SortFunc(l.Files, func(a, b LockedFile) int { return strings.Compare(a.Path, b.Path) })
+	return l
+}
+
+// portable returns a dir: or zip: source spec with its path relative to the
+// repository, so the lock names the same pack on every checkout; commands run
+// from the repository's top, where such paths resolve.
+func portable(spec string) string {
+	for _, prefix := range []string{"dir:", "zip:"} {
+		path, ok := strings.CutPrefix(spec, prefix)
+		if !ok {
+			continue
+		}
+		if filepath.IsAbs(path) {
+			root, err := repoRoot()
+			if err != nil {
+				return spec
+			}
+			if path, err = filepath.Rel(root, path); err != nil {
+				return spec
+			}
+		}
+		return prefix + filepath.ToSlash(path)
+	}
+	return spec
+}
+
+// Manifest returns the pin and selection the lock records, for resolving the
+// pack it was installed from. The file list is left empty.
+func (l Lock) Manifest() Manifest {
+	return Manifest{
+		Version:     l.Version,
+		Source:      l.Source,
+		Tag:         l.Tag,
+		Digest:      l.Digest,
+		URL:         l.URL,
+		Layers:      l.Layers,
+		Stacks:      l.Stacks,
+		Snippets:    l.Snippets,
+		HooksTarget: l.HooksTarget,
+	}
+}
+
+// LockExists reports whether the repository commits a lock.
+func LockExists() bool {
+	_, err := os.Stat(LockPath)
+	return err == nil
+}
+
+// OpenLock reads the repository's lock.
+func OpenLock() (Lock, error) {
+	var l Lock
+	b, err := os.ReadFile(LockPath)
+	if err != nil {
+		return l, err
+	}
+	if err := json.Unmarshal(b, &l); err != nil {
+		return l, fmt.Errorf("%s: %w", LockPath, err)
+	}
+	if l.LockVersion > lockVersion {
+		return l, fmt.Errorf("%s was written by a newer codo (lock version %d); upgrade with `codo upgrade`", LockPath, l.LockVersion)
+	}
+	return l, nil
+}
+
+// SaveLock writes the lock of m to the repository. Local changes the lock
+// accepted are kept for files whose pack content is unchanged.
+func SaveLock(m Manifest) error {
+	l := NewLock(m)
+	if old, err := OpenLock(); err == nil {
+		local := map[string]LockedFile{}
+		for _, lf := range old.Files {
+			local[lf.Path] = lf
+		}
+		for i, lf := range l.Files {
+			if prev := local[lf.Path]; prev.SHA256 == lf.SHA256 {
+				l.Files[i].Local = prev.Local
+			}
+		}
+	}
+	return writeLock(l)
+}
+
+// SaveLockAccepting writes the lock of m accepting the local changes made to
+// managed files since they were installed, so sync expects them instead of
+// reporting them.
+func SaveLockAccepting(m Manifest) error {
+	l := NewLock(m)
+	for i, lf := range l.Files {
+		if lf.Policy != "" || lf.Unmanaged {
+			continue
+		}
+		b, err := os.ReadFile(lf.Path)
+		if err != nil {
+			continue
+		}
+		if sum := fmt.Sprintf("%x", sha256.Sum256(b)); sum != lf.SHA256 {
+			l.Files[i].Local = sum
+		}
+	}
+	return writeLock(l)
+}
+
+func writeLock(l Lock) error {
+	buf, _ := json.MarshalIndent(l, "", "  ")
+	return journal.WriteFile(LockPath, append(buf, '\n'), 0o644)
+}
@@ -3,27 +3,107 @@ package manifest
 import (
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/journal"
 	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
 	"github.com/hergert/codo-agentic-toolkit/cli/internal/statepath"
 )
 
+// schemaVersion is the manifest format written by this version: 1 was the
+// unversioned format; 2 added timestamps, the writing CLI and each file's
+// policy, stack and mode. Older manifests are upgraded when read.
+const schemaVersion = 2
+
+// CLIVersion is the version of codo recorded in the manifests it writes.
+var CLIVersion = "dev"
+
 type Entry struct {
 	Path      string `json:"path"`
 	SHA256    string `json:"sha256"`
 	Unmanaged bool   `json:"unmanaged,omitempty"`
+	// Owned is the JSON contribution codo merged into a settings file: the
+	// keys and permission rules it owns and may later change or remove.
+	Owned json.RawMessage `json:"owned,omitempty"`
+	// Policy is how the file was installed, so it is handled correctly once
+	// the pack drops it.
+	Policy pack.Policy `json:"policy,omitempty"`
+	// Layer names the pack layer(s) the file came from in a layered install.
+	Layer string `json:"layer,omitempty"`
+	// Stack names the stack overlay(s) that provide or extend the file.
+	Stack string `json:"stack,omitempty"`
+	// Mode is the file's permission bits as installed, in octal.
+	Mode string `json:"mode,omitempty"`
 }
+
+// Layer is an organization or team pack installed on top of the upstream
+// pack, pinned the same way.
+type Layer struct {
+	Name    string `json:"name"`
+	Source  string `json:"source"`
+	Version string `json:"version,omitempty"`
+	Tag     string `json:"tag,omitempty"`
+	Digest  string `json:"digest,omitempty"`
+	URL     string `json:"url,omitempty"`
+}
+
 type Manifest struct {
-	Version     string   `json:"version"`
+	SchemaVersion int `json:"schema_version"`
+	// Version is the concrete pack version installed; Source is the spec of
+	// where the pack came from (see pack.ParseSource).
+	Version string `json:"version"`
+	Source  string `json:"source,omitempty"`
+	// Tag, Digest and URL pin a fetched pack: the release tag "latest" or
+	// "edge" resolved to (or the git commit), the sha256 of the pack zip and
+	// where it came from.
+	Tag    string `json:"tag,omitempty"`
+	Digest string `json:"digest,omitempty"`
+	URL    string `json:"url,omitempty"`
+	// InstalledAt and UpdatedAt are when the install was made and last
+	// changed (RFC 3339, UTC); CLIVersion is the codo that last wrote it.
 	InstalledAt string   `json:"installed_at"`
+	UpdatedAt   string   `json:"updated_at,omitempty"`
+	CLIVersion  string   `json:"cli_version,omitempty"`
 	Files       []Entry  `json:"files"`
 	Stacks      []string `json:"stacks,omitempty"`
+	HooksTarget string   `json:"hooks_target,omitempty"`
+	Snippets    []string `json:"snippets,omitempty"`
+	// Layers are the packs composed over the upstream one, in order.
+	Layers []Layer `json:"layers,omitempty"`
+	// Root is the checkout the manifest was last written from.
+	Root string `json:"root,omitempty"`
+	// Migrated is set when the install was found where older versions of
+	// codo kept it and moved.
+	Migrated *Migration `json:"migrated,omitempty"`
+}
+
+// Migration records where an install was found when it was moved.
+type Migration struct {
+	From string `json:"from"`
+	At   string `json:"at,omitempty"`
+}
+
+// PackSource returns where the installed pack came from. Manifests written
+// before Source existed kept it in Version.
+func (m Manifest) PackSource() string {
+	if m.Source != "" {
+		return m.Source
+	}
+	return m.Version
 }
 
+// Selection returns what was chosen at install time, for recomposing the pack.
+func (m Manifest) Selection() pack.Selection {
+	return pack.Selection{Stacks: m.Stacks, HooksTarget: m.HooksTarget, Snippets: m.Snippets}
+}
+
+// repoRoot is the working directory, which commands move to the top of the
+// git work tree before using the manifest.
 func repoRoot() (string, error) {
 	return os.Getwd()
 }
@@ -61,17 +141,12 @@ func Exists() bool {
 }
 
 func Write(files []pack.File, version string) error {
-	return WriteWithStacks(files, version, nil, nil)
+	return WriteWithStacks(files, Manifest{Version: version}, nil, nil)
 }
 
-func WriteWithStacks(files []pack.File, version string, stacks []string, unmanaged map[string]bool) error {
-	path, err := manifestPath()
-	if err != nil {
-		return err
-	}
-	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
-		return err
-	}
+// WriteWithStacks records a fresh install of files. m carries the pack and
+// selection details; its file list is replaced.
+func WriteWithStacks(files []pack.File, m Manifest, unmanaged map[string]bool, owned map[string][]byte) error {
 	var entries []Entry
 	for _, f := range files {
 		// use on-disk hash if placed; else hash of new content
@@ -86,51 +161,312 @@ func WriteWithStacks(files []pack.File, version string, stacks []string, unmanag
 			}
 			sum = fmt.Sprintf("%x", sha256.Sum256(b))
 		}
-		entries = append(entries, Entry{Path: dst, SHA256: sum, Unmanaged: unmanaged != nil && unmanaged[dst]})
+		isUnmanaged := unmanaged != nil && unmanaged[dst]
+		if f.Policy == pack.PolicyMergeJSON {
+			entries = append(entries, Entry{Path: dst, SHA256: sum, Owned: owned[dst], Layer: f.Layer, Stack: f.Stack})
+			continue
+		}
+		if f.Policy == pack.PolicySeedOnce || f.Policy == pack.PolicyNeverOverwrite {
+			entries = append(entries, Entry{Path: dst, SHA256: sum, Policy: f.Policy, Layer: f.Layer, Stack: f.Stack})
+			continue
+		}
+		if !isUnmanaged {
+			b, err := f.Read()
+			if err != nil {
+				return err
+			}
+			if err := SaveBase(b); err != nil {
+				return err
+			}
+		}
+		entries = append(entries, Entry{Path: dst, SHA256: sum, Unmanaged: isUnmanaged, Layer: f.Layer, Stack: f.Stack})
 	}
-	m := Manifest{Version: version, InstalledAt: "", Files: entries, Stacks: stacks}
-	buf, _ := json.MarshalIndent(m, "", "  ")
-	if err := os.WriteFile(path, buf, 0o644); err != nil {
+	m.Files = entries
+	m.InstalledAt = ""
+	return Save(m)
+}
+
+// Save writes m as the repository's manifest, replacing any legacy in-repo
+// copy, and keeps the repository's lock, if it has one, in step. It stamps
+// the update time (and the install time of a fresh install) and completes the
+// policy and mode of new entries.
+func Save(m Manifest) error {
+	path, err := manifestPath()
+	if err != nil {
+		return err
+	}
+	if m.Root, err = repoRoot(); err != nil {
+		return err
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	if m.InstalledAt == "" {
+		m.InstalledAt = now
+	}
+	m.UpdatedAt = now
+	m.CLIVersion = CLIVersion
+	m.SchemaVersion = schemaVersion
+	m.Files = completeEntries(m.Files)
+	if err := journal.WriteFile(path, encode(m), 0o644); err != nil {
 		return err
 	}
 	if legacy, err := legacyManifestPath(); err == nil {
-		_ = os.Remove(legacy)
+		if _, err := os.Stat(legacy); err == nil {
+			_ = journal.Remove(legacy)
+		}
+	}
+	if LockExists() {
+		return SaveLock(m)
+	}
+	return nil
+}
+
+// Migrate brings an install recorded by an older version of codo up to date:
+// a manifest kept in the repository or keyed by the repository's path moves
+// to the repository's identity, noting where it was found, and an older
+// manifest format is upgraded.
+func Migrate() error {
+	root, err := repoRoot()
+	if err != nil {
+		return err
+	}
+	from, err := statepath.MigratePathKeyed(root)
+	if err != nil {
+		return err
+	}
+	path, err := manifestPath()
+	if err != nil {
+		return err
+	}
+	legacy, err := legacyManifestPath()
+	if err != nil {
+		return err
+	}
+	m, src, upgraded, err := open()
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if from == "" && src == path && !upgraded {
+		return nil
+	}
+	if from != "" {
+		m.Migrated = &Migration{From: from, At: time.Now().UTC().Format(time.RFC3339)}
+	}
+	m.Root = root
+	m.CLIVersion = CLIVersion
+	m.Files = completeEntries(m.Files)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, encode(m), 0o644); err != nil {
+		return err
+	}
+	if src == legacy {
+		return os.Remove(legacy)
 	}
 	return nil
 }
 
+// upgrade brings a manifest read from path to the current format. It reports
+// whether anything changed.
+func upgrade(m *Manifest, path string) (bool, error) {
+	if m.SchemaVersion > schemaVersion {
+		return false, fmt.Errorf("%s was written by a newer codo (manifest schema %d); upgrade with `codo upgrade`", path, m.SchemaVersion)
+	}
+	if m.SchemaVersion == schemaVersion {
+		return false, nil
+	}
+	// Before version 2 nothing recorded when: the last write is the best
+	// estimate for both.
+	if info, err := os.Stat(path); err == nil {
+		at := info.ModTime().UTC().Format(time.RFC3339)
+		if m.InstalledAt == "" {
+			m.InstalledAt = at
+		}
+		if m.UpdatedAt == "" {
+			m.UpdatedAt = at
+		}
+	}
+	completePolicies(m.Files)
+	m.SchemaVersion = schemaVersion
+	return true, nil
+}
+
+// completePolicies fills in the policy of entries that leave it implied:
+// merged settings, or managed.
+func completePolicies(entries []Entry) {
+	for i := range entries {
+		ent := &entries[i]
+		if ent.Policy == "" {
+			ent.Policy = pack.PolicyManaged
+			if ent.Owned != nil {
+				ent.Policy = pack.PolicyMergeJSON
+			}
+		}
+	}
+}
+
+// completeEntries completes the policies of entries and records the mode of
+// the repository's files that have none.
+func completeEntries(entries []Entry) []Entry {
+	completePolicies(entries)
+	for i := range entries {
+		ent := &entries[i]
+		if ent.Mode == "" {
+			if info, err := os.Stat(ent.Path); err == nil {
+				ent.Mode = FileMode(info.Mode())
+			}
+		}
+	}
+	return entries
+}
+
+// FileMode formats the permission bits of mode the way entries record them.
+func FileMode(mode fs.FileMode) string {
+	return fmt.Sprintf("%04o", mode.Perm())
+}
+
+func encode(m Manifest) []byte {
+	buf, _ := json.MarshalIndent(m, "", "  ")
+	return buf
+}
+
+// SnapshotFile is the name of the manifest kept with a snapshot of replaced
+// files, recording the install they belonged to.
+const SnapshotFile = ".codo-manifest.json"
+
+// SaveSnapshot records m in the snapshot directory dir.
+func SaveSnapshot(dir string, m Manifest) error {
+	return journal.WriteFile(filepath.Join(dir, SnapshotFile), encode(m), 0o644)
+}
+
+// OpenSnapshot reads the manifest recorded in the snapshot directory dir.
+func OpenSnapshot(dir string) (Manifest, error) {
+	var m Manifest
+	path := filepath.Join(dir, SnapshotFile)
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return m, err
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return m, err
+	}
+	_, err = upgrade(&m, path)
+	return m, err
+}
+
+// SaveBase stores the pristine pack content of an installed file so later
+// updates can three-way merge against it.
+func SaveBase(b []byte) error {
+	path, err := statepath.BasePath(fmt.Sprintf("%x", sha256.Sum256(b)))
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o644)
+}
+
+// LoadBase returns the stored pack content with the given SHA256 digest.
+func LoadBase(sum string) ([]byte, error) {
+	path, err := statepath.BasePath(sum)
+	if err != nil {
+		return nil, err
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if fmt.Sprintf("%x", sha256.Sum256(b)) != sum {
+		return nil, fmt.Errorf("stored base %s is corrupt", sum)
+	}
+	return b, nil
+}
+
+// Open reads the repository's manifest, upgraded to the current format.
 func Open() (Manifest, error) {
+	m, _, _, err := open()
+	return m, err
+}
+
+// open reads the repository's manifest, falling back to the legacy in-repo
+// copy, and upgrades it. It returns the path read and whether the format
+// was upgraded.
+func open() (Manifest, string, bool, error) {
 	var m Manifest
 	path, err := manifestPath()
 	if err == nil {
-		if f, err := os.Open(path); err == nil {
-			defer f.Close()
-			b, _ := io.ReadAll(f)
-			err = json.Unmarshal(b, &m)
-			if err == nil {
-				return m, nil
+		if b, err := os.ReadFile(path); err == nil {
+			if err := json.Unmarshal(b, &m); err == nil {
+				upgraded, err := upgrade(&m, path)
+				return m, path, upgraded, err
 			}
 		}
 	}
 	legacy, err := legacyManifestPath()
 	if err != nil {
-		return m, err
+		return m, "", false, err
 	}
-	f, err := os.Open(legacy)
+	b, err := os.ReadFile(legacy)
 	if err != nil {
-		return m, err
+		return m, "", false, err
 	}
-	defer f.Close()
-	b, _ := io.ReadAll(f)
-	err = json.Unmarshal(b, &m)
-	return m, err
+	if err := json.Unmarshal(b, &m); err != nil {
+		return m, "", false, err
+	}
+	if m.Migrated == nil {
+		// Moved out of the repository by Migrate or the next save.
+		m.Migrated = &Migration{From: legacy, At: time.Now().UTC().Format(time.RFC3339)}
+	}
+	upgraded, err := upgrade(&m, legacy)
+	return m, legacy, upgraded, err
 }
 
-func Remove() {
-	if path, err := manifestPath(); err == nil {
-		_ = os.Remove(path)
+// All returns the manifests of every repository installed on this machine.
+// Unreadable manifests are skipped.
+func All() ([]Manifest, error) {
+	dir, err := statepath.ManifestsDir()
+	if err != nil {
+		return nil, err
 	}
-	if legacy, err := legacyManifestPath(); err == nil {
-		_ = os.Remove(legacy)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var out []Manifest
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			continue
+		}
+		var m Manifest
+		if json.Unmarshal(b, &m) == nil {
+			if _, err := upgrade(&m, filepath.Join(dir, e.Name())); err == nil {
+				out = append(out, m)
+			}
+		}
+	}
+	return out, nil
+}
+
+func Remove() {
+	for _, path := range []func() (string, error){manifestPath, legacyManifestPath} {
+		if p, err := path(); err == nil {
+			if _, err := os.Stat(p); err == nil {
+				_ = journal.Remove(p)
+			}
+		}
 	}
 }
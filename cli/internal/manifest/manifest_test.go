@@ -0,0 +1,104 @@
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack"
+)
+
+func TestMigrateUpgradesLegacyManifest(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	legacy := filepath.Join(".claude", ".codo-manifest.json")
+	if err := os.MkdirAll(".claude/hooks", 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(".claude/hooks/guard.sh", []byte("#!/bin/sh\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	old := `{"version":"1.1.0","installed_at":"","files":[
+		{"path":".claude/hooks/guard.sh","sha256":"x"},
+		{"path":".claude/settings.json","sha256":"y","owned":{"a":1}},
+		{"path":".claude/notes.md","sha256":"z","policy":"seed-once"}]}`
+	if err := os.WriteFile(legacy, []byte(old), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Migrate(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(legacy); !os.IsNotExist(err) {
+		t.Fatalf("legacy manifest still in the repository: %v", err)
+	}
+	m, err := Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.SchemaVersion != schemaVersion || m.InstalledAt == "" || m.UpdatedAt == "" || m.Migrated == nil {
+		t.Fatalf("manifest not upgraded: schema %d, installed %q, updated %q, migrated %v", m.SchemaVersion, m.InstalledAt, m.UpdatedAt, m.Migrated)
+	}
+	want := []struct {
+		policy pack.Policy
+		mode   string
+	}{{pack.PolicyManaged, "0755"}, {pack.PolicyMergeJSON, ""}, {pack.PolicySeedOnce, ""}}
+	for i, w := range want {
+		if ent := m.Files[i]; ent.Policy != w.policy || ent.Mode != w.mode {
+			t.Errorf("%s: policy %q mode %q, want %q %q", ent.Path, ent.Policy, ent.Mode, w.policy, w.mode)
+		}
+	}
+
+	path, err := manifestPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(`{"schema_version":99,"version":"9.0.0","files":[]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(); err == nil {
+		t.Fatal("opened a manifest from a newer codo")
+	}
+}
+
+func TestNewLockRecordsSourcesRelativeToTheRepository(t *testing.T) {
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	m := Manifest{
+		Source: "dir:" + filepath.Join(dir, "vendor", "pack"),
+		Layers: []Layer{
+			{Name: "org", Source: "zip:" + filepath.Join(filepath.Dir(dir), "org.zip")},
+			{Name: "team", Source: "git+https://example.com/team-pack@main"},
+		},
+	}
+	l := NewLock(m)
+	if l.Source != "dir:vendor/pack" {
+		t.Errorf("source = %q, want dir:vendor/pack", l.Source)
+	}
+	if l.Layers[0].Source != "zip:../org.zip" || l.Layers[1].Source != m.Layers[1].Source {
+		t.Errorf("layer sources = %q, %q", l.Layers[0].Source, l.Layers[1].Source)
+	}
+	if m.Layers[0].Source == l.Layers[0].Source {
+		t.Error("NewLock changed the manifest's layers")
+	}
+}
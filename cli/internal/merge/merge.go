@@ -0,0 +1,256 @@
+package merge
+
+import "bytes"
+
+// Result is the outcome of a three-way merge.
+type Result struct {
+	Data      []byte
+	Conflicts int
+}
+
+// Labels name the two sides in conflict markers.
+type Labels struct {
+	Ours   string
+	Theirs string
+}
+
+// DefaultLabels are used when ThreeWay is called with empty labels.
+var DefaultLabels = Labels{Ours: "local", Theirs: "upstream"}
+
+type hunk struct {
+	aStart, aEnd int // replaced range in base
+	bStart, bEnd int // replacement range in the other side
+}
+
+// ThreeWay merges ours and theirs, which both descend from base, line by line.
+// Changes made on only one side are applied; identical changes on both sides
+// are applied once; overlapping changes produce a conflict block delimited by
+// git-style markers and are counted in Result.Conflicts.
+func ThreeWay(base, ours, theirs []byte, labels Labels) Result {
+	if labels.Ours == "" {
+		labels.Ours = DefaultLabels.Ours
+	}
+	if labels.Theirs == "" {
+		labels.Theirs = DefaultLabels.Theirs
+	}
+	b, o, t := splitLines(base), splitLines(ours), splitLines(theirs)
+	oh := diff(b, o)
+	th := diff(b, t)
+
+	var out [][]byte
+	conflicts := 0
+	pos := 0
+	i, j := 0, 0
+	for i < len(oh) || j < len(th) {
+		// Start a region at the earliest pending hunk, then absorb every hunk
+		// from either side that touches it.
+		start, end := 0, 0
+		if j >= len(th) || (i < len(oh) && oh[i].aStart <= th[j].aStart) {
+			start, end = oh[i].aStart, oh[i].aEnd
+		} else {
+			start, end = th[j].aStart, th[j].aEnd
+		}
+		i0, j0 := i, j
+		for {
+			grew := false
+			for i < len(oh) && oh[i].aStart <= end {
+				end = max(end, oh[i].aEnd)
+				i++
+				grew = true
+			}
+			for j < len(th) && th[j].aStart <= end {
+				end = max(end, th[j].aEnd)
+				j++
+				grew = true
+			}
+			if !grew {
+				break
+			}
+		}
+
+		out = append(out, b[pos:start]...)
+		ourSeg := apply(b, o, oh[i0:i], start, end)
+		theirSeg := apply(b, t, th[j0:j], start, end)
+		switch {
+		case i == i0:
+			out = append(out, theirSeg...)
+		case j == j0:
+			out = append(out, ourSeg...)
+		case equalLines(ourSeg, theirSeg):
+			out = append(out, ourSeg...)
+		default:
+			conflicts++
+			out = append(out, []byte("<<<<<<< "+labels.Ours+"\n"))
+			out = append(out, terminated(ourSeg)...)
+			out = append(out, []byte("=======\n"))
+			out = append(out, terminated(theirSeg)...)
+			out = append(out, []byte(">>>>>>> "+labels.Theirs+"\n"))
+		}
+		pos = end
+	}
+	out = append(out, b[pos:]...)
+	return Result{Data: bytes.Join(out, nil), Conflicts: conflicts}
+}
+
+// HasConflictMarkers reports whether b still holds a conflict block as
+// ThreeWay writes them: a "<<<<<<< " line followed by "=======" and
+// ">>>>>>> " lines.
+func HasConflictMarkers(b []byte) bool {
+	state := 0
+	for _, line := range splitLines(b) {
+		line = bytes.TrimRight(line, "\r\n")
+		switch {
+		case bytes.HasPrefix(line, []byte("<<<<<<< ")):
+			state = 1
+		case state == 1 && string(line) == "=======":
+			state = 2
+		case state == 2 && bytes.HasPrefix(line, []byte(">>>>>>> ")):
+			return true
+		}
+	}
+	return false
+}
+
+// apply rebuilds the side's view of base[start:end] from its hunks.
+func apply(base, side [][]byte, hunks []hunk, start, end int) [][]byte {
+	var seg [][]byte
+	cur := start
+	for _, h := range hunks {
+		seg = append(seg, base[cur:h.aStart]...)
+		seg = append(seg, side[h.bStart:h.bEnd]...)
+		cur = h.aEnd
+	}
+	return append(seg, base[cur:end]...)
+}
+
+// terminated ensures a conflict side ends with a newline so markers stay on
+// their own lines.
+func terminated(lines [][]byte) [][]byte {
+	if len(lines) == 0 {
+		return lines
+	}
+	last := lines[len(lines)-1]
+	if bytes.HasSuffix(last, []byte("\n")) {
+		return lines
+	}
+	out := append([][]byte(nil), lines[:len(lines)-1]...)
+	return append(out, append(append([]byte(nil), last...), '\n'))
+}
+
+func splitLines(b []byte) [][]byte {
+	lines := bytes.SplitAfter(b, []byte("\n"))
+	if len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func equalLines(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// diff returns the hunks that turn a into b, ordered by position in a.
+// It uses Myers' O(ND) algorithm after trimming the common prefix and suffix.
+func diff(a, b [][]byte) []hunk {
+	pre := 0
+	for pre < len(a) && pre < len(b) && bytes.Equal(a[pre], b[pre]) {
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && bytes.Equal(a[len(a)-1-suf], b[len(b)-1-suf]) {
+		suf++
+	}
+	a, b = a[pre:len(a)-suf], b[pre:len(b)-suf]
+
+	var hunks []hunk
+	prevA, prevB := -1, -1
+	emit := func(x, y int) {
+		if x > prevA+1 || y > prevB+1 {
+			hunks = append(hunks, hunk{aStart: pre + prevA + 1, aEnd: pre + x, bStart: pre + prevB + 1, bEnd: pre + y})
+		}
+		prevA, prevB = x, y
+	}
+	for _, m := range matches(a, b) {
+		emit(m[0], m[1])
+	}
+	emit(len(a), len(b))
+	return hunks
+}
+
+// matches returns the index pairs of a longest common subsequence of a and b.
+func matches(a, b [][]byte) [][2]int {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return nil
+	}
+	limit := n + m
+	off := limit + 1
+	v := make([]int, 2*limit+3)
+	var trace [][]int // trace[d][k+d] = furthest x on diagonal k after d edits
+
+	found := -1
+	for d := 0; d <= limit && found < 0; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[off+k-1] < v[off+k+1]) {
+				x = v[off+k+1]
+			} else {
+				x = v[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && bytes.Equal(a[x], b[y]) {
+				x++
+				y++
+			}
+			v[off+k] = x
+			if x >= n && y >= m {
+				found = d
+			}
+		}
+		trace = append(trace, append([]int(nil), v[off-d:off+d+1]...))
+	}
+
+	var out [][2]int
+	x, y := n, m
+	for d := found; d > 0; d-- {
+		prev := trace[d-1]
+		at := func(k int) int { return prev[k+d-1] }
+		k := x - y
+		var pk int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			pk = k + 1
+		} else {
+			pk = k - 1
+		}
+		px := at(pk)
+		py := px - pk
+		// Walk back along the snake that followed the edit.
+		sx := px + 1
+		if pk == k+1 {
+			sx = px
+		}
+		for x > sx {
+			x--
+			y--
+			out = append(out, [2]int{x, y})
+		}
+		x, y = px, py
+	}
+	for x > 0 && y > 0 {
+		x--
+		y--
+		out = append(out, [2]int{x, y})
+	}
+	for l, r := 0, len(out)-1; l < r; l, r = l+1, r-1 {
+		out[l], out[r] = out[r], out[l]
+	}
+	return out
+}
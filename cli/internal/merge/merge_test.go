@@ -0,0 +1,138 @@
+package merge
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestThreeWay(t *testing.T) {
+	cases := []struct {
+		name      string
+		base      string
+		ours      string
+		theirs    string
+		want      string
+		conflicts int
+	}{
+		{
+			name:   "only upstream changed",
+			base:   "a\nb\nc\n",
+			ours:   "a\nb\nc\n",
+			theirs: "a\nB\nc\n",
+			want:   "a\nB\nc\n",
+		},
+		{
+			name:   "only local changed",
+			base:   "a\nb\nc\n",
+			ours:   "a\nb\nc\nlocal\n",
+			theirs: "a\nb\nc\n",
+			want:   "a\nb\nc\nlocal\n",
+		},
+		{
+			name:   "disjoint edits",
+			base:   "1\n2\n3\n4\n5\n6\n",
+			ours:   "one\n2\n3\n4\n5\n6\n",
+			theirs: "1\n2\n3\n4\n5\nsix\n",
+			want:   "one\n2\n3\n4\n5\nsix\n",
+		},
+		{
+			name:   "identical edits",
+			base:   "a\nb\nc\n",
+			ours:   "a\nx\nc\n",
+			theirs: "a\nx\nc\n",
+			want:   "a\nx\nc\n",
+		},
+		{
+			name:      "overlapping edits",
+			base:      "a\nb\nc\n",
+			ours:      "a\nmine\nc\n",
+			theirs:    "a\ntheirs\nc\n",
+			want:      "a\n<<<<<<< local\nmine\n=======\ntheirs\n>>>>>>> upstream\nc\n",
+			conflicts: 1,
+		},
+		{
+			name:   "missing trailing newline",
+			base:   "a\nb",
+			ours:   "z\na\nb",
+			theirs: "a\nb\nc",
+			want:   "z\na\nb\nc",
+		},
+		{
+			name:   "upstream deletes, local appends elsewhere",
+			base:   "a\nb\nc\nd\ne\n",
+			ours:   "a\nb\nc\nd\ne\nf\n",
+			theirs: "a\nc\nd\ne\n",
+			want:   "a\nc\nd\ne\nf\n",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := ThreeWay([]byte(tc.base), []byte(tc.ours), []byte(tc.theirs), Labels{})
+			if string(res.Data) != tc.want {
+				t.Fatalf("merged:\n%q\nwant:\n%q", res.Data, tc.want)
+			}
+			if res.Conflicts != tc.conflicts {
+				t.Fatalf("conflicts = %d, want %d", res.Conflicts, tc.conflicts)
+			}
+		})
+	}
+}
+
+// TestDiffRoundTrip checks that diff hunks rebuild the target and keep a
+// longest common subsequence.
+func TestHasConflictMarkers(t *testing.T) {
+	res := ThreeWay([]byte("a\nb\n"), []byte("a\nours\n"), []byte("a\ntheirs\n"), Labels{})
+	if !HasConflictMarkers(res.Data) {
+		t.Fatalf("no markers found in %q", res.Data)
+	}
+	for _, s := range []string{"a\nb\n", "Title\n=======\n", "<<<<<<< x\nno end\n", "=======\n>>>>>>> x\n"} {
+		if HasConflictMarkers([]byte(s)) {
+			t.Errorf("markers found in %q", s)
+		}
+	}
+}
+
+func TestDiffRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	gen := func() string {
+		var b strings.Builder
+		for i, n := 0, rng.Intn(12); i < n; i++ {
+			b.WriteByte(byte('a' + rng.Intn(4)))
+			b.WriteByte('\n')
+		}
+		return b.String()
+	}
+	for i := 0; i < 2000; i++ {
+		a, b := splitLines([]byte(gen())), splitLines([]byte(gen()))
+		hunks := diff(a, b)
+		got := apply(a, b, hunks, 0, len(a))
+		if !equalLines(got, b) {
+			t.Fatalf("round trip failed for %q -> %q: got %q", a, b, got)
+		}
+		kept := len(a)
+		for _, h := range hunks {
+			kept -= h.aEnd - h.aStart
+		}
+		if want := lcs(a, b); kept != want {
+			t.Fatalf("diff kept %d lines of %q -> %q, LCS is %d", kept, a, b, want)
+		}
+	}
+}
+
+func lcs(a, b [][]byte) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if string(a[i]) == string(b[j]) {
+				dp[i][j] = dp[i+1][j+1] + 1
+			} else {
+				dp[i][j] = max(dp[i+1][j], dp[i][j+1])
+			}
+		}
+	}
+	return dp[0][0]
+}
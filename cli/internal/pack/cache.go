@@ -0,0 +1,334 @@
+package pack
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/blang/semver"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/statepath"
+)
+
+// The pack cache lives under the statepath base directory:
+//
+//	packs/sha256/<digest>/pack.zip          the pack archive as fetched
+//	packs/sha256/<digest>/pack.zip.minisig  its signature, if verified
+//	packs/sha256/<digest>/pack/             its extracted contents
+//	packs/sha256/<digest>/entry.json        where and when it was fetched
+//	packs/git/<commit>/pack/                git checkouts, keyed by commit
+const entryFile = "entry.json"
+
+// CacheEntry describes a cached pack.
+type CacheEntry struct {
+	Digest string `json:"digest,omitempty"` // sha256 of the pack zip
+	Commit string `json:"commit,omitempty"` // git checkouts are keyed by commit instead
+	Tag    string `json:"tag,omitempty"`
+	URL    string `json:"url,omitempty"`
+	// Origin is where the pack came from: a releases page, a zip path or a
+	// git repository. Offline fallback only considers packs of the same
+	// releases page.
+	Origin  string    `json:"origin,omitempty"`
+	Version string    `json:"version,omitempty"`
+	Fetched time.Time `json:"fetched"`
+	// SignedBy is the ID of the key whose signature was verified; empty
+	// for packs cached with --insecure or from sources without signatures.
+	SignedBy string `json:"signed_by,omitempty"`
+
+	Dir  string `json:"-"` // extracted pack root
+	Size int64  `json:"-"` // bytes on disk
+	path string // cache directory of the entry
+	sig  []byte // signature to store with a new entry
+}
+
+// Key names the entry for display and lookup: the digest, or git:<commit>.
+func (e CacheEntry) Key() string {
+	if e.Commit != "" {
+		return "git:" + e.Commit
+	}
+	return e.Digest
+}
+
+// Release returns the pinned release the entry holds.
+func (e CacheEntry) Release() Release {
+	if e.Commit != "" {
+		return Release{Tag: e.Commit, URL: e.Origin, Dir: e.Dir}
+	}
+	return Release{Tag: e.Tag, Digest: e.Digest, URL: e.URL, Dir: e.Dir}
+}
+
+// Verify checks the cached archive still matches its digest and the
+// extracted pack is present.
+func (e CacheEntry) Verify() error {
+	if e.Dir == "" {
+		return fmt.Errorf("%s: pack not extracted", e.Key())
+	}
+	if e.Commit != "" {
+		return nil
+	}
+	sum, err := fileChecksum(filepath.Join(e.path, "pack.zip"))
+	if err != nil {
+		return fmt.Errorf("%s: %w", short(e.Digest), err)
+	}
+	if sum != e.Digest {
+		return fmt.Errorf("%s: archive is corrupt (sha256 %s)", short(e.Digest), sum)
+	}
+	return nil
+}
+
+// Remove deletes the entry from the cache.
+func (e CacheEntry) Remove() error {
+	return os.RemoveAll(e.path)
+}
+
+// Export copies the entry's archive to dst and writes its checksum and
+// signature next to it as dst.sha256 and dst.minisig, as releases publish them.
+func (e CacheEntry) Export(dst string) error {
+	if e.Commit != "" {
+		return fmt.Errorf("%s is a git checkout; only packs fetched as archives can be exported", e.Key())
+	}
+	if err := e.Verify(); err != nil {
+		return err
+	}
+	src, err := os.Open(filepath.Join(e.path, "pack.zip"))
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, src); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	if sig, err := os.ReadFile(filepath.Join(e.path, "pack.zip.minisig")); err == nil {
+		if err := os.WriteFile(dst+".minisig", sig, 0o644); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(dst+".sha256", []byte(fmt.Sprintf("%s  %s\n", e.Digest, filepath.Base(dst))), 0o644)
+}
+
+// CacheEntries lists the cached packs, newest first.
+func CacheEntries() ([]CacheEntry, error) {
+	root, err := packsRoot()
+	if err != nil {
+		return nil, err
+	}
+	var out []CacheEntry
+	for _, kind := range []string{"sha256", "git"} {
+		dirs, err := os.ReadDir(filepath.Join(root, kind))
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		for _, d := range dirs {
+			if !d.IsDir() || strings.HasPrefix(d.Name(), ".") {
+				continue // in-progress unpacks
+			}
+			out = append(out, readEntry(filepath.Join(root, kind, d.Name()), kind, d.Name()))
+		}
+	}
+	slices.SortStableFunc(out, func(a, b CacheEntry) int { return b.Fetched.Compare(a.Fetched) })
+	return out, nil
+}
+
+// readEntry loads an entry's metadata. Entries written before entry.json
+// existed are described from the directory alone.
+func readEntry(dir, kind, name string) CacheEntry {
+	var e CacheEntry
+	if b, err := os.ReadFile(filepath.Join(dir, entryFile)); err == nil {
+		_ = json.Unmarshal(b, &e)
+	}
+	if kind == "git" {
+		e.Commit = name
+	} else {
+		e.Digest = name
+	}
+	e.path = dir
+	if e.Fetched.IsZero() {
+		if info, err := os.Stat(dir); err == nil {
+			e.Fetched = info.ModTime()
+		}
+	}
+	e.Dir, _ = canonicalPackRoot(filepath.Join(dir, "pack"))
+	_ = filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			if info, err := d.Info(); err == nil {
+				e.Size += info.Size()
+			}
+		}
+		return nil
+	})
+	return e
+}
+
+// FindCached returns the cached pack named by ref: a digest or a prefix of
+// one, git:<commit>, or a tag.
+func FindCached(ref string) (CacheEntry, error) {
+	entries, err := CacheEntries()
+	if err != nil {
+		return CacheEntry{}, err
+	}
+	var found []CacheEntry
+	for _, e := range entries {
+		key := strings.TrimPrefix(e.Key(), "git:")
+		if e.Tag == ref || strings.HasPrefix(key, strings.TrimPrefix(strings.TrimPrefix(ref, "git:"), "sha256:")) {
+			found = append(found, e)
+		}
+	}
+	switch {
+	case len(found) == 0:
+		return CacheEntry{}, fmt.Errorf("no cached pack matches %q (see `codo cache list`)", ref)
+	case len(found) > 1 && found[0].Tag != ref:
+		return CacheEntry{}, fmt.Errorf("%q matches %d cached packs; give more of the digest", ref, len(found))
+	}
+	return found[0], nil // the newest fetch of a tag
+}
+
+// NewestCached returns the newest verified pack previously fetched from
+// these releases, for use when they cannot be reached. A concrete tag only
+// matches itself; "latest" takes the highest release version cached and
+// "edge" also considers edge builds.
+func (s ReleaseSource) NewestCached(tag string) (Release, error) {
+	entries, err := CacheEntries()
+	if err != nil {
+		return Release{}, err
+	}
+	var best *CacheEntry
+	for i, e := range entries {
+		if e.Digest == "" || e.Origin != s.BaseURL {
+			continue
+		}
+		switch tag {
+		case "", "latest":
+			if e.Tag == "edge" {
+				continue
+			}
+		case "edge":
+		default:
+			if e.Tag != tag {
+				continue
+			}
+		}
+		if e.Verify() != nil || (e.SignedBy == "" && !Verifier.Insecure) {
+			continue
+		}
+		if best == nil || newerEntry(e, *best) {
+			best = &entries[i]
+		}
+	}
+	if best == nil {
+		return Release{}, fmt.Errorf("no cached pack from %s", s.BaseURL)
+	}
+	return best.Release(), nil
+}
+
+// newerEntry orders entries by version, then by fetch time.
+func newerEntry(a, b CacheEntry) bool {
+	va, err1 := semver.Parse(a.Version)
+	vb, err2 := semver.Parse(b.Version)
+	if err1 == nil && err2 == nil && !va.EQ(vb) {
+		return va.GT(vb)
+	}
+	if err1 == nil && err2 != nil {
+		return true
+	}
+	return a.Fetched.After(b.Fetched)
+}
+
+// Import adds a pack archive to the cache as if it had been downloaded from
+// the given releases under tag. A checksum file next to the archive
+// (<file>.sha256, as written by Export and published with releases) is
+// verified when present, and its signature (<file>.minisig) always is. An
+// empty tag is derived from the pack's version.
+func (s ReleaseSource) Import(zipPath, tag string) (CacheEntry, error) {
+	digest, err := fileChecksum(zipPath)
+	if err != nil {
+		return CacheEntry{}, err
+	}
+	if b, err := os.ReadFile(zipPath + ".sha256"); err == nil {
+		fields := strings.Fields(string(b))
+		if len(fields) == 0 || strings.ToLower(fields[0]) != digest {
+			return CacheEntry{}, fmt.Errorf("checksum mismatch: %s.sha256 does not match %s (sha256 %s)", zipPath, zipPath, digest)
+		}
+	}
+	e := CacheEntry{Digest: digest, Tag: tag, Origin: s.BaseURL}
+	sig, err := os.ReadFile(zipPath + ".minisig")
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return CacheEntry{}, err
+	}
+	data, err := os.ReadFile(zipPath)
+	if err != nil {
+		return CacheEntry{}, err
+	}
+	if e.SignedBy, err = Verifier.Verify(filepath.Base(zipPath), data, sig); err != nil {
+		return CacheEntry{}, err
+	}
+	e.sig = sig
+	if _, err := unpack(zipPath, e); err != nil {
+		return CacheEntry{}, err
+	}
+	return FindCached(digest)
+}
+
+// packsRoot is the pack cache under the statepath base directory.
+func packsRoot() (string, error) {
+	return statepath.PacksDir()
+}
+
+// LegacyPacksDir is where older versions cached packs, or "" when that is
+// also the current cache.
+func LegacyPacksDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	legacy := filepath.Join(home, ".codo", "packs")
+	if root, err := packsRoot(); err == nil && root == legacy {
+		return ""
+	}
+	return legacy
+}
+
+// writeEntry records e in the cache directory dir.
+func writeEntry(dir string, e CacheEntry) error {
+	if e.Fetched.IsZero() {
+		e.Fetched = time.Now().UTC()
+	}
+	if e.Version == "" && e.Dir != "" {
+		if m, err := ReadMeta(os.DirFS(e.Dir)); err == nil {
+			e.Version = m.Version
+		}
+	}
+	if e.Tag == "" && e.Version != "" {
+		e.Tag = "v" + e.Version
+	}
+	b, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, entryFile), b, 0o644)
+}
+
+// short abbreviates a digest for messages.
+func short(digest string) string {
+	if len(digest) > 12 {
+		return digest[:12]
+	}
+	return digest
+}
@@ -0,0 +1,53 @@
+package pack
+
+import (
+	"bytes"
+	"slices"
+)
+
+// Changes lists the destination paths that differ between two file sets.
+type Changes struct {
+	Added   []string
+	Changed []string
+	Removed []string
+}
+
+// Empty reports whether the file sets were identical.
+func (c Changes) Empty() bool {
+	return len(c.Added)+len(c.Changed)+len(c.Removed) == 0
+}
+
+// Compare reports which files were added, changed or removed going from old
+// to new, as composed for the same selection.
+func Compare(old, new []File) (Changes, error) {
+	prev := map[string][]byte{}
+	for _, f := range old {
+		b, err := f.Read()
+		if err != nil {
+			return Changes{}, err
+		}
+		prev[f.RelPath] = b
+	}
+	var c Changes
+	for _, f := range new {
+		b, err := f.Read()
+		if err != nil {
+			return Changes{}, err
+		}
+		ob, ok := prev[f.RelPath]
+		switch {
+		case !ok:
+			c.Added = append(c.Added, f.RelPath)
+		case !bytes.Equal(ob, b):
+			c.Changed = append(c.Changed, f.RelPath)
+		}
+		delete(prev, f.RelPath)
+	}
+	for rel := range prev {
+		c.Removed = append(c.Removed, rel)
+	}
+	slices.Sort(c.Added)
+	slices.Sort(c.Changed)
+	slices.Sort(c.Removed)
+	return c, nil
+}
@@ -0,0 +1,56 @@
+package pack
+
+import (
+	"fmt"
+	"io/fs"
+	"strings"
+)
+
+// Detection records a catalog stack whose markers were found in a repository.
+type Detection struct {
+	Stack   string
+	Markers []string // matched paths, relative to the repository root
+}
+
+// Detect matches each stack's marker globs against the repository root and
+// returns the stacks that matched, in catalog order.
+func Detect(catalog []Stack, repo fs.FS) []Detection {
+	var out []Detection
+	for _, s := range catalog {
+		var found []string
+		for _, pattern := range s.Detect {
+			matches, err := fs.Glob(repo, pattern)
+			if err != nil {
+				continue
+			}
+			found = append(found, matches...)
+		}
+		if len(found) > 0 {
+			out = append(out, Detection{Stack: s.Key, Markers: found})
+		}
+	}
+	return out
+}
+
+// DetectedKeys lists the stacks of the detections.
+func DetectedKeys(ds []Detection) []string {
+	keys := make([]string, len(ds))
+	for i, d := range ds {
+		keys[i] = d.Stack
+	}
+	return keys
+}
+
+// Has reports whether stack was detected.
+func Has(ds []Detection, stack string) bool {
+	for _, d := range ds {
+		if d.Stack == stack {
+			return true
+		}
+	}
+	return false
+}
+
+func (d Detection) String() string {
+	return fmt.Sprintf("%s (%s)", d.Stack, strings.Join(d.Markers, ", "))
+}
@@ -0,0 +1,27 @@
+package pack
+
+import (
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+func TestDetect(t *testing.T) {
+	repo := fstest.MapFS{
+		"go.mod":             {Data: []byte("module x\n")},
+		"tsconfig.json":      {Data: []byte("{}")},
+		"package.json":       {Data: []byte("{}")},
+		"web/pubspec.yaml":   {Data: []byte("name: x\n")},
+		"tools/requirements": {Data: []byte("")},
+	}
+	ds := Detect(defaultCatalog, repo)
+	if got := DetectedKeys(ds); !slices.Equal(got, []string{"go", "typescript"}) {
+		t.Fatalf("stacks = %v", got)
+	}
+	if got := ds[1].Markers; !slices.Equal(got, []string{"package.json", "tsconfig.json"}) {
+		t.Fatalf("typescript markers = %v", got)
+	}
+	if got := ds[0].String(); got != "go (go.mod)" {
+		t.Fatalf("String() = %q", got)
+	}
+}
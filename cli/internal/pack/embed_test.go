@@ -30,6 +30,6 @@ func TestEmbeddedBaseZipDeterministic(t *testing.T) {
 	}
 
 	if !bytes.Equal(embeddedBaseZip, generated) {
-		t.Fatalf("embedded pack bytes differ from pack directory snapshot")
+		t.Fatalf("embedded pack bytes differ from pack directory snapshot; run ./scripts/embed-pack.sh")
 	}
 }
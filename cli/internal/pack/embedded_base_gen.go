@@ -7,1601 +7,1982 @@ func init() {
 		0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x09, 0x00, 0x09, 0x00, 0x43, 0x4c, 0x41, 0x55, 0x44, 0x45,
 		0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
-		0x84, 0x55, 0x41, 0x6f, 0x1b, 0x37, 0x13, 0xbd, 0xef, 0xaf, 0x78, 0x80,
-		0x81, 0xc0, 0x36, 0xb4, 0xd4, 0xe7, 0x7c, 0xdf, 0xd7, 0xa6, 0x75, 0x11,
-		0xc0, 0xb0, 0x95, 0xc4, 0x68, 0x62, 0x0b, 0xb6, 0xd2, 0xa2, 0x37, 0x8d,
-		0xb8, 0xb3, 0xbb, 0xac, 0xb8, 0xe4, 0x96, 0x43, 0x4a, 0xd6, 0xa5, 0xf0,
-		0xa5, 0xbd, 0xb7, 0xb7, 0x02, 0xe9, 0x9f, 0xf3, 0x2f, 0x29, 0x48, 0xc9,
-		0x41, 0x6e, 0xbd, 0x69, 0x49, 0x70, 0xe6, 0xcd, 0x7b, 0x6f, 0x9e, 0x8e,
-		0x70, 0xf9, 0xfe, 0xe2, 0xe3, 0xd5, 0x4c, 0x0d, 0x0d, 0x8e, 0xc7, 0xe0,
-		0x7f, 0x66, 0x1d, 0x31, 0xf0, 0xe0, 0xc3, 0xee, 0xa4, 0xaa, 0xe6, 0x87,
-		0x83, 0x05, 0x75, 0xdf, 0xe2, 0xf2, 0xf6, 0xea, 0xb6, 0xbe, 0xb8, 0xb9,
-		0x7c, 0x77, 0x7b, 0x57, 0x55, 0x47, 0x47, 0x78, 0x7b, 0xfb, 0xfe, 0x6a,
-		0x76, 0x83, 0xbb, 0x8f, 0xef, 0x67, 0xf7, 0xa8, 0x71, 0xc7, 0xd4, 0xa0,
-		0x35, 0x41, 0xe2, 0x04, 0xec, 0x5a, 0x1f, 0x34, 0x83, 0xec, 0x96, 0x76,
-		0x52, 0x55, 0x67, 0x0a, 0x3f, 0x5d, 0xbc, 0xbd, 0xb9, 0x46, 0x8d, 0x1f,
-		0x83, 0x89, 0x0c, 0xef, 0xec, 0x0e, 0xdb, 0x9e, 0x22, 0x62, 0xcf, 0xd0,
-		0x29, 0x04, 0x76, 0x11, 0xd1, 0xe8, 0x35, 0xc7, 0xa9, 0x8c, 0xac, 0xd1,
-		0xf0, 0x40, 0xae, 0x11, 0x55, 0xbd, 0x54, 0xf8, 0xfe, 0xfa, 0x3e, 0x77,
-		0x98, 0x07, 0x6e, 0x39, 0x94, 0x17, 0x62, 0x86, 0xd1, 0xb2, 0x44, 0x6c,
-		0x7d, 0x58, 0x1b, 0xd7, 0x41, 0xbc, 0x4d, 0xd1, 0x78, 0x77, 0x0e, 0xda,
-		0x78, 0xd3, 0xc0, 0x31, 0x37, 0x96, 0x45, 0x40, 0x2b, 0x89, 0x81, 0x74,
-		0xbe, 0x13, 0x55, 0xfd, 0x57, 0xe1, 0xc6, 0x23, 0x37, 0x48, 0x96, 0xa2,
-		0xd9, 0x30, 0x7c, 0x8a, 0x63, 0x8a, 0xa8, 0x71, 0xbf, 0x36, 0x23, 0xee,
-		0x66, 0x17, 0x57, 0x1f, 0x66, 0x32, 0x6d, 0xbc, 0x96, 0xa9, 0xe8, 0x60,
-		0xc6, 0x28, 0x48, 0xae, 0x54, 0xe2, 0x87, 0xd1, 0x1a, 0x6d, 0xa2, 0xdd,
-		0x21, 0xf0, 0x2f, 0x89, 0x25, 0x72, 0xa3, 0xaa, 0xff, 0x29, 0x2c, 0x58,
-		0xa2, 0x60, 0x63, 0x08, 0xcb, 0xce, 0x23, 0x66, 0x58, 0x6a, 0xaa, 0x94,
-		0x5a, 0xa2, 0xc6, 0xdb, 0xc0, 0xec, 0xca, 0x99, 0x80, 0x02, 0x17, 0xf0,
-		0xfc, 0x60, 0x22, 0x3a, 0x8a, 0x8c, 0xa7, 0x4f, 0xbf, 0xa9, 0xea, 0xff,
-		0x0a, 0x6f, 0x8c, 0x6b, 0xca, 0x55, 0xf0, 0x3e, 0x42, 0x53, 0x12, 0x46,
-		0x8d, 0x37, 0xe6, 0x01, 0x6d, 0x72, 0x0d, 0x0d, 0xec, 0x22, 0x59, 0x99,
-		0xc0, 0xf1, 0x86, 0x03, 0x46, 0x8a, 0xba, 0x87, 0xec, 0x86, 0x31, 0xfa,
-		0x41, 0x54, 0xf5, 0x95, 0xc2, 0x07, 0xe3, 0xcc, 0x60, 0x64, 0x5f, 0xbf,
-		0x31, 0x6d, 0x8b, 0x1a, 0x8b, 0xcc, 0xd3, 0x40, 0xd6, 0x72, 0x98, 0x40,
-		0x5b, 0xa6, 0xc0, 0x01, 0xba, 0x27, 0xd7, 0x31, 0xb6, 0x26, 0xb3, 0xf1,
-		0xb5, 0xc2, 0x85, 0xd6, 0x29, 0x90, 0xde, 0xc1, 0xe7, 0xca, 0x32, 0x32,
-		0x37, 0xa8, 0x71, 0xdd, 0x22, 0x39, 0x49, 0x81, 0x27, 0x20, 0x59, 0xab,
-		0xea, 0xd5, 0x41, 0x03, 0x0a, 0xba, 0x37, 0x91, 0x75, 0x4c, 0x21, 0x03,
-		0xbc, 0xe7, 0x91, 0x42, 0x9e, 0xe3, 0xdd, 0x62, 0x31, 0x47, 0x4f, 0xae,
-		0xb1, 0x59, 0x8b, 0x36, 0xf8, 0x01, 0xab, 0x24, 0xc6, 0x65, 0xda, 0xac,
-		0xef, 0x8c, 0x3e, 0x87, 0xf6, 0x0d, 0x63, 0x48, 0x12, 0xb1, 0xe2, 0xc2,
-		0x07, 0xad, 0x2c, 0x4f, 0x03, 0x53, 0x93, 0x7f, 0xa8, 0xea, 0x1b, 0x85,
-		0xfb, 0xc5, 0xed, 0x1c, 0xd4, 0x34, 0xb9, 0x86, 0xf6, 0x59, 0xe0, 0x07,
-		0x13, 0x77, 0xa8, 0xb3, 0x64, 0xfc, 0x10, 0x03, 0xa1, 0x65, 0xca, 0xad,
-		0xff, 0x45, 0x92, 0xb3, 0xff, 0x28, 0x5c, 0x7a, 0x57, 0x64, 0xaf, 0x2d,
-		0x6f, 0xd8, 0x96, 0x86, 0xb9, 0x6c, 0xf1, 0x5d, 0x5d, 0x14, 0xc3, 0x98,
-		0x56, 0xd6, 0x68, 0x5c, 0xcc, 0xaf, 0x65, 0xaa, 0x83, 0x89, 0x46, 0x93,
-		0x05, 0x37, 0x1d, 0xd7, 0x9a, 0x84, 0x65, 0x2a, 0xd4, 0x72, 0xdc, 0xc1,
-		0xb8, 0x0d, 0x05, 0x43, 0x2e, 0xca, 0x39, 0x24, 0x7b, 0xc4, 0x92, 0xeb,
-		0x12, 0x75, 0x8c, 0x2e, 0x51, 0x20, 0x17, 0x99, 0x05, 0x2f, 0x30, 0x06,
-		0xb3, 0xc9, 0x54, 0x18, 0x17, 0x39, 0x38, 0xb2, 0xa2, 0xaa, 0xb3, 0x33,
-		0x85, 0x9b, 0xd9, 0x0f, 0xb3, 0xbb, 0x3c, 0xcd, 0x60, 0xe2, 0x81, 0x7c,
-		0x41, 0x8d, 0x2b, 0x8f, 0x9b, 0xdb, 0xc5, 0xf3, 0xf9, 0x61, 0x9a, 0xac,
-		0x5d, 0x12, 0x0e, 0x5f, 0x8e, 0x45, 0xb2, 0x16, 0x55, 0xa4, 0x2c, 0x37,
-		0xfb, 0x07, 0xb9, 0xf6, 0xcb, 0xe7, 0xda, 0x0d, 0x8f, 0xd6, 0xef, 0x10,
-		0x3d, 0xc6, 0xe0, 0x9b, 0x54, 0x9c, 0x8e, 0xfa, 0x70, 0x9c, 0x9d, 0x23,
-		0x85, 0x1b, 0x13, 0xf8, 0x73, 0x5d, 0xf4, 0x69, 0x20, 0x07, 0x1a, 0xc7,
-		0xe0, 0x37, 0x64, 0x55, 0x59, 0xe7, 0x2b, 0x6e, 0x29, 0xd9, 0x08, 0xeb,
-		0xfd, 0x58, 0x55, 0x8b, 0xde, 0xb8, 0x35, 0x9e, 0x7e, 0xff, 0x13, 0x73,
-		0x4b, 0x0e, 0xc7, 0xa3, 0x70, 0x6a, 0xfc, 0xd3, 0xe3, 0x1f, 0x59, 0xc7,
-		0x93, 0x72, 0x71, 0x7a, 0x7a, 0xdf, 0xfb, 0x2d, 0x5e, 0x40, 0x7b, 0xd7,
-		0x9a, 0x30, 0x9c, 0x9e, 0x96, 0xe3, 0xeb, 0x2c, 0x5c, 0xee, 0x5b, 0xbe,
-		0xf6, 0xbb, 0xd1, 0x95, 0x2d, 0xc8, 0xdf, 0xc7, 0x1f, 0xf3, 0x18, 0xc5,
-		0x2a, 0x2c, 0x87, 0x71, 0xa6, 0xf3, 0xbb, 0x93, 0x82, 0xe1, 0x08, 0x17,
-		0x5d, 0x7e, 0xd8, 0x73, 0x0a, 0x46, 0xa2, 0xd1, 0x52, 0xd5, 0x58, 0x66,
-		0x8f, 0x70, 0xc0, 0x77, 0x6b, 0xde, 0xbd, 0x5e, 0xe2, 0x58, 0xb4, 0x4f,
-		0xf1, 0x04, 0xd4, 0x46, 0x0e, 0x58, 0x4e, 0x47, 0x4b, 0x6e, 0x89, 0x6d,
-		0xcf, 0x0e, 0xc9, 0xad, 0x9d, 0xdf, 0xba, 0x62, 0xb5, 0x91, 0x62, 0x9f,
-		0x07, 0x1f, 0xc8, 0x38, 0xf8, 0x80, 0xa7, 0xc7, 0x4f, 0x6f, 0x4c, 0x6e,
-		0x19, 0x3d, 0xa2, 0x4f, 0xba, 0x7f, 0x7a, 0xfc, 0x1b, 0xaf, 0xf1, 0xeb,
-		0xab, 0x73, 0x04, 0xde, 0x18, 0xde, 0x16, 0xf6, 0x57, 0xc1, 0x70, 0x3b,
-		0x41, 0x1a, 0x9b, 0x2c, 0x65, 0x3e, 0xc9, 0xe5, 0x27, 0xf9, 0x57, 0x2e,
-		0xeb, 0xa2, 0x71, 0x89, 0xd5, 0x1e, 0x54, 0x7e, 0xf4, 0x05, 0x2c, 0x4a,
-		0x8d, 0xf9, 0x02, 0x16, 0x3f, 0xb0, 0x4e, 0x91, 0x97, 0x90, 0xa4, 0x35,
-		0x73, 0x23, 0x20, 0xd7, 0x60, 0xc5, 0xad, 0x0f, 0x9c, 0x51, 0x87, 0xbc,
-		0x3a, 0x5c, 0xef, 0x09, 0x58, 0xc2, 0x3b, 0x58, 0x0a, 0xdd, 0x7e, 0x77,
-		0x05, 0xc7, 0xaf, 0x5f, 0xa1, 0xcd, 0x70, 0x4f, 0x32, 0xf6, 0x60, 0x64,
-		0xbd, 0xcb, 0xe9, 0x41, 0x7b, 0x0b, 0x0e, 0x05, 0x99, 0x77, 0x5c, 0x8f,
-		0xd9, 0x86, 0xfb, 0x04, 0xdb, 0x8b, 0x78, 0xe9, 0xdd, 0x86, 0x5d, 0x09,
-		0xbb, 0xaa, 0xfa, 0x9c, 0x98, 0x25, 0x05, 0x26, 0x90, 0x14, 0xba, 0x62,
-		0xf2, 0x7d, 0x93, 0xad, 0x89, 0xfd, 0x3e, 0x17, 0x10, 0x28, 0x3f, 0x21,
-		0x5b, 0x66, 0xbb, 0xf2, 0x3a, 0x15, 0xfd, 0x3e, 0x87, 0xf4, 0xd3, 0xe3,
-		0x5f, 0x02, 0xc7, 0x9a, 0x45, 0x28, 0x14, 0xab, 0x0d, 0xb4, 0xe6, 0x03,
-		0x71, 0x02, 0x6e, 0x5b, 0xd6, 0x25, 0x4c, 0x8f, 0x73, 0xb2, 0x4e, 0x9e,
-		0x49, 0xcb, 0xd2, 0x4f, 0x0a, 0x7a, 0x38, 0x1f, 0x59, 0x4e, 0x72, 0xf5,
-		0xe7, 0xc5, 0x3c, 0x64, 0x62, 0xee, 0x71, 0x8e, 0x35, 0xf3, 0xb8, 0xcf,
-		0xf5, 0x94, 0xff, 0x1d, 0x5a, 0x92, 0x58, 0xf8, 0xb2, 0x4c, 0x4e, 0x55,
-		0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0x30, 0x49,
-		0x74, 0xa9, 0x02, 0x04, 0x00, 0x00, 0xa4, 0x06, 0x00, 0x00, 0x50, 0x4b,
-		0x03, 0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x05, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x63, 0x73, 0x2f, 0x55, 0x54, 0x05,
-		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00,
-		0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0e, 0x00, 0x09, 0x00,
-		0x64, 0x6f, 0x63, 0x73, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x2e,
-		0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x72,
-		0xc9, 0x2f, 0x49, 0xce, 0x49, 0x2c, 0x4d, 0x49, 0x55, 0x48, 0xc9, 0x4f,
-		0x2e, 0x56, 0x28, 0xc8, 0x49, 0x4c, 0x4e, 0xcd, 0xc8, 0xcf, 0x49, 0x49,
-		0x2d, 0xd2, 0xe3, 0x02, 0x04, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07,
-		0x08, 0x74, 0x98, 0xfb, 0x78, 0x22, 0x00, 0x00, 0x00, 0x1c, 0x00, 0x00,
-		0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x0a, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c,
-		0x61, 0x75, 0x64, 0x65, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
+		0x84, 0x54, 0x4d, 0x6f, 0x1b, 0x37, 0x10, 0xbd, 0xef, 0xaf, 0x78, 0x80,
+		0x81, 0xc0, 0x36, 0xb4, 0xab, 0x3a, 0xfd, 0x4a, 0xeb, 0x22, 0x80, 0x60,
+		0x29, 0x89, 0xd1, 0xc4, 0x16, 0x2c, 0xa5, 0x45, 0x6f, 0x1a, 0x71, 0x67,
+		0x77, 0x59, 0x71, 0xc9, 0x2d, 0x87, 0x94, 0xac, 0x4b, 0xe1, 0x4b, 0x7b,
+		0x6f, 0x6f, 0x05, 0xd2, 0x3f, 0xe7, 0x5f, 0x52, 0x90, 0x92, 0x03, 0xdf,
+		0x7a, 0xdb, 0x1d, 0x82, 0x6f, 0xde, 0xbc, 0xf7, 0x38, 0x27, 0xb8, 0x7a,
+		0x3f, 0xf9, 0x38, 0x9d, 0x55, 0x7d, 0x8d, 0xd3, 0xc1, 0xbb, 0x5f, 0x59,
+		0x05, 0xf4, 0xdc, 0x3b, 0xbf, 0x3f, 0x2b, 0x8a, 0xf9, 0xb1, 0xb0, 0xa4,
+		0xf6, 0x7b, 0x5c, 0xdd, 0x4e, 0x6f, 0xcb, 0xc9, 0xcd, 0xd5, 0xbb, 0xdb,
+		0xbb, 0xa2, 0x38, 0x39, 0xc1, 0xdb, 0xdb, 0xf7, 0xd3, 0xd9, 0x0d, 0xee,
+		0x3e, 0xbe, 0x9f, 0x2d, 0x50, 0xe2, 0x8e, 0xa9, 0x46, 0xa3, 0xbd, 0x84,
+		0x11, 0xd8, 0x36, 0xce, 0x2b, 0x06, 0x99, 0x1d, 0xed, 0xa5, 0x28, 0x2e,
+		0x2a, 0xfc, 0x32, 0x79, 0x7b, 0x73, 0x8d, 0x12, 0x3f, 0x7b, 0x1d, 0x18,
+		0xce, 0x9a, 0x3d, 0x76, 0x1d, 0x05, 0x84, 0x8e, 0xa1, 0xa2, 0xf7, 0x6c,
+		0x03, 0x82, 0x56, 0x1b, 0x0e, 0x63, 0x19, 0x58, 0xa1, 0xe6, 0x9e, 0x6c,
+		0x2d, 0x55, 0xf1, 0xb2, 0xc2, 0x8f, 0xd7, 0x8b, 0x05, 0x4a, 0xcc, 0x3d,
+		0x37, 0xec, 0xf3, 0x0d, 0xd1, 0xfd, 0x60, 0x58, 0x02, 0x76, 0xce, 0x6f,
+		0xb4, 0x6d, 0x21, 0xce, 0xc4, 0xa0, 0x9d, 0xbd, 0x04, 0x6d, 0x9d, 0xae,
+		0x61, 0x99, 0x6b, 0xc3, 0x22, 0xa0, 0xb5, 0x04, 0x4f, 0x2a, 0x9d, 0x49,
+		0x55, 0x7c, 0x59, 0xe1, 0xc6, 0x21, 0x35, 0x88, 0x86, 0x82, 0xde, 0x32,
+		0x5c, 0x0c, 0x43, 0x0c, 0x28, 0xb1, 0xd8, 0xe8, 0x01, 0x77, 0xb3, 0xc9,
+		0xf4, 0xc3, 0x4c, 0xc6, 0xb5, 0x53, 0x32, 0x16, 0xe5, 0xf5, 0x10, 0x04,
+		0xd1, 0x66, 0x24, 0xbe, 0x1f, 0x8c, 0x56, 0x3a, 0x98, 0x3d, 0x3c, 0xff,
+		0x16, 0x59, 0x02, 0xd7, 0x55, 0xf1, 0x55, 0x85, 0x25, 0x4b, 0x10, 0x6c,
+		0x35, 0x61, 0xd5, 0x3a, 0x84, 0x44, 0xab, 0x1a, 0x57, 0x55, 0xb5, 0x42,
+		0x89, 0xb7, 0x9e, 0xd9, 0xe6, 0x9a, 0x80, 0x3c, 0x67, 0xf2, 0x7c, 0xaf,
+		0x03, 0x5a, 0x0a, 0x8c, 0xc7, 0x4f, 0x7f, 0x54, 0xc5, 0xd7, 0x15, 0xde,
+		0x68, 0x5b, 0xe7, 0x23, 0xef, 0x5c, 0x80, 0xa2, 0x28, 0x8c, 0x12, 0x6f,
+		0xf4, 0x3d, 0x9a, 0x68, 0x6b, 0xea, 0xd9, 0x06, 0x32, 0x32, 0x82, 0xe5,
+		0x2d, 0x7b, 0x0c, 0x14, 0x54, 0x07, 0xd9, 0xf7, 0x43, 0x70, 0xbd, 0x54,
+		0xc5, 0x37, 0x15, 0x3e, 0x68, 0xab, 0x7b, 0x2d, 0x07, 0xfc, 0x5a, 0x37,
+		0x0d, 0x4a, 0x2c, 0x3b, 0x86, 0xf4, 0x64, 0x0c, 0xfb, 0x11, 0x94, 0x61,
+		0xf2, 0xec, 0xa1, 0x3a, 0xb2, 0x2d, 0x63, 0xa7, 0x93, 0x1a, 0xdf, 0x56,
+		0x98, 0x28, 0x15, 0x3d, 0xa9, 0x3d, 0x5c, 0x42, 0x96, 0x81, 0xb9, 0x46,
+		0x89, 0xeb, 0x06, 0xd1, 0x4a, 0xf4, 0x3c, 0x02, 0xc9, 0xa6, 0x2a, 0x5e,
+		0x1d, 0x3d, 0x20, 0xaf, 0x3a, 0x1d, 0x58, 0x85, 0xe8, 0x13, 0xc1, 0x05,
+		0x0f, 0xe4, 0xd3, 0x1c, 0xef, 0x96, 0xcb, 0x39, 0x3a, 0xb2, 0xb5, 0x49,
+		0x5e, 0x34, 0xde, 0xf5, 0x58, 0x47, 0xd1, 0x36, 0xc9, 0x66, 0x5c, 0xab,
+		0xd5, 0x25, 0x94, 0xab, 0x19, 0x7d, 0x94, 0x80, 0x35, 0x67, 0x3d, 0x68,
+		0x6d, 0x78, 0xec, 0x99, 0xea, 0xf4, 0x51, 0x15, 0xdf, 0x55, 0x58, 0x2c,
+		0x6f, 0xe7, 0xa0, 0xba, 0x4e, 0x18, 0xca, 0x25, 0x83, 0xef, 0x75, 0xd8,
+		0xa3, 0x4c, 0x96, 0xf1, 0x7d, 0xf0, 0x84, 0x86, 0x29, 0xb5, 0xfe, 0x1f,
+		0x4b, 0x2e, 0xbe, 0xa8, 0x70, 0xe5, 0x6c, 0xb6, 0xbd, 0x34, 0xbc, 0x65,
+		0x93, 0x1b, 0x26, 0xd8, 0x9c, 0xbb, 0x32, 0x3b, 0x86, 0x21, 0xae, 0x8d,
+		0x56, 0x98, 0xcc, 0xaf, 0x65, 0xac, 0xbc, 0x0e, 0x5a, 0x91, 0x01, 0xd7,
+		0x2d, 0x97, 0x8a, 0x84, 0x65, 0x2c, 0xd4, 0x70, 0xd8, 0x43, 0xdb, 0x2d,
+		0x79, 0x4d, 0x36, 0xc8, 0x25, 0x24, 0x65, 0xc4, 0x90, 0x6d, 0x23, 0xb5,
+		0x8c, 0x36, 0x92, 0x27, 0x1b, 0x98, 0x05, 0x2f, 0x30, 0x78, 0xbd, 0x4d,
+		0x52, 0x68, 0x1b, 0xd8, 0x5b, 0x32, 0x52, 0x15, 0x17, 0x17, 0x15, 0x6e,
+		0x66, 0x3f, 0xcd, 0xee, 0xd2, 0x34, 0xbd, 0x0e, 0x47, 0xf1, 0x05, 0x25,
+		0xa6, 0x0e, 0x37, 0xb7, 0xcb, 0xa7, 0xfa, 0x71, 0x9a, 0x14, 0x80, 0x28,
+		0xec, 0x9f, 0x8f, 0x45, 0xb2, 0x91, 0x2a, 0x5b, 0x99, 0x4f, 0x0e, 0x17,
+		0x12, 0xf6, 0xcb, 0x27, 0xec, 0x9a, 0x07, 0xe3, 0xf6, 0x08, 0x0e, 0x83,
+		0x77, 0x75, 0xcc, 0x49, 0x47, 0x79, 0x2c, 0xa7, 0xe4, 0x48, 0x8e, 0xab,
+		0xf6, 0xfc, 0x19, 0x17, 0x5d, 0xec, 0xc9, 0x82, 0x86, 0xc1, 0xbb, 0x2d,
+		0x99, 0x2a, 0x3f, 0xe7, 0x29, 0x37, 0x14, 0x4d, 0x80, 0x71, 0x6e, 0x28,
+		0x8a, 0x65, 0xa7, 0xed, 0x06, 0x8f, 0x7f, 0xfe, 0x8d, 0xb9, 0x21, 0x8b,
+		0xd3, 0x41, 0x38, 0xd6, 0xee, 0xf1, 0xe1, 0xaf, 0xe4, 0xe3, 0x59, 0x3e,
+		0x38, 0x3f, 0x5f, 0x74, 0x6e, 0x87, 0x17, 0x50, 0xce, 0x36, 0xda, 0xf7,
+		0xe7, 0xe7, 0xb9, 0x7c, 0x9d, 0x8c, 0x4b, 0x7d, 0xf3, 0x5f, 0x52, 0x5a,
+		0xd0, 0xe6, 0x57, 0x90, 0xfe, 0x4f, 0x3f, 0xa6, 0x31, 0x72, 0x54, 0x58,
+		0x8e, 0xe3, 0x8c, 0xe7, 0x77, 0x67, 0x99, 0xc3, 0x09, 0x26, 0x6d, 0xba,
+		0xd8, 0x71, 0xf4, 0x5a, 0x82, 0x56, 0x52, 0x94, 0x58, 0xa5, 0x8c, 0xb0,
+		0xc7, 0x0f, 0x1b, 0xde, 0xbf, 0x5e, 0xe1, 0x54, 0x94, 0x8b, 0xe1, 0x0c,
+		0xd4, 0x04, 0xf6, 0x58, 0x8d, 0x07, 0x43, 0x76, 0x85, 0x5d, 0xc7, 0x16,
+		0xd1, 0x6e, 0xac, 0xdb, 0xd9, 0x1c, 0xb5, 0x81, 0x42, 0x97, 0x06, 0xef,
+		0x49, 0x5b, 0x38, 0x8f, 0xc7, 0x87, 0x4f, 0x6f, 0x74, 0x6a, 0x19, 0x1c,
+		0x82, 0x8b, 0xaa, 0x7b, 0x7c, 0xf8, 0x17, 0xaf, 0xf1, 0xfb, 0xab, 0x4b,
+		0x78, 0xde, 0x6a, 0xde, 0x65, 0xf5, 0xd7, 0x5e, 0x73, 0x33, 0x42, 0x1c,
+		0xea, 0x64, 0x65, 0xaa, 0x24, 0xf8, 0x51, 0xfa, 0x4a, 0xb0, 0x36, 0x68,
+		0x1b, 0xb9, 0x3a, 0x90, 0x4a, 0x97, 0x9e, 0xd1, 0xa2, 0x58, 0xeb, 0x67,
+		0xb4, 0xf8, 0x9e, 0x55, 0x0c, 0xbc, 0x82, 0x44, 0xa5, 0x98, 0x6b, 0x01,
+		0xd9, 0x1a, 0x6b, 0x6e, 0x9c, 0xe7, 0xc4, 0xda, 0xa7, 0xa7, 0xc3, 0xe5,
+		0x41, 0x80, 0x15, 0x9c, 0x85, 0x21, 0xdf, 0x1e, 0xde, 0xae, 0xe0, 0xf4,
+		0xf5, 0x2b, 0x34, 0x89, 0xee, 0x59, 0xe2, 0xee, 0xb5, 0x6c, 0xf6, 0x69,
+		0x7b, 0xd0, 0x21, 0x82, 0x7d, 0xe2, 0x03, 0x67, 0xb9, 0x1c, 0x52, 0x0c,
+		0x0f, 0x1b, 0x2c, 0x0b, 0x98, 0x62, 0xbf, 0x65, 0x9b, 0x22, 0x20, 0x45,
+		0xf1, 0x79, 0x63, 0xe6, 0x2d, 0x30, 0x82, 0x44, 0xdf, 0xe6, 0x90, 0x1f,
+		0x9a, 0xec, 0x74, 0xe8, 0x0e, 0x7b, 0x01, 0x9e, 0xd2, 0x15, 0x32, 0x79,
+		0xb6, 0xa9, 0x53, 0x31, 0xfb, 0xf7, 0x79, 0x49, 0x3f, 0x3e, 0xfc, 0x23,
+		0xb0, 0xac, 0x58, 0x84, 0x7c, 0x8e, 0x5a, 0x4f, 0x1b, 0x3e, 0x0a, 0x27,
+		0xe0, 0xa6, 0x61, 0x95, 0x97, 0xe9, 0x69, 0xda, 0xac, 0xa3, 0x27, 0xd1,
+		0x92, 0xf5, 0xa3, 0xcc, 0x1e, 0xd6, 0x05, 0x96, 0xb3, 0x84, 0xfe, 0xf4,
+		0x30, 0x8f, 0x3b, 0x31, 0xf5, 0xb8, 0xc4, 0x86, 0x79, 0xc8, 0x43, 0x49,
+		0xd4, 0x81, 0xd1, 0x90, 0x04, 0x90, 0xad, 0x61, 0x98, 0x6c, 0x55, 0xfc,
+		0x37, 0x00, 0x50, 0x4b, 0x07, 0x08, 0x30, 0x49, 0x74, 0xa9, 0xfe, 0x03,
+		0x00, 0x00, 0xa4, 0x06, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x00, 0x09, 0x00,
+		0x64, 0x6f, 0x63, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
 		0xce, 0x12, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00,
 		0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x14, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63,
-		0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x2e, 0x67, 0x69, 0x74, 0x69, 0x67,
-		0x6e, 0x6f, 0x72, 0x65, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce,
-		0x12, 0x4c, 0xcb, 0x41, 0x0a, 0x42, 0x31, 0x10, 0x03, 0xd0, 0x7d, 0x4f,
-		0x51, 0x70, 0x27, 0x74, 0xfe, 0x21, 0x3c, 0x49, 0xe9, 0xc4, 0x52, 0xb0,
-		0x7f, 0x64, 0x32, 0x5f, 0x3d, 0xbe, 0x20, 0x55, 0xdc, 0x85, 0x97, 0xe4,
-		0x94, 0x2f, 0xa6, 0x96, 0x09, 0x72, 0xd8, 0x9e, 0xab, 0xc7, 0xb8, 0xd6,
-		0x16, 0x4c, 0xd2, 0x6e, 0xf5, 0x50, 0x6c, 0xab, 0xd9, 0x7e, 0x20, 0xcd,
-		0xd4, 0x8a, 0xe3, 0x6e, 0x1e, 0x7f, 0xba, 0x76, 0xa5, 0xd9, 0x9c, 0x23,
-		0xca, 0x04, 0x59, 0x3b, 0x24, 0x5e, 0x91, 0xce, 0x9f, 0x8b, 0xec, 0x78,
-		0x7e, 0xa3, 0x63, 0xda, 0x03, 0x2a, 0x3c, 0x7a, 0x07, 0x03, 0x9a, 0xde,
-		0x01, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0xb8, 0x65, 0xd2,
-		0x81, 0x64, 0x00, 0x00, 0x00, 0x88, 0x00, 0x00, 0x00, 0x50, 0x4b, 0x03,
+		0x00, 0x00, 0x00, 0x00, 0x0e, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x63, 0x73,
+		0x2f, 0x72, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x2e, 0x6d, 0x64, 0x55, 0x54,
+		0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x00, 0x1c, 0x00, 0xe3, 0xff,
+		0x44, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x20, 0x64, 0x6f,
+		0x63, 0x73, 0x20, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64,
+		0x65, 0x72, 0x2e, 0x0a, 0x03, 0x00, 0x50, 0x4b, 0x07, 0x08, 0x74, 0x98,
+		0xfb, 0x78, 0x23, 0x00, 0x00, 0x00, 0x1c, 0x00, 0x00, 0x00, 0x50, 0x4b,
+		0x03, 0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x0a, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64,
+		0x65, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50,
+		0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x14, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
+		0x64, 0x65, 0x2f, 0x2e, 0x67, 0x69, 0x74, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+		0x65, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x4c, 0xcb,
+		0x41, 0x0a, 0x42, 0x31, 0x10, 0x03, 0xd0, 0x7d, 0x4f, 0x51, 0x70, 0x27,
+		0x74, 0xfe, 0x21, 0x3c, 0x49, 0xe9, 0xc4, 0x52, 0xb0, 0x7f, 0x64, 0x32,
+		0x5f, 0x3d, 0xbe, 0x20, 0x55, 0xdc, 0x85, 0x97, 0xe4, 0x94, 0x2f, 0xa6,
+		0x96, 0x09, 0x72, 0xd8, 0x9e, 0xab, 0xc7, 0xb8, 0xd6, 0x16, 0x4c, 0xd2,
+		0x6e, 0xf5, 0x50, 0x6c, 0xab, 0xd9, 0x7e, 0x20, 0xcd, 0xd4, 0x8a, 0xe3,
+		0x6e, 0x1e, 0x7f, 0xba, 0x76, 0xa5, 0xd9, 0x9c, 0x23, 0xca, 0x04, 0x59,
+		0x3b, 0x24, 0x5e, 0x91, 0xce, 0x9f, 0x8b, 0xec, 0x78, 0x7e, 0xa3, 0x63,
+		0xda, 0x03, 0x2a, 0x3c, 0x7a, 0x07, 0x03, 0x9a, 0xde, 0x03, 0x00, 0x50,
+		0x4b, 0x07, 0x08, 0xb8, 0x65, 0xd2, 0x81, 0x61, 0x00, 0x00, 0x00, 0x88,
+		0x00, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x11, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74,
+		0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74,
+		0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50,
+		0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x1a, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
+		0x64, 0x65, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x72, 0x65,
+		0x61, 0x64, 0x65, 0x72, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01,
+		0x00, 0xa6, 0xce, 0x12, 0x4c, 0x93, 0xd1, 0x6a, 0x63, 0x37, 0x10, 0x86,
+		0xef, 0xf5, 0x14, 0x3f, 0x14, 0x16, 0x9b, 0xfa, 0x9c, 0x24, 0x85, 0xd2,
+		0xad, 0x17, 0x0a, 0x9b, 0xa4, 0x85, 0x40, 0xdb, 0x94, 0xa6, 0x77, 0xa5,
+		0x17, 0x63, 0x69, 0x9c, 0x23, 0x2c, 0x69, 0x4e, 0x35, 0x73, 0xec, 0x75,
+		0x97, 0x85, 0xdc, 0xee, 0x23, 0x14, 0xda, 0xbb, 0x7d, 0x32, 0x3f, 0x49,
+		0xd1, 0x71, 0x36, 0xf4, 0x4e, 0x0c, 0xa3, 0x5f, 0xf3, 0x7f, 0xff, 0xa8,
+		0xeb, 0x3a, 0x57, 0x28, 0xf3, 0x1a, 0x95, 0x29, 0x70, 0x75, 0x81, 0xd5,
+		0xd7, 0x38, 0x5a, 0x94, 0xb2, 0xc6, 0x77, 0x0e, 0x78, 0xf0, 0x32, 0x19,
+		0xa6, 0xb2, 0x2b, 0x72, 0x28, 0xa0, 0xca, 0xa4, 0x38, 0x44, 0x1b, 0x5a,
+		0x75, 0x94, 0x94, 0x26, 0x8b, 0xe5, 0x11, 0x36, 0x30, 0x32, 0xc5, 0x02,
+		0x1b, 0x9a, 0x50, 0x8f, 0x9f, 0x68, 0x84, 0x97, 0x62, 0x95, 0xbc, 0x29,
+		0x5e, 0x21, 0x90, 0x11, 0xb6, 0x49, 0x0e, 0xba, 0x72, 0x68, 0xed, 0x05,
+		0x6a, 0x32, 0xf6, 0xf8, 0x99, 0xf7, 0x5c, 0xc1, 0x21, 0x1a, 0xbc, 0x04,
+		0xee, 0x9d, 0x89, 0x24, 0x5d, 0xe3, 0xf7, 0x5f, 0x99, 0xc2, 0x0a, 0xd7,
+		0xa4, 0xc3, 0x1f, 0x2e, 0x4b, 0xe0, 0xb4, 0x86, 0x4a, 0x29, 0x6c, 0x2e,
+		0x70, 0x8a, 0x7b, 0xae, 0xb4, 0x49, 0xbc, 0x46, 0x10, 0xaf, 0x17, 0x95,
+		0x75, 0x4a, 0xa6, 0x17, 0xef, 0xdf, 0xef, 0xf8, 0xf8, 0xe1, 0x43, 0x7f,
+		0x36, 0xd3, 0xe7, 0xe0, 0x5c, 0x73, 0xe8, 0xbe, 0xc0, 0xf5, 0x14, 0x1e,
+		0xd9, 0xf0, 0x0a, 0x0f, 0x26, 0xe3, 0xda, 0xb9, 0x0e, 0xa7, 0x8f, 0x9f,
+		0xf0, 0x1a, 0xed, 0x15, 0x78, 0x4a, 0x49, 0x57, 0x73, 0xe5, 0xdb, 0xcb,
+		0x4b, 0x98, 0xec, 0xb8, 0x28, 0x38, 0x47, 0x33, 0x0e, 0xe7, 0xfa, 0xd5,
+		0x25, 0x72, 0x2c, 0x93, 0xb1, 0xe2, 0x40, 0x29, 0xc1, 0x62, 0xe6, 0xde,
+		0x75, 0xb3, 0x1c, 0x62, 0xce, 0x1c, 0x22, 0x19, 0xa7, 0x23, 0xe2, 0x16,
+		0x63, 0xa2, 0xd2, 0xe7, 0x00, 0x4a, 0x8d, 0xc5, 0x11, 0x5e, 0xf6, 0x5c,
+		0xb5, 0x99, 0xc6, 0x36, 0x26, 0x56, 0x1c, 0x65, 0x6a, 0x24, 0x41, 0x9b,
+		0x46, 0xd1, 0x04, 0x99, 0xc6, 0xbe, 0x4d, 0x79, 0x2b, 0xf3, 0x68, 0x37,
+		0x2f, 0xe0, 0x16, 0x03, 0x95, 0x90, 0xb8, 0xea, 0xc5, 0xcd, 0x8f, 0x77,
+		0xcb, 0x19, 0xfc, 0xac, 0x81, 0x91, 0x6c, 0x50, 0x7c, 0x89, 0x14, 0x0b,
+		0x43, 0x47, 0x2a, 0x8a, 0xc5, 0xe9, 0xe3, 0xa7, 0xab, 0x4b, 0x70, 0xb1,
+		0x1a, 0x59, 0x97, 0xae, 0xc3, 0xed, 0x0b, 0x74, 0x2c, 0x6e, 0xaf, 0x57,
+		0xf8, 0x73, 0xe2, 0x89, 0x75, 0x05, 0x7e, 0x67, 0x5c, 0x0b, 0x25, 0xbc,
+		0xfd, 0xe5, 0x4e, 0x97, 0x20, 0xc5, 0x55, 0xf7, 0x15, 0x42, 0xa4, 0xc7,
+		0x4a, 0x59, 0xb1, 0x78, 0xfb, 0x70, 0x73, 0x77, 0x07, 0xd9, 0x35, 0x8d,
+		0xef, 0xdf, 0x45, 0x3d, 0x47, 0xcc, 0x6a, 0x7a, 0xf6, 0xf2, 0x9c, 0xb8,
+		0x7e, 0x1e, 0x63, 0x91, 0x62, 0xd9, 0x29, 0xa4, 0xa4, 0x63, 0xbb, 0xf2,
+		0x75, 0xf7, 0x0d, 0xc8, 0x7b, 0x1e, 0x8d, 0x8a, 0x67, 0xf8, 0x81, 0xfd,
+		0x4e, 0xe1, 0xa9, 0x84, 0x18, 0xa8, 0x11, 0x5c, 0x7c, 0xde, 0x8c, 0x2e,
+		0xf1, 0x9e, 0xd3, 0xd2, 0x01, 0x1d, 0x4e, 0x4f, 0xff, 0xfc, 0x30, 0xd3,
+		0x31, 0x81, 0xc9, 0xe4, 0x87, 0xd3, 0xd3, 0xbf, 0x08, 0x95, 0xb6, 0x36,
+		0x3b, 0x7b, 0xbd, 0x5c, 0x41, 0xa5, 0x1a, 0x07, 0x6c, 0x8e, 0x88, 0x79,
+		0x24, 0x6f, 0xae, 0xc3, 0xfd, 0xc8, 0xa5, 0xf9, 0xd2, 0xb6, 0xb1, 0x8a,
+		0xc5, 0x6f, 0xf7, 0xb7, 0xf7, 0x8b, 0x61, 0xca, 0x54, 0x96, 0xcb, 0x15,
+		0x98, 0xfc, 0xd0, 0xe2, 0xbb, 0x9a, 0x39, 0x9d, 0x09, 0x97, 0xd3, 0xd3,
+		0xdf, 0x36, 0x63, 0x7e, 0x3e, 0x43, 0xa7, 0x9c, 0xa9, 0xc6, 0xbf, 0xb8,
+		0x99, 0xc2, 0x61, 0x90, 0xc4, 0xa8, 0x3c, 0xca, 0x1b, 0x84, 0xe7, 0x8e,
+		0xca, 0x6a, 0x64, 0x0c, 0xd9, 0xec, 0xa3, 0x4c, 0x8a, 0x6d, 0xa5, 0xcc,
+		0x07, 0xa9, 0x3b, 0x6c, 0x78, 0xa0, 0x7d, 0x94, 0xda, 0xff, 0x4f, 0x6f,
+		0xac, 0x32, 0x8a, 0xf2, 0xbc, 0xd4, 0xfa, 0xa6, 0xa5, 0x5d, 0x21, 0x93,
+		0x8d, 0x93, 0x21, 0x2a, 0x08, 0x3a, 0x7f, 0xa9, 0x4d, 0x8d, 0xbc, 0x85,
+		0x94, 0x74, 0xec, 0xdd, 0x7f, 0x03, 0x00, 0x50, 0x4b, 0x07, 0x08, 0x35,
+		0x48, 0x73, 0x56, 0x4f, 0x02, 0x00, 0x00, 0x87, 0x03, 0x00, 0x00, 0x50,
+		0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x1c, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
+		0x64, 0x65, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x72, 0x65,
+		0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05,
+		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x4c, 0x92, 0x51, 0x4e, 0x1b, 0x3b,
+		0x14, 0x86, 0xdf, 0x67, 0x15, 0xbf, 0x84, 0x74, 0x45, 0x74, 0x99, 0x24,
+		0x70, 0xa5, 0xab, 0x76, 0x78, 0x02, 0x9a, 0x16, 0x2a, 0x35, 0x49, 0x13,
+		0xda, 0x97, 0xaa, 0x0f, 0xc6, 0x3e, 0x93, 0x39, 0x8d, 0xc7, 0x1e, 0xf9,
+		0x1c, 0x27, 0x44, 0x84, 0x05, 0xb0, 0x0f, 0x56, 0xc6, 0x4a, 0xaa, 0x49,
+		0x4b, 0xd5, 0x27, 0xcb, 0xd2, 0x6f, 0xfb, 0xfb, 0xfc, 0x9f, 0xb2, 0x2c,
+		0x8b, 0x60, 0x5a, 0xaa, 0x90, 0x68, 0xc3, 0xb4, 0xa5, 0x54, 0x38, 0x12,
+		0x9b, 0xb8, 0x53, 0x8e, 0xa1, 0xc2, 0x7b, 0x0e, 0xc6, 0xc3, 0x64, 0xc7,
+		0x0a, 0xb3, 0x32, 0x1c, 0x44, 0x61, 0xac, 0xa5, 0x4e, 0x4d, 0xb0, 0x04,
+		0xdb, 0x90, 0x5d, 0xcb, 0x39, 0x42, 0x04, 0x39, 0x56, 0x19, 0x62, 0x96,
+		0xb5, 0xcb, 0x8a, 0x18, 0x08, 0x9d, 0x59, 0x11, 0x4c, 0x70, 0x10, 0x8d,
+		0xdd, 0xb0, 0xd0, 0x18, 0xbd, 0x54, 0xf8, 0xb6, 0x20, 0xe3, 0x4e, 0x70,
+		0x69, 0xa4, 0xf9, 0x5e, 0xb4, 0xd1, 0x91, 0xaf, 0x20, 0x31, 0x04, 0xd2,
+		0xc2, 0x91, 0xe7, 0x0d, 0x25, 0x73, 0xe7, 0xa9, 0x82, 0x8b, 0x56, 0x46,
+		0x89, 0x24, 0x7b, 0x95, 0xd1, 0xc3, 0xc3, 0x9a, 0x76, 0x8f, 0x8f, 0xc3,
+		0x57, 0xc8, 0x61, 0xeb, 0x8a, 0x1e, 0xfd, 0x08, 0x97, 0xd9, 0xad, 0x48,
+		0xf1, 0x0f, 0x96, 0x1a, 0xbb, 0xaa, 0x28, 0xb1, 0x38, 0x44, 0x10, 0x83,
+		0xdf, 0xc1, 0x71, 0x5d, 0x0b, 0x38, 0x40, 0x1b, 0x82, 0xcd, 0x29, 0x51,
+		0x50, 0xdc, 0x25, 0x13, 0x6c, 0x73, 0x8e, 0x97, 0xa7, 0x67, 0x9c, 0x9e,
+		0xa1, 0x66, 0x4f, 0xf2, 0x6b, 0xf7, 0x76, 0x3c, 0x86, 0xc6, 0x35, 0x05,
+		0x01, 0xb5, 0xac, 0x4a, 0x6e, 0x58, 0x14, 0x47, 0xbf, 0x95, 0xfa, 0xbb,
+		0x97, 0xb9, 0x6d, 0x4d, 0xda, 0xe1, 0xf8, 0xe5, 0xe9, 0xf9, 0xcd, 0x6b,
+		0x76, 0x50, 0x21, 0xb1, 0xac, 0xe1, 0x69, 0x43, 0x1e, 0xff, 0x42, 0x63,
+		0x87, 0xff, 0x60, 0x63, 0xb0, 0x94, 0x82, 0x14, 0x25, 0x3e, 0x99, 0xb4,
+		0x76, 0x71, 0x1b, 0xa0, 0xe6, 0xce, 0x13, 0x8e, 0x85, 0xc3, 0xca, 0x13,
+		0xc4, 0x26, 0xa2, 0x30, 0xc0, 0x96, 0xb5, 0x81, 0x8d, 0x3e, 0xb7, 0x41,
+		0xaa, 0x02, 0x07, 0x20, 0xec, 0xe1, 0x39, 0xf4, 0x0b, 0x8b, 0x64, 0xea,
+		0xdf, 0x3b, 0x3d, 0x1b, 0x0f, 0xb0, 0x47, 0xca, 0x9e, 0x46, 0x7f, 0x35,
+		0xb0, 0x87, 0xe4, 0xd5, 0x8a, 0x44, 0xc9, 0xa1, 0xe6, 0xfb, 0x43, 0xf2,
+		0xff, 0xf1, 0xa0, 0x28, 0xf1, 0x6e, 0x72, 0x75, 0xb3, 0xbc, 0x99, 0x4d,
+		0x2b, 0x5c, 0xcc, 0xe7, 0x8b, 0xd9, 0xd7, 0x09, 0xf6, 0x58, 0x4c, 0x3e,
+		0x7f, 0x99, 0x2c, 0x6f, 0xcb, 0xab, 0xeb, 0x8b, 0xe9, 0x87, 0xc9, 0xb2,
+		0xb7, 0x9b, 0x47, 0xcf, 0x76, 0xd7, 0xdb, 0x2d, 0xe8, 0x07, 0x59, 0x85,
+		0x26, 0xde, 0xb0, 0xf1, 0xe8, 0xbc, 0xd1, 0x3a, 0xa6, 0x16, 0x4a, 0xa2,
+		0x82, 0xe3, 0xeb, 0xdb, 0xdb, 0x39, 0xce, 0xc6, 0x63, 0x8c, 0xf0, 0x71,
+		0x39, 0x9b, 0x96, 0xd2, 0x98, 0x8e, 0x06, 0xc8, 0xc1, 0x93, 0x48, 0xef,
+		0xab, 0xc9, 0x58, 0x2d, 0xa5, 0x23, 0xcb, 0x35, 0xdb, 0x61, 0x51, 0x62,
+		0x9e, 0xa8, 0xa6, 0x04, 0x0e, 0x1b, 0x93, 0xd8, 0x04, 0x15, 0x68, 0x63,
+		0x14, 0xdb, 0x98, 0xbd, 0x83, 0x35, 0x6a, 0x1b, 0x24, 0x32, 0x9e, 0x45,
+		0xd9, 0xa2, 0xcd, 0x6a, 0x94, 0x63, 0x90, 0xfe, 0xe4, 0xf4, 0xcf, 0x40,
+		0xdd, 0xd4, 0x30, 0xbd, 0x19, 0x58, 0x5e, 0xd1, 0x4e, 0x60, 0x59, 0xe9,
+		0x50, 0x2a, 0xdd, 0x1b, 0xab, 0x87, 0xbf, 0x12, 0x98, 0xe0, 0x20, 0x1a,
+		0xbb, 0x61, 0xf1, 0x73, 0x00, 0x50, 0x4b, 0x07, 0x08, 0xad, 0xb9, 0x75,
+		0x0e, 0xf7, 0x01, 0x00, 0x00, 0xd6, 0x02, 0x00, 0x00, 0x50, 0x4b, 0x03,
 		0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x11,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x13,
 		0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65,
-		0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00,
-		0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08,
+		0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x55, 0x54,
+		0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x03, 0x04, 0x14,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1b, 0x00, 0x09,
+		0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63,
+		0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
+		0x61, 0x63, 0x74, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce,
+		0x12, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00,
+		0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x26, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c,
+		0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+		0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x2f, 0x72, 0x65,
+		0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05,
+		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x54, 0x52, 0xcd, 0x6e, 0xd3, 0x4a,
+		0x14, 0xde, 0xcf, 0x53, 0x7c, 0xea, 0x5d, 0xf4, 0x62, 0x79, 0x5c, 0xb5,
+		0x88, 0x8d, 0x4b, 0x91, 0x2a, 0xa5, 0x52, 0x91, 0x08, 0x45, 0x2d, 0x2c,
+		0x42, 0x14, 0x29, 0xd3, 0x99, 0xe3, 0x78, 0x94, 0xc9, 0x8c, 0x99, 0x73,
+		0x4c, 0xe2, 0x1d, 0x5b, 0xd6, 0xbc, 0x02, 0x4f, 0xd6, 0x27, 0x41, 0x6e,
+		0xd2, 0x52, 0x76, 0x96, 0x47, 0xdf, 0xff, 0xd1, 0x5a, 0x2b, 0x47, 0x6c,
+		0xb3, 0xef, 0xc4, 0xa7, 0x58, 0x63, 0xe2, 0x59, 0x7c, 0x08, 0x90, 0x96,
+		0xc0, 0xc4, 0xec, 0x53, 0x3c, 0x66, 0x04, 0x32, 0x39, 0xfa, 0xb8, 0x3a,
+		0xc7, 0x36, 0x7b, 0x21, 0x48, 0x42, 0x20, 0xb7, 0xa2, 0x8c, 0x14, 0xc3,
+		0x00, 0xdf, 0x40, 0x72, 0x1f, 0x06, 0xf4, 0x4c, 0x4d, 0x1f, 0x2a, 0x65,
+		0xf2, 0xaa, 0xdf, 0x50, 0x14, 0xdd, 0xfa, 0x28, 0x35, 0xe6, 0x0d, 0x19,
+		0xe9, 0x33, 0xe9, 0x35, 0x0d, 0x0b, 0x65, 0x42, 0x48, 0x5b, 0x72, 0x5a,
+		0x52, 0x0a, 0x5c, 0xe3, 0x96, 0x8c, 0x2b, 0x71, 0xe5, 0xbc, 0x28, 0xad,
+		0xb5, 0x52, 0x97, 0x7e, 0x83, 0x26, 0x65, 0x9c, 0x3e, 0xfc, 0xf8, 0xf5,
+		0x1a, 0xf7, 0x7d, 0x08, 0x24, 0x8c, 0x8d, 0xd9, 0x9d, 0x83, 0x8c, 0x6d,
+		0x0f, 0x7f, 0xf0, 0xf0, 0xf3, 0xf7, 0xd9, 0x1b, 0x6c, 0x53, 0x76, 0x7c,
+		0x0e, 0x9b, 0xa2, 0xcd, 0x24, 0x54, 0x82, 0x69, 0xfc, 0xd0, 0x4d, 0x26,
+		0x2a, 0x91, 0xa9, 0x67, 0x73, 0x1f, 0xa8, 0x52, 0xea, 0x3f, 0x7c, 0xca,
+		0xc9, 0xf5, 0x96, 0x60, 0xc0, 0x6d, 0xca, 0x82, 0xa3, 0xab, 0x5d, 0x47,
+		0xd9, 0x53, 0xb4, 0x74, 0x84, 0xfb, 0x90, 0xec, 0xba, 0x56, 0x1a, 0xef,
+		0x9b, 0x31, 0x7a, 0xa6, 0x63, 0x46, 0x51, 0xc4, 0x24, 0xad, 0x8f, 0xab,
+		0x51, 0x45, 0x5a, 0xac, 0x89, 0x3a, 0x1f, 0x57, 0x45, 0x51, 0xa2, 0xcb,
+		0x3e, 0x0a, 0x68, 0x67, 0xac, 0x84, 0xa1, 0xc6, 0xf2, 0x2f, 0x55, 0x8d,
+		0x27, 0xd0, 0x63, 0x67, 0xe4, 0x96, 0x30, 0xd1, 0xa1, 0x28, 0x5c, 0x1a,
+		0x5f, 0x40, 0xce, 0x0b, 0x4c, 0x1c, 0xd0, 0xf8, 0x40, 0x45, 0x51, 0x29,
+		0x8d, 0x9b, 0x51, 0x70, 0xeb, 0x99, 0xf6, 0xb4, 0xb5, 0x52, 0x2f, 0xe8,
+		0x94, 0xc6, 0xdb, 0x43, 0xe2, 0xd3, 0x77, 0x80, 0x98, 0x15, 0x5f, 0xcc,
+		0x4d, 0x26, 0x53, 0x0a, 0xd9, 0xb6, 0xcc, 0x9e, 0xd7, 0x0b, 0x80, 0x6d,
+		0xea, 0xe8, 0x62, 0x9e, 0x1e, 0x47, 0x34, 0xe1, 0xa4, 0x33, 0xd2, 0xf2,
+		0xe2, 0x05, 0xf6, 0xec, 0x19, 0x5b, 0x55, 0xd5, 0x62, 0xac, 0xe3, 0xb2,
+		0xeb, 0x28, 0x3a, 0x74, 0x29, 0x78, 0x3b, 0x8c, 0x32, 0x37, 0x4f, 0x4b,
+		0xb6, 0x94, 0x09, 0x9e, 0x61, 0x64, 0xcc, 0xc0, 0x82, 0xa2, 0x48, 0x91,
+		0x8a, 0x02, 0x7b, 0x1f, 0x25, 0xcc, 0x1e, 0x2a, 0x09, 0x4b, 0x97, 0x2c,
+		0x9f, 0xd0, 0xb3, 0xdd, 0x93, 0xfd, 0x55, 0x54, 0x1b, 0xb7, 0x84, 0x8f,
+		0x90, 0xd6, 0x33, 0x9a, 0x94, 0x37, 0x46, 0x6a, 0x05, 0x2c, 0x35, 0xe6,
+		0xb3, 0xd9, 0x6c, 0xa6, 0xa7, 0x53, 0x3d, 0x99, 0x7c, 0xbe, 0xbe, 0xae,
+		0xa7, 0xd3, 0xfa, 0xee, 0xee, 0xeb, 0xe2, 0xd9, 0xa6, 0xd0, 0x4e, 0xfe,
+		0x71, 0xba, 0x54, 0x1a, 0x93, 0xb4, 0x9f, 0xa2, 0x28, 0xe0, 0xfa, 0x2e,
+		0x78, 0x6b, 0x84, 0x0e, 0x5e, 0x18, 0x26, 0x64, 0x32, 0x6e, 0x40, 0x97,
+		0x89, 0x29, 0x0a, 0xfe, 0x77, 0x09, 0x06, 0xdf, 0x7a, 0x6f, 0xd7, 0x8f,
+		0x6c, 0x60, 0x32, 0xd9, 0xb6, 0xaf, 0xc6, 0xa2, 0x3f, 0xd2, 0x77, 0xca,
+		0xf0, 0xd1, 0x86, 0xde, 0xd1, 0xe1, 0x52, 0xb8, 0xc4, 0x9a, 0x06, 0x2e,
+		0x21, 0x69, 0x4d, 0x91, 0x4b, 0xa4, 0x8c, 0x2f, 0xb7, 0x1f, 0x18, 0x5b,
+		0x2f, 0x2d, 0x6c, 0x26, 0x47, 0x51, 0xbc, 0x09, 0x5c, 0xa9, 0x3f, 0x03,
+		0x00, 0x50, 0x4b, 0x07, 0x08, 0xf8, 0xd6, 0xc9, 0xb6, 0x0b, 0x02, 0x00,
+		0x00, 0x2c, 0x03, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08,
 		0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1a, 0x00, 0x09, 0x00, 0x64,
-		0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x61, 0x67, 0x65,
-		0x6e, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x6d,
-		0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x4c, 0x93,
-		0x41, 0x6b, 0x23, 0x47, 0x10, 0x85, 0xef, 0xfd, 0x2b, 0x1e, 0x04, 0x16,
-		0x89, 0x68, 0xc6, 0x76, 0x20, 0x64, 0xa3, 0x85, 0xc0, 0xda, 0x4e, 0xc0,
-		0x90, 0xc4, 0x21, 0xce, 0x2d, 0xe4, 0x50, 0xea, 0x2e, 0x79, 0x1a, 0x75,
-		0x77, 0x4d, 0xba, 0x6a, 0xa4, 0x55, 0x96, 0x05, 0x5f, 0xf7, 0x27, 0x04,
-		0x92, 0xdb, 0xfe, 0x32, 0xfd, 0x92, 0xd0, 0x23, 0xaf, 0xc9, 0x6d, 0x28,
-		0x6a, 0xde, 0xbc, 0xf7, 0xbd, 0x9a, 0xae, 0xeb, 0x5c, 0xa1, 0xcc, 0x6b,
-		0x54, 0xa6, 0xc0, 0xd5, 0x05, 0x56, 0x5f, 0xe3, 0x68, 0x51, 0xca, 0x1a,
-		0xdf, 0x39, 0xe0, 0xc1, 0xcb, 0x64, 0x98, 0xca, 0xae, 0xc8, 0xa1, 0x80,
-		0x2a, 0x93, 0xe2, 0x10, 0x6d, 0x68, 0xd3, 0x51, 0x52, 0x9a, 0x2c, 0x96,
-		0x47, 0xd8, 0xc0, 0xc8, 0x14, 0x0b, 0x6c, 0x68, 0x42, 0x3d, 0x7e, 0xa2,
-		0x11, 0x5e, 0x8a, 0x55, 0xf2, 0xa6, 0x78, 0x85, 0x40, 0x46, 0xd8, 0x26,
-		0x39, 0xe8, 0xca, 0xa1, 0xad, 0x17, 0xa8, 0xc9, 0xd8, 0xe3, 0x67, 0xde,
-		0x73, 0x05, 0x87, 0x68, 0xf0, 0x12, 0xb8, 0x77, 0x26, 0x92, 0x74, 0x8d,
-		0xdf, 0x7f, 0x65, 0x0a, 0x2b, 0x5c, 0x93, 0x0e, 0x7f, 0xb8, 0x2c, 0x81,
-		0xd3, 0x1a, 0x2a, 0xa5, 0xb0, 0xb9, 0xc0, 0x29, 0xee, 0xb9, 0xd2, 0x26,
-		0xf1, 0x1a, 0x41, 0xbc, 0x5e, 0x54, 0xd6, 0x29, 0x99, 0x5e, 0xbc, 0x7f,
-		0xbf, 0xe3, 0xe3, 0x87, 0x0f, 0xfd, 0x39, 0x4c, 0x9f, 0x83, 0x73, 0x2d,
-		0xa1, 0xfb, 0x02, 0xd7, 0x53, 0x78, 0x64, 0xc3, 0x2b, 0x3c, 0x98, 0x8c,
-		0x6b, 0xe7, 0x3a, 0x9c, 0x3e, 0x7e, 0xc2, 0x6b, 0xb4, 0xaf, 0xc0, 0x53,
-		0x4a, 0xba, 0x9a, 0x27, 0xdf, 0x5e, 0x5e, 0xc2, 0x64, 0xc7, 0x45, 0xc1,
-		0x39, 0x9a, 0x71, 0x38, 0xcf, 0xaf, 0x2e, 0x91, 0x63, 0x99, 0x8c, 0x15,
-		0x07, 0x4a, 0x09, 0x16, 0x33, 0xf7, 0xae, 0x9b, 0xe5, 0x10, 0x73, 0xe6,
-		0x10, 0xc9, 0x38, 0x1d, 0x11, 0xb7, 0x18, 0x13, 0x95, 0x3e, 0x07, 0x50,
-		0x6a, 0x3e, 0x8e, 0xf0, 0xb2, 0xe7, 0xaa, 0x33, 0xa3, 0x6d, 0x4c, 0xac,
-		0x38, 0xca, 0xd4, 0x48, 0x82, 0x36, 0x8d, 0xa2, 0x09, 0x32, 0x8d, 0x7d,
-		0x73, 0x79, 0x2b, 0xb3, 0xb5, 0x9b, 0x17, 0x70, 0x8b, 0x81, 0x4a, 0x48,
-		0x5c, 0xf5, 0xe2, 0xe6, 0xc7, 0xbb, 0xe5, 0x0c, 0x7e, 0xd6, 0xc0, 0x48,
-		0x36, 0x28, 0xbe, 0x44, 0x8a, 0x85, 0xa1, 0x23, 0x15, 0xc5, 0xe2, 0xf4,
-		0xf1, 0xd3, 0xd5, 0x25, 0xb8, 0x58, 0x8d, 0xac, 0x4b, 0xd7, 0xe1, 0xf6,
-		0x05, 0x3a, 0x16, 0xb7, 0xd7, 0x2b, 0xfc, 0x39, 0xf1, 0xc4, 0xba, 0x02,
-		0xbf, 0x33, 0xae, 0x85, 0x12, 0xde, 0xfe, 0x72, 0xa7, 0x4b, 0x90, 0xe2,
-		0xaa, 0xfb, 0x0a, 0x21, 0xd2, 0x63, 0xa5, 0xac, 0x58, 0xbc, 0x7d, 0xb8,
-		0xb9, 0xbb, 0x83, 0xec, 0x9a, 0xc6, 0xf7, 0xef, 0xa2, 0x9e, 0x2b, 0x66,
-		0x35, 0x3d, 0x67, 0x79, 0x6e, 0x5c, 0x3f, 0xdb, 0x58, 0xa4, 0x58, 0x76,
-		0x0a, 0x29, 0xe9, 0xd8, 0x5e, 0xf9, 0xba, 0xfb, 0x06, 0xe4, 0x3d, 0x8f,
-		0x46, 0xc5, 0x33, 0xfc, 0xc0, 0x7e, 0xa7, 0xf0, 0x54, 0x42, 0x0c, 0xd4,
-		0x08, 0x2e, 0x3e, 0x5f, 0x46, 0x97, 0x78, 0xcf, 0x69, 0xe9, 0x80, 0x0e,
-		0xa7, 0xa7, 0x7f, 0x7e, 0x98, 0xe9, 0x98, 0xc0, 0x64, 0xf2, 0xc3, 0xe9,
-		0xe9, 0x5f, 0x84, 0x4a, 0x5b, 0x9b, 0x93, 0xbd, 0x5e, 0xae, 0xa0, 0x52,
-		0x8d, 0x03, 0x36, 0x47, 0xc4, 0x3c, 0x92, 0x37, 0xd7, 0xe1, 0x7e, 0xe4,
-		0xd2, 0x72, 0x69, 0xbb, 0x58, 0xc5, 0xe2, 0xb7, 0xfb, 0xdb, 0xfb, 0xc5,
-		0x30, 0x65, 0x2a, 0xcb, 0xe5, 0x0a, 0x4c, 0x7e, 0x68, 0xf5, 0x5d, 0xcd,
-		0x9c, 0xce, 0x84, 0xcb, 0xe9, 0xe9, 0x6f, 0x9b, 0x31, 0x3f, 0x3f, 0x43,
-		0xa7, 0x9c, 0xa9, 0xc6, 0xbf, 0x78, 0xae, 0xe8, 0x30, 0x48, 0x62, 0x54,
-		0x1e, 0xe5, 0x0d, 0xc2, 0xf3, 0x46, 0x65, 0x35, 0x32, 0x86, 0x6c, 0xf6,
-		0x51, 0x26, 0xc5, 0xb6, 0x52, 0xe6, 0x83, 0xd4, 0x1d, 0x36, 0x3c, 0xd0,
-		0x3e, 0x4a, 0xed, 0xff, 0xa7, 0x37, 0x56, 0x19, 0x45, 0x79, 0x3e, 0x6a,
-		0x7d, 0xd3, 0xda, 0xae, 0x90, 0xc9, 0xc6, 0xc9, 0x10, 0x15, 0x04, 0x9d,
-		0x7f, 0xa9, 0x4d, 0x8d, 0xbc, 0x9d, 0x99, 0xf5, 0xee, 0xbf, 0x00, 0x00,
-		0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0x35, 0x48, 0x73, 0x56, 0x51,
-		0x02, 0x00, 0x00, 0x87, 0x03, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1d, 0x00, 0x09, 0x00, 0x64,
+		0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d,
+		0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+		0x65, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce,
+		0x12, 0x5c, 0x53, 0xcb, 0x6e, 0x23, 0x37, 0x10, 0xbc, 0xcf, 0x57, 0x14,
+		0x90, 0x3d, 0x48, 0x5c, 0x0d, 0x0d, 0x67, 0xf3, 0x00, 0x6c, 0xe4, 0x22,
+		0xd8, 0x40, 0x8c, 0x20, 0xd8, 0xc0, 0xf6, 0x2d, 0x08, 0xb0, 0x6d, 0xb2,
+		0x47, 0x43, 0x88, 0x43, 0x0e, 0xd8, 0x4d, 0x69, 0x75, 0xf3, 0x69, 0x3f,
+		0x60, 0x91, 0x63, 0xf2, 0x73, 0xfa, 0x92, 0x05, 0x65, 0xf9, 0xe0, 0x3d,
+		0x76, 0xa3, 0x1f, 0xd5, 0x55, 0xd5, 0x7d, 0xdf, 0x77, 0x9e, 0xc5, 0x95,
+		0x30, 0x6b, 0xc8, 0xe9, 0x0a, 0xb7, 0x9f, 0xd9, 0x55, 0x65, 0xe8, 0xc8,
+		0x98, 0x42, 0x0a, 0x13, 0x45, 0xb8, 0x91, 0xd2, 0x86, 0x91, 0x98, 0x3d,
+		0x7b, 0x68, 0xc6, 0x44, 0x5b, 0x86, 0xcb, 0x49, 0x0b, 0x39, 0x85, 0xb2,
+		0xa8, 0x60, 0x53, 0x98, 0x13, 0x16, 0xca, 0xa2, 0x82, 0xe3, 0x97, 0xaf,
+		0x70, 0xd9, 0x33, 0x8e, 0x5f, 0xbe, 0xa2, 0x70, 0xa9, 0x69, 0xd9, 0x51,
+		0xd9, 0xd4, 0x89, 0x93, 0xf6, 0x63, 0x48, 0x7a, 0x85, 0xbf, 0x07, 0x26,
+		0xad, 0x85, 0xfb, 0x2d, 0x1f, 0xfe, 0xe9, 0x28, 0xc6, 0xbc, 0x67, 0xdf,
+		0x6b, 0xce, 0x51, 0xae, 0x70, 0xcf, 0xe4, 0x57, 0xb8, 0xf5, 0x41, 0x57,
+		0xf8, 0xb3, 0x46, 0x0d, 0xb7, 0x3e, 0xe8, 0xaa, 0x03, 0xd6, 0x24, 0xe3,
+		0x62, 0x93, 0xa1, 0x2c, 0x0a, 0x7b, 0x61, 0xad, 0x5d, 0xae, 0xb0, 0x26,
+		0x19, 0x17, 0x73, 0x9a, 0x27, 0x28, 0x8b, 0x9a, 0xe5, 0x0a, 0x6b, 0x92,
+		0x71, 0x91, 0xe6, 0x09, 0xca, 0xa2, 0x66, 0xb9, 0xc2, 0x9a, 0x64, 0x5c,
+		0x1c, 0xa8, 0xa4, 0x13, 0x5a, 0xf3, 0xda, 0xf4, 0x54, 0xd3, 0xdb, 0x9e,
+		0x5d, 0x38, 0x87, 0xaf, 0xbb, 0xea, 0x0e, 0xa5, 0x26, 0xcc, 0x87, 0x37,
+		0x65, 0xdf, 0x85, 0xd3, 0xee, 0xbb, 0x31, 0x9b, 0x42, 0x3e, 0xf2, 0xdb,
+		0xdc, 0x10, 0xab, 0x2a, 0x97, 0x73, 0xb2, 0x6b, 0xcc, 0x77, 0x3f, 0xe0,
+		0x2e, 0xcd, 0x55, 0xaf, 0xf0, 0xee, 0x12, 0xbf, 0xe1, 0xcc, 0x08, 0xb6,
+		0x7c, 0xe8, 0xba, 0x4b, 0x8b, 0x7b, 0xee, 0x0b, 0x93, 0xc7, 0x27, 0x9f,
+		0x9d, 0x5c, 0xc8, 0xcc, 0x4e, 0x2e, 0xde, 0x5d, 0xf6, 0x73, 0xa4, 0x64,
+		0x27, 0xff, 0xe9, 0x1a, 0x85, 0x45, 0x49, 0x19, 0xfc, 0xb9, 0xe9, 0x30,
+		0x84, 0xc8, 0xd2, 0xe4, 0xd1, 0x5c, 0xdd, 0x68, 0xbb, 0x1f, 0x2d, 0x8c,
+		0x79, 0x3c, 0xe9, 0x31, 0x84, 0x22, 0x8a, 0xc5, 0xab, 0x64, 0x7d, 0xe4,
+		0x1d, 0xc7, 0xa5, 0x31, 0x57, 0xf0, 0x85, 0x06, 0x05, 0x41, 0xc6, 0x5c,
+		0xf4, 0x5c, 0x8f, 0x07, 0x8e, 0xec, 0x9a, 0x1f, 0x8c, 0x41, 0x0c, 0xa2,
+		0xa8, 0x12, 0xd2, 0xe6, 0xe4, 0x89, 0xc7, 0x87, 0xdf, 0x71, 0x7c, 0xfe,
+		0x17, 0x73, 0xc9, 0x73, 0x16, 0xc6, 0x87, 0xfe, 0x57, 0x38, 0x92, 0xb6,
+		0x78, 0x24, 0x85, 0xe7, 0x81, 0x93, 0x47, 0x61, 0x8a, 0x08, 0x69, 0x47,
+		0x25, 0x50, 0x52, 0xb9, 0xc6, 0x53, 0x09, 0x3c, 0xc4, 0x03, 0x52, 0x56,
+		0xc6, 0x7e, 0x0c, 0x6e, 0xc4, 0xf1, 0xf9, 0xbf, 0xfc, 0xb4, 0x0b, 0xb9,
+		0xca, 0x85, 0x96, 0xb0, 0x0b, 0x14, 0x8f, 0xcf, 0xff, 0x9f, 0xd8, 0x11,
+		0x1c, 0x72, 0x05, 0x15, 0x86, 0x31, 0x29, 0xab, 0x31, 0x20, 0xef, 0x1b,
+		0x00, 0x4a, 0x1e, 0xfb, 0xf1, 0x60, 0xf1, 0x07, 0xf3, 0x8c, 0x81, 0x44,
+		0xaf, 0x31, 0x65, 0xb7, 0x45, 0x62, 0xdd, 0xe7, 0xb2, 0xbd, 0xb8, 0x59,
+		0xdb, 0xee, 0x83, 0xc5, 0xdd, 0x34, 0x47, 0x9e, 0x38, 0xb5, 0x83, 0x64,
+		0xa2, 0x18, 0x9b, 0x5b, 0x76, 0x81, 0x9e, 0x22, 0xc3, 0x87, 0x61, 0x30,
+		0xe6, 0x05, 0xad, 0x90, 0x06, 0x19, 0x02, 0x0b, 0xc8, 0x39, 0x9e, 0x95,
+		0x92, 0x63, 0xb8, 0x91, 0xdd, 0x56, 0x2c, 0x1e, 0x1b, 0x8b, 0xc8, 0x29,
+		0x1e, 0xd0, 0x18, 0x4f, 0xec, 0x5f, 0x18, 0xb6, 0xdd, 0x4f, 0x16, 0xf7,
+		0xcd, 0x38, 0x23, 0x43, 0x6a, 0x50, 0x46, 0x4d, 0x1a, 0x22, 0x8c, 0x39,
+		0xfd, 0x80, 0x31, 0x16, 0x77, 0x03, 0x6a, 0x2a, 0x1c, 0x49, 0x5b, 0x17,
+		0x85, 0x28, 0xa0, 0x79, 0x66, 0x2a, 0xab, 0x86, 0x48, 0xf3, 0xdc, 0x6e,
+		0x4a, 0xbe, 0xdd, 0x85, 0xc7, 0x8f, 0x37, 0x1f, 0x17, 0x63, 0x9d, 0x28,
+		0x2d, 0xb1, 0x0f, 0x3a, 0x9e, 0xb6, 0xe0, 0xfd, 0x89, 0x09, 0x24, 0x9a,
+		0xd8, 0x76, 0x3f, 0x5b, 0xdc, 0x4e, 0xa1, 0xc9, 0x64, 0x8c, 0xcb, 0xc9,
+		0x05, 0x61, 0xcc, 0xa4, 0x6e, 0x84, 0xd4, 0x69, 0xa2, 0x72, 0x30, 0x06,
+		0x8b, 0x97, 0x2f, 0x3d, 0x83, 0xc4, 0x7b, 0x14, 0x6a, 0x0a, 0x52, 0xe4,
+		0xa5, 0xed, 0x7e, 0xb1, 0x78, 0xd0, 0x3c, 0x5b, 0xdc, 0xe4, 0x26, 0x01,
+		0x5c, 0x9e, 0xda, 0xbc, 0x5c, 0x90, 0x67, 0x4e, 0xf8, 0xeb, 0x5e, 0x6c,
+		0xf7, 0x6d, 0x00, 0x50, 0x4b, 0x07, 0x08, 0x17, 0x4a, 0xcf, 0xd0, 0x8a,
+		0x02, 0x00, 0x00, 0x0b, 0x04, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14,
 		0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x00, 0x09,
-		0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x61,
-		0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-		0x65, 0x72, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
-		0xce, 0x12, 0x4c, 0x92, 0xd1, 0x4e, 0x1b, 0x39, 0x14, 0x86, 0xef, 0xe7,
-		0x29, 0x7e, 0x09, 0x69, 0x45, 0xb4, 0x4c, 0x12, 0x58, 0x69, 0xb5, 0x3b,
-		0x5c, 0x01, 0x9b, 0x2d, 0x54, 0x6a, 0x92, 0x26, 0xb4, 0x37, 0x55, 0x2f,
-		0x8c, 0x7d, 0x26, 0x73, 0x1a, 0x8f, 0x3d, 0xf2, 0x39, 0x4e, 0x88, 0x08,
-		0x0f, 0xc0, 0x7b, 0xf0, 0x64, 0x3c, 0x49, 0x35, 0xa1, 0x54, 0x5c, 0x59,
-		0x96, 0x7e, 0xfb, 0xfc, 0xdf, 0xf9, 0xff, 0xb2, 0x2c, 0x8b, 0x60, 0x5a,
-		0xaa, 0x90, 0x68, 0xc3, 0xb4, 0xa5, 0x54, 0x38, 0x12, 0x9b, 0xb8, 0x53,
-		0x8e, 0xa1, 0xc2, 0xff, 0x1c, 0x8c, 0x87, 0xc9, 0x8e, 0x15, 0x66, 0x65,
-		0x38, 0x88, 0xc2, 0x58, 0x4b, 0x9d, 0x9a, 0x60, 0x09, 0xb6, 0x21, 0xbb,
-		0x96, 0x73, 0x84, 0x08, 0x72, 0xac, 0x32, 0xc4, 0x2c, 0x6b, 0x97, 0x15,
-		0x31, 0x10, 0x3a, 0xb3, 0x22, 0x98, 0xe0, 0x20, 0x1a, 0xbb, 0x61, 0xa1,
-		0x31, 0x7a, 0xa9, 0xf0, 0x6d, 0x41, 0xc6, 0x9d, 0xe0, 0xd2, 0x48, 0xf3,
-		0xbd, 0x68, 0xa3, 0x23, 0x5f, 0x41, 0x62, 0x08, 0xa4, 0x85, 0x23, 0xcf,
-		0x1b, 0x4a, 0xe6, 0xce, 0x53, 0x05, 0x17, 0xad, 0x8c, 0x12, 0x49, 0xf6,
-		0x2a, 0xa3, 0x87, 0x87, 0x35, 0xed, 0x1e, 0x1f, 0x87, 0x6f, 0x26, 0x87,
-		0xad, 0x2b, 0x7a, 0xeb, 0x47, 0xb8, 0xcc, 0x6e, 0x45, 0x8a, 0x3f, 0xb0,
-		0xd4, 0xd8, 0x55, 0x45, 0x89, 0xc5, 0x41, 0x82, 0x18, 0xfc, 0x0e, 0x8e,
-		0xeb, 0x5a, 0xc0, 0x01, 0xda, 0x10, 0x6c, 0x4e, 0x89, 0x82, 0xe2, 0x2e,
-		0x99, 0x60, 0x9b, 0x73, 0xbc, 0x3c, 0x3d, 0xe3, 0xf4, 0x0c, 0x35, 0x7b,
-		0x92, 0xd7, 0xdb, 0xbf, 0xe3, 0x31, 0x34, 0xae, 0x29, 0x08, 0xa8, 0x65,
-		0x55, 0x72, 0xc3, 0xa2, 0x38, 0xfa, 0x85, 0xd4, 0xff, 0xbd, 0xcc, 0x6d,
-		0x6b, 0xd2, 0x0e, 0xc7, 0x2f, 0x4f, 0xcf, 0xff, 0xbc, 0x69, 0x07, 0x15,
-		0x12, 0xcb, 0x1a, 0x9e, 0x36, 0xe4, 0xf1, 0x27, 0x34, 0x76, 0xf8, 0x0b,
-		0x36, 0x06, 0x4b, 0x29, 0x48, 0x51, 0xe2, 0x93, 0x49, 0x6b, 0x17, 0xb7,
-		0x01, 0xda, 0x93, 0xe1, 0x58, 0x38, 0xac, 0x3c, 0x41, 0x6c, 0x22, 0x0a,
-		0x03, 0x6c, 0x59, 0x1b, 0xd8, 0xe8, 0x73, 0x1b, 0xa4, 0x2a, 0x70, 0x30,
-		0x84, 0x3d, 0x3c, 0x87, 0xfe, 0x60, 0x91, 0x4c, 0xfd, 0xbc, 0xd3, 0xb3,
-		0xf1, 0x00, 0x7b, 0xa4, 0xec, 0x69, 0xf4, 0x2e, 0x81, 0x3d, 0x24, 0xaf,
-		0x56, 0x24, 0x4a, 0x0e, 0x35, 0xdf, 0x1f, 0x94, 0x7f, 0x8f, 0x07, 0x45,
-		0x89, 0xff, 0x26, 0x57, 0x37, 0xcb, 0x9b, 0xd9, 0xb4, 0xc2, 0xc5, 0x7c,
-		0xbe, 0x98, 0x7d, 0x9d, 0x60, 0x8f, 0xc5, 0xe4, 0xf3, 0x97, 0xc9, 0xf2,
-		0xb6, 0xbc, 0xba, 0xbe, 0x98, 0x7e, 0x98, 0x2c, 0x7b, 0xba, 0x79, 0xf4,
-		0x6c, 0x77, 0xaf, 0x9b, 0xfb, 0x41, 0x56, 0xa1, 0x89, 0x37, 0x6c, 0x3c,
-		0x3a, 0x6f, 0xb4, 0x8e, 0xa9, 0x85, 0x92, 0xa8, 0xe0, 0xf8, 0xfa, 0xf6,
-		0x76, 0x8e, 0xb3, 0xf1, 0x18, 0x23, 0x7c, 0x5c, 0xce, 0xa6, 0xa5, 0x34,
-		0xa6, 0xa3, 0x01, 0x72, 0xf0, 0x24, 0xd2, 0xf3, 0x6a, 0x32, 0x56, 0x4b,
-		0xe9, 0xc8, 0x72, 0xcd, 0x76, 0x58, 0x94, 0x98, 0x27, 0xaa, 0x29, 0x81,
-		0xc3, 0xc6, 0x24, 0x36, 0x41, 0x05, 0xda, 0x18, 0xc5, 0x36, 0x66, 0xef,
-		0x60, 0x8d, 0xda, 0x06, 0x89, 0x8c, 0x67, 0x51, 0xb6, 0x68, 0xb3, 0x9a,
-		0xbe, 0x74, 0xd2, 0xbf, 0x9c, 0xfe, 0x2e, 0xd4, 0x4d, 0x0d, 0xd3, 0x93,
-		0x81, 0xe5, 0xcd, 0xda, 0x09, 0x2c, 0x2b, 0x1d, 0x42, 0xa5, 0x7b, 0x63,
-		0xf5, 0xb0, 0x2b, 0x79, 0x57, 0xb5, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff,
-		0x50, 0x4b, 0x07, 0x08, 0xad, 0xb9, 0x75, 0x0e, 0xf6, 0x01, 0x00, 0x00,
-		0xd6, 0x02, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x13, 0x00, 0x09, 0x00, 0x64, 0x6f,
-		0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
-		0x61, 0x6e, 0x64, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
-		0xce, 0x12, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1a, 0x00, 0x09,
+		0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63,
+		0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x70, 0x6c, 0x61, 0x6e,
+		0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
+		0x64, 0x93, 0x51, 0x6a, 0x1c, 0x47, 0x10, 0x86, 0xdf, 0xe7, 0x14, 0x3f,
+		0x8e, 0x1e, 0x76, 0x37, 0x3b, 0xb3, 0x5a, 0x3b, 0x10, 0x58, 0x11, 0xb0,
+		0x8c, 0x64, 0x23, 0x88, 0x63, 0xb3, 0x12, 0x84, 0x90, 0x04, 0x5c, 0xea,
+		0xae, 0x99, 0x69, 0x76, 0xa6, 0xab, 0xe9, 0xaa, 0x91, 0xb2, 0x17, 0x08,
+		0xe4, 0x35, 0x67, 0xc8, 0xc9, 0x7c, 0x92, 0xd0, 0x23, 0x7b, 0x15, 0xc8,
+		0xc3, 0xbc, 0xf4, 0xf4, 0xff, 0x57, 0x7d, 0xf5, 0x57, 0xd7, 0x75, 0x5d,
+		0x79, 0x56, 0x97, 0x43, 0xb2, 0x20, 0x71, 0x87, 0x8f, 0x03, 0x45, 0x10,
+		0x5c, 0x4f, 0xb1, 0x63, 0x70, 0xf4, 0xb5, 0x49, 0xcd, 0xd1, 0x63, 0x31,
+		0x52, 0xc2, 0xe7, 0x3f, 0xff, 0x46, 0x1a, 0x28, 0x2e, 0x11, 0x22, 0x24,
+		0x32, 0x12, 0xa9, 0x5e, 0x40, 0x4d, 0x12, 0x5a, 0xc9, 0xe8, 0xa7, 0x91,
+		0x22, 0x32, 0x3f, 0x04, 0x7e, 0xac, 0x28, 0x77, 0xd3, 0xc8, 0xd1, 0xea,
+		0x3e, 0x44, 0xdb, 0xe1, 0xd7, 0x96, 0xc9, 0xa6, 0xcc, 0xf5, 0x81, 0x8f,
+		0xbf, 0x57, 0x34, 0x0c, 0xf2, 0xc8, 0xbe, 0x36, 0x91, 0x41, 0x77, 0xd8,
+		0x33, 0xf9, 0x35, 0xae, 0x7d, 0xb0, 0x35, 0xde, 0x90, 0xf6, 0x8b, 0x2e,
+		0x18, 0x06, 0xad, 0xdb, 0x30, 0xb0, 0xee, 0x56, 0xcb, 0x35, 0xde, 0x90,
+		0xf6, 0x8b, 0x2e, 0x18, 0xba, 0xcc, 0x09, 0x75, 0x7c, 0x3e, 0x6c, 0x43,
+		0xf4, 0xd8, 0xad, 0x96, 0x55, 0x81, 0xa9, 0xbe, 0xc1, 0x4d, 0x4c, 0x93,
+		0xed, 0x70, 0xb6, 0xc5, 0x0f, 0xf8, 0x52, 0x13, 0x07, 0x3e, 0x56, 0xd5,
+		0xb6, 0xc1, 0xfe, 0xfa, 0xf2, 0x0a, 0x6f, 0x6f, 0xf6, 0xb7, 0x77, 0x0d,
+		0x6e, 0x0f, 0x61, 0xc4, 0xeb, 0x72, 0xf2, 0xfe, 0xba, 0x19, 0x3d, 0x28,
+		0x96, 0xef, 0x88, 0xd7, 0x5e, 0x9c, 0x6e, 0x7e, 0x5b, 0xa1, 0x34, 0x1f,
+		0x24, 0x86, 0xd8, 0xe1, 0xc5, 0xd9, 0xf6, 0x45, 0x53, 0xbd, 0x6c, 0xf0,
+		0x31, 0x8b, 0x9f, 0x1c, 0x63, 0xb5, 0x2a, 0xf8, 0x6d, 0x18, 0x78, 0xb5,
+		0xda, 0xe1, 0xd3, 0x2c, 0xd1, 0xc4, 0x4e, 0x37, 0x67, 0xdb, 0xba, 0x8c,
+		0xa8, 0x19, 0xfd, 0x27, 0x2c, 0x3e, 0xff, 0xf5, 0x0f, 0xbe, 0x3b, 0x3f,
+		0x87, 0xc9, 0x81, 0xa3, 0x2e, 0xe1, 0x24, 0x1a, 0x85, 0x62, 0xb9, 0xab,
+		0x00, 0xd4, 0x58, 0xad, 0xde, 0x53, 0xc2, 0xdd, 0x8f, 0x17, 0x57, 0xfb,
+		0xf9, 0xf6, 0xf6, 0xe5, 0xf9, 0xb2, 0x38, 0xba, 0x29, 0x67, 0x8e, 0x86,
+		0x7b, 0xee, 0xe9, 0x21, 0x48, 0xde, 0x14, 0x65, 0x26, 0x67, 0xba, 0x46,
+		0xca, 0x61, 0xa4, 0x7c, 0x9c, 0xab, 0xeb, 0x93, 0xea, 0x7c, 0x89, 0xc7,
+		0x60, 0x3d, 0x86, 0x10, 0x19, 0x9a, 0x28, 0x6a, 0xf3, 0xd5, 0xff, 0x9d,
+		0xd0, 0x50, 0x1c, 0x53, 0x96, 0xfb, 0x81, 0xc7, 0x39, 0x43, 0xcf, 0x1a,
+		0x32, 0xfb, 0x93, 0x3b, 0x16, 0x5b, 0x24, 0xca, 0xd4, 0x65, 0x4a, 0xfd,
+		0xf2, 0x24, 0xbd, 0x74, 0x8e, 0x93, 0x51, 0x74, 0x0c, 0xd7, 0xb3, 0x3b,
+		0x28, 0x16, 0xaf, 0xea, 0xef, 0xe7, 0xfe, 0x1e, 0x38, 0x87, 0x36, 0xd0,
+		0xfd, 0xc0, 0x70, 0x32, 0x8e, 0x14, 0xbd, 0xae, 0x11, 0xc5, 0x4a, 0x19,
+		0xe5, 0x93, 0xc3, 0xdb, 0x92, 0x1f, 0x4c, 0x60, 0x32, 0xb9, 0xbe, 0x08,
+		0xf9, 0x0f, 0x72, 0x86, 0x21, 0xa8, 0x5d, 0x20, 0x44, 0x2b, 0x8c, 0x89,
+		0xf3, 0xcc, 0x82, 0xc5, 0x81, 0x39, 0x21, 0x18, 0xb4, 0x97, 0x6c, 0xcb,
+		0x06, 0x1f, 0xe2, 0x70, 0x84, 0xf5, 0x3c, 0x2f, 0xdd, 0xd3, 0x9d, 0xa0,
+		0x78, 0xcc, 0xc1, 0x8c, 0x23, 0xfc, 0x94, 0x43, 0xec, 0xb0, 0x29, 0x3f,
+		0x4f, 0x15, 0xaf, 0x42, 0xdb, 0x42, 0x26, 0x2b, 0x93, 0x28, 0xf5, 0x92,
+		0xf2, 0xe4, 0xa5, 0x76, 0xe2, 0x19, 0xd2, 0x7e, 0x59, 0x6c, 0xc5, 0xa2,
+		0x9d, 0xa2, 0x2b, 0xe9, 0xea, 0x46, 0x2d, 0x4f, 0xce, 0x74, 0x93, 0x65,
+		0x32, 0xd6, 0x67, 0xfa, 0x7d, 0xd0, 0x83, 0xce, 0x16, 0x9c, 0xdb, 0xcd,
+		0x18, 0xba, 0x4c, 0x4f, 0x82, 0xfb, 0xcc, 0x74, 0xa0, 0x8e, 0xf1, 0x2d,
+		0x3c, 0x1b, 0xcf, 0x36, 0x18, 0xd9, 0x7a, 0xf1, 0x27, 0xf1, 0x1d, 0xab,
+		0x41, 0x2d, 0x93, 0x71, 0x77, 0x2c, 0x26, 0x5f, 0xf3, 0x83, 0xb1, 0x9a,
+		0x42, 0x66, 0x32, 0x41, 0xca, 0xf2, 0xc0, 0xff, 0xc9, 0xa1, 0x0c, 0x66,
+		0x06, 0xd5, 0xcd, 0xa9, 0xc3, 0xe7, 0x96, 0x3e, 0x4c, 0x56, 0x20, 0xd4,
+		0x49, 0x9a, 0xe1, 0x38, 0xb6, 0x92, 0x1d, 0xe3, 0x97, 0xcb, 0x77, 0x3f,
+		0xdd, 0x34, 0xd5, 0xab, 0x06, 0xb7, 0x26, 0xa9, 0xc1, 0xcf, 0x14, 0xec,
+		0x7f, 0x0f, 0xb2, 0xbc, 0x59, 0xd7, 0x53, 0xd9, 0xa9, 0x56, 0x32, 0xcf,
+		0xbb, 0xce, 0x3e, 0x98, 0x36, 0xd5, 0xbf, 0x03, 0x00, 0x50, 0x4b, 0x07,
+		0x08, 0xd8, 0x16, 0xc6, 0x04, 0x85, 0x02, 0x00, 0x00, 0x07, 0x04, 0x00,
+		0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00,
+		0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x24, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c,
+		0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+		0x73, 0x2f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x2d, 0x63, 0x6f,
+		0x6d, 0x6d, 0x69, 0x74, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01,
+		0x00, 0xa6, 0xce, 0x12, 0x9c, 0x52, 0x4b, 0x6b, 0xe3, 0x30, 0x10, 0xbe,
+		0xfb, 0x57, 0x7c, 0x0d, 0x4b, 0x89, 0x4b, 0x95, 0xd0, 0xee, 0xcd, 0xb0,
+		0x65, 0x5f, 0xec, 0x83, 0x65, 0xd9, 0x42, 0xf7, 0x5e, 0x0f, 0xd6, 0x38,
+		0x16, 0x91, 0x25, 0xa3, 0x19, 0x27, 0x3d, 0xf4, 0xc7, 0x2f, 0x72, 0x9c,
+		0x94, 0xf6, 0x14, 0xf6, 0x26, 0x46, 0x9f, 0xbe, 0xd7, 0xc8, 0x18, 0x53,
+		0x58, 0x96, 0x26, 0xb9, 0x41, 0x5d, 0x0c, 0x15, 0x1e, 0x94, 0x36, 0x0c,
+		0x8d, 0x63, 0xd3, 0xb1, 0x45, 0xeb, 0x3c, 0x0b, 0x28, 0x58, 0xd8, 0x44,
+		0xad, 0x82, 0xd0, 0xc4, 0xbe, 0x77, 0x8a, 0x9e, 0x45, 0x32, 0x70, 0x19,
+		0x22, 0x86, 0x51, 0xba, 0xb2, 0x20, 0xef, 0xe3, 0x9e, 0xad, 0xd1, 0x18,
+		0xbd, 0x54, 0xf8, 0x4c, 0xd2, 0x2d, 0x37, 0x4e, 0x41, 0xd6, 0x56, 0x57,
+		0xe5, 0xf5, 0xcb, 0x40, 0x94, 0x74, 0x94, 0xd7, 0x33, 0xeb, 0xda, 0x16,
+		0xc6, 0x48, 0x16, 0x7f, 0x03, 0x9f, 0x05, 0xcd, 0xb7, 0xd3, 0xb4, 0x21,
+		0x3d, 0x9d, 0xfb, 0xad, 0x75, 0x09, 0x66, 0xb8, 0x2a, 0x8b, 0x1c, 0xe5,
+		0xa6, 0x9c, 0x03, 0xf0, 0xd3, 0xe0, 0x5d, 0xe3, 0x14, 0x03, 0x69, 0x27,
+		0x68, 0x53, 0xec, 0xa1, 0x1d, 0x63, 0xf0, 0x14, 0x10, 0x13, 0x3c, 0xc9,
+		0xac, 0xba, 0xa4, 0x5d, 0x74, 0x16, 0x3e, 0x36, 0xdb, 0x29, 0xed, 0x7a,
+		0xc3, 0x81, 0x13, 0x29, 0x5b, 0x90, 0x08, 0xab, 0x94, 0xab, 0xa2, 0xb8,
+		0x2d, 0xf1, 0x75, 0x2a, 0x20, 0x73, 0xbc, 0xa9, 0x60, 0xe2, 0xfe, 0xb8,
+		0x6a, 0x3c, 0x8d, 0x96, 0xd7, 0xca, 0xfd, 0xe0, 0x49, 0x59, 0xd6, 0x07,
+		0xd8, 0xe3, 0xdc, 0xd4, 0xe3, 0xf1, 0x62, 0xa5, 0x4f, 0xba, 0x2a, 0x00,
+		0x18, 0xfc, 0x62, 0x1e, 0x30, 0x45, 0x0c, 0x8d, 0x13, 0x9e, 0x7a, 0xa6,
+		0x26, 0xef, 0xc1, 0xc4, 0xe4, 0x38, 0x64, 0x0f, 0xb9, 0xe0, 0x17, 0x47,
+		0x6d, 0x8c, 0xca, 0x29, 0x5b, 0x9a, 0x08, 0x1e, 0x68, 0xc7, 0x99, 0x40,
+		0x23, 0xea, 0xa3, 0x81, 0x95, 0xb0, 0x48, 0xa6, 0x38, 0xe8, 0x9b, 0x59,
+		0x3f, 0xcb, 0xd6, 0x93, 0x82, 0x74, 0x71, 0x0f, 0xa7, 0x55, 0x91, 0x39,
+		0x2e, 0xea, 0x63, 0x83, 0x98, 0x09, 0x70, 0xb7, 0xb6, 0xbc, 0x5b, 0x87,
+		0xd1, 0x7b, 0xdc, 0xde, 0x5d, 0xde, 0xe0, 0xf9, 0x19, 0x9a, 0x46, 0xae,
+		0x0f, 0xf0, 0xdf, 0x0f, 0xdf, 0x1f, 0xef, 0x3f, 0xfd, 0xfd, 0xf1, 0x61,
+		0x71, 0x86, 0xe0, 0x02, 0x97, 0x97, 0xe0, 0xa6, 0x8b, 0x58, 0xbc, 0x3b,
+		0x3e, 0x5c, 0x4c, 0x44, 0xcb, 0x2f, 0x53, 0x5d, 0x15, 0xf6, 0xc9, 0x29,
+		0x4f, 0xb5, 0xce, 0x46, 0xe1, 0x42, 0x8e, 0x73, 0xc2, 0xd7, 0xd7, 0xf9,
+		0x36, 0x94, 0xf9, 0xd5, 0x45, 0xdd, 0x90, 0xe2, 0x9c, 0xa4, 0x45, 0xf1,
+		0xbe, 0xc4, 0xcf, 0x76, 0xde, 0x95, 0x80, 0x12, 0x63, 0xfe, 0xa1, 0x58,
+		0xf6, 0x94, 0xb6, 0x9c, 0x30, 0x24, 0x16, 0x0e, 0x5a, 0x5e, 0x23, 0x8d,
+		0xa1, 0xca, 0xfc, 0x06, 0xf5, 0xab, 0x2f, 0x77, 0x9e, 0x14, 0x80, 0x3f,
+		0xda, 0x71, 0xda, 0x3b, 0xe1, 0x99, 0xe6, 0x3e, 0xb9, 0xa0, 0x70, 0x41,
+		0x34, 0x8d, 0xd3, 0x42, 0x05, 0x6d, 0x4c, 0xe8, 0x29, 0x8c, 0xe4, 0x67,
+		0x53, 0x15, 0x90, 0xc1, 0xf8, 0x2f, 0xcd, 0x7f, 0x03, 0x00, 0x50, 0x4b,
+		0x07, 0x08, 0x2f, 0xd9, 0xa5, 0xcd, 0xc2, 0x01, 0x00, 0x00, 0xb8, 0x03,
+		0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00,
 		0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x1b, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63,
 		0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-		0x64, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x2f, 0x55,
-		0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x03, 0x04,
-		0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x26, 0x00,
-		0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f,
-		0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x63, 0x6f, 0x6d,
-		0x70, 0x61, 0x63, 0x74, 0x2f, 0x72, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-		0x72, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce,
-		0x12, 0x54, 0x52, 0xcb, 0x6e, 0xdb, 0x38, 0x14, 0xdd, 0xf3, 0x2b, 0x0e,
-		0x32, 0x8b, 0xcc, 0x08, 0xa2, 0x82, 0x64, 0x30, 0x1b, 0x65, 0x52, 0x20,
-		0x80, 0x03, 0xa4, 0x40, 0xdd, 0x14, 0x49, 0xbb, 0x70, 0x0d, 0x03, 0x66,
-		0xc8, 0x6b, 0x8b, 0x10, 0x4d, 0xaa, 0xbc, 0x57, 0xb5, 0xb5, 0xeb, 0xb6,
-		0xeb, 0xfe, 0x42, 0xbf, 0x2c, 0x5f, 0x52, 0x28, 0x76, 0xdc, 0x74, 0x27,
-		0x88, 0x38, 0xe7, 0x9e, 0x97, 0xd6, 0x5a, 0x39, 0x62, 0x9b, 0x7d, 0x27,
-		0x3e, 0xc5, 0x1a, 0x13, 0xcf, 0xe2, 0x43, 0x80, 0x34, 0x04, 0x26, 0x66,
-		0x9f, 0xe2, 0x29, 0x23, 0x90, 0xc9, 0xd1, 0xc7, 0xf5, 0x25, 0xb6, 0xd9,
-		0x0b, 0x41, 0x12, 0x02, 0xb9, 0x35, 0x65, 0xa4, 0x18, 0x06, 0xf8, 0x15,
-		0x24, 0xf7, 0x61, 0x40, 0xcf, 0xb4, 0xea, 0x43, 0xa5, 0x4c, 0x5e, 0xf7,
-		0x1b, 0x8a, 0xa2, 0x1b, 0x1f, 0xa5, 0xc6, 0x7c, 0x45, 0x46, 0xfa, 0x4c,
-		0xba, 0xa5, 0x61, 0xa1, 0x4c, 0x08, 0x69, 0x4b, 0x4e, 0x4b, 0x4a, 0x81,
-		0x6b, 0xdc, 0x93, 0x71, 0x25, 0x6e, 0x9c, 0x17, 0x35, 0xaa, 0x51, 0xd7,
-		0x7e, 0x83, 0x55, 0xca, 0x38, 0x7f, 0xfa, 0xf6, 0xe3, 0x5f, 0x3c, 0xf6,
-		0x21, 0x90, 0x30, 0x36, 0x66, 0x77, 0x09, 0x32, 0xb6, 0x39, 0xfc, 0xc1,
-		0xd3, 0xf7, 0x9f, 0x17, 0xff, 0x61, 0x9b, 0xb2, 0xe3, 0x4b, 0xd8, 0x14,
-		0x6d, 0x26, 0xa1, 0x12, 0x4c, 0xe3, 0x87, 0x5e, 0x65, 0xa2, 0x12, 0x99,
-		0x7a, 0x36, 0x8f, 0x81, 0x2a, 0xa5, 0xfe, 0xc2, 0x87, 0x9c, 0x5c, 0x6f,
-		0x09, 0x06, 0xdc, 0xa4, 0x2c, 0x38, 0xb9, 0xd9, 0x75, 0x94, 0x3d, 0x45,
-		0x4b, 0x27, 0x78, 0x0c, 0xc9, 0xb6, 0xb5, 0xd2, 0x78, 0xbb, 0x1a, 0xad,
-		0x67, 0x3a, 0x65, 0x14, 0x45, 0x4c, 0xd2, 0xf8, 0xb8, 0x1e, 0xaf, 0x48,
-		0x83, 0x96, 0xa8, 0xf3, 0x71, 0x5d, 0x14, 0x25, 0xba, 0xec, 0xa3, 0x80,
-		0x76, 0xc6, 0x4a, 0x18, 0x6a, 0x2c, 0x7f, 0x53, 0xd5, 0x78, 0x01, 0x3d,
-		0x67, 0x46, 0x6e, 0x09, 0x13, 0x1d, 0x8a, 0xc2, 0xa5, 0xf1, 0x05, 0xe4,
-		0xbc, 0xc0, 0xc4, 0x01, 0x2b, 0x1f, 0xa8, 0x28, 0x2a, 0xa5, 0x71, 0x37,
-		0x1e, 0xdc, 0x7a, 0xa6, 0x3d, 0x6d, 0xad, 0xd4, 0x2b, 0x3a, 0xa5, 0xf1,
-		0xff, 0xc1, 0xf1, 0xf9, 0x1b, 0x40, 0xcc, 0x9a, 0xaf, 0xe6, 0x26, 0x93,
-		0x29, 0x85, 0x6c, 0x53, 0x66, 0xcf, 0xed, 0x02, 0x60, 0x9b, 0x3a, 0xba,
-		0x9a, 0xa7, 0xe7, 0x12, 0x4d, 0x38, 0xeb, 0x8c, 0x34, 0xbc, 0x78, 0x85,
-		0xbd, 0x38, 0x62, 0xab, 0xaa, 0x5a, 0x8c, 0x71, 0x5c, 0x77, 0x1d, 0x45,
-		0x87, 0x2e, 0x05, 0x6f, 0x87, 0xf1, 0xcc, 0xdd, 0x4b, 0x93, 0xa3, 0x7d,
-		0x78, 0x86, 0x91, 0xd1, 0x03, 0x0b, 0x8a, 0x22, 0x45, 0x2a, 0x8a, 0x43,
-		0xf2, 0x25, 0xcc, 0x1e, 0x2a, 0x09, 0x4b, 0x97, 0x2c, 0x9f, 0xd1, 0x51,
-		0xee, 0xd9, 0x7e, 0x15, 0xd5, 0xc6, 0x2d, 0xe1, 0x23, 0xa4, 0xf1, 0x3c,
-		0x76, 0xb9, 0x31, 0x52, 0x2b, 0x60, 0xa9, 0x31, 0x9f, 0xcd, 0x66, 0x33,
-		0x3d, 0x9d, 0xea, 0xc9, 0xe4, 0xe3, 0xed, 0x6d, 0x3d, 0x9d, 0xd6, 0x0f,
-		0x0f, 0x9f, 0x17, 0x47, 0x99, 0x42, 0x3b, 0xf9, 0x43, 0xe9, 0x52, 0x69,
-		0x4c, 0xd2, 0xbe, 0x8a, 0xa2, 0x80, 0xeb, 0xbb, 0xe0, 0xad, 0x11, 0x3a,
-		0xee, 0xc2, 0x84, 0x4c, 0xc6, 0x0d, 0xe8, 0x32, 0x31, 0x45, 0xc1, 0xdf,
-		0x2e, 0xc1, 0xe0, 0x4b, 0xef, 0x6d, 0xfb, 0xcc, 0x06, 0x26, 0x93, 0x6d,
-		0xf3, 0xcf, 0x18, 0xf4, 0x7b, 0xfa, 0x4a, 0x19, 0x3e, 0xda, 0xd0, 0x3b,
-		0x3a, 0x2c, 0x85, 0x4b, 0xb4, 0x34, 0x70, 0x09, 0x49, 0x2d, 0x45, 0x2e,
-		0x91, 0x32, 0x3e, 0xdd, 0xbf, 0x63, 0x6c, 0xbd, 0x34, 0xb0, 0x99, 0x1c,
-		0x45, 0xf1, 0x26, 0x70, 0xa5, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x50,
-		0x4b, 0x07, 0x08, 0xf8, 0xd6, 0xc9, 0xb6, 0x0e, 0x02, 0x00, 0x00, 0x2c,
-		0x03, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08,
+		0x64, 0x73, 0x2f, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x2e, 0x6d, 0x64, 0x55,
+		0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x5c, 0x53, 0x4d, 0x6f,
+		0xe4, 0x44, 0x10, 0xbd, 0xfb, 0x57, 0x3c, 0x85, 0x20, 0xec, 0xd5, 0xd8,
+		0x93, 0x0f, 0x56, 0x2b, 0xcd, 0x0a, 0x89, 0x8d, 0xb2, 0xd2, 0x46, 0x02,
+		0x82, 0x92, 0xa0, 0x3d, 0x20, 0xa4, 0xa9, 0xb8, 0xcb, 0x76, 0x6b, 0xda,
+		0xd5, 0xa6, 0xab, 0x9c, 0xc9, 0x70, 0xca, 0x75, 0xef, 0x1c, 0xe1, 0x06,
+		0x7f, 0x2c, 0xbf, 0x04, 0xd9, 0x33, 0x62, 0x09, 0xc7, 0xee, 0x57, 0x7a,
+		0xf5, 0xde, 0xd3, 0xab, 0xb2, 0x2c, 0x33, 0xc7, 0x5a, 0x27, 0x3f, 0x98,
+		0x8f, 0xb2, 0xc2, 0x8f, 0xc9, 0xf7, 0x0c, 0xeb, 0x18, 0xca, 0xaa, 0x3e,
+		0x0a, 0xea, 0x28, 0xc6, 0x8f, 0x86, 0x8f, 0x57, 0x77, 0x1f, 0xae, 0x7f,
+		0xba, 0xc3, 0x36, 0x79, 0xf3, 0xd2, 0xa2, 0xf1, 0x81, 0xf5, 0x2d, 0x36,
+		0xcc, 0x03, 0x9e, 0x3f, 0xfd, 0xfd, 0xe6, 0xe4, 0x4b, 0x74, 0x4c, 0x2e,
+		0xc5, 0xd8, 0x67, 0x94, 0xda, 0xb1, 0x67, 0xb1, 0xb2, 0xf3, 0x62, 0x2b,
+		0xfc, 0xdc, 0x30, 0xd9, 0x98, 0xb8, 0xdc, 0xf0, 0xee, 0x97, 0x8c, 0x42,
+		0x88, 0x5b, 0x76, 0xa5, 0xc5, 0x18, 0x74, 0x85, 0x1b, 0x26, 0xb7, 0xc0,
+		0x05, 0x69, 0x97, 0xb7, 0xde, 0xa0, 0x46, 0x36, 0xea, 0xea, 0x55, 0xb1,
+		0xc0, 0x05, 0x69, 0x97, 0xb7, 0xde, 0xe0, 0x7c, 0xd3, 0xa0, 0x2c, 0x27,
+		0xe8, 0x25, 0x10, 0xb4, 0x9c, 0x65, 0xbc, 0xfc, 0x6d, 0x13, 0x0f, 0x28,
+		0x65, 0xf5, 0xaa, 0xc8, 0x26, 0x7f, 0xd9, 0x17, 0xb8, 0x92, 0x61, 0xb4,
+		0x15, 0x8e, 0x4f, 0xf1, 0x0d, 0x0e, 0x5a, 0x96, 0x46, 0xba, 0xc1, 0x86,
+		0x77, 0xc8, 0xe3, 0xec, 0x9d, 0x42, 0x31, 0x8d, 0xee, 0x03, 0xc8, 0x25,
+		0xce, 0x46, 0x59, 0x8b, 0x2c, 0x3b, 0xad, 0x70, 0xdb, 0xc5, 0x2d, 0xd6,
+		0x9f, 0xf5, 0xa1, 0xd4, 0x35, 0x48, 0x1c, 0x08, 0xda, 0xc5, 0x64, 0x7b,
+		0xec, 0x3f, 0x3a, 0xd7, 0x55, 0x76, 0x56, 0xcd, 0xde, 0x90, 0x47, 0x09,
+		0xbb, 0x62, 0x95, 0x01, 0x28, 0xf1, 0xed, 0xcd, 0xfb, 0x77, 0x97, 0xdf,
+		0xbf, 0xaf, 0x7a, 0x77, 0x78, 0xbb, 0x58, 0xeb, 0x72, 0x23, 0x71, 0x1b,
+		0xd8, 0xb5, 0x5c, 0xde, 0x93, 0xf2, 0xff, 0xc0, 0x83, 0xe0, 0xb2, 0xa7,
+		0x41, 0x97, 0xc7, 0xa7, 0x55, 0xef, 0x90, 0xfb, 0x06, 0x43, 0x62, 0x65,
+		0xb1, 0x62, 0x3f, 0x7a, 0xd7, 0x31, 0x5e, 0xa3, 0x8f, 0x6a, 0x48, 0x1c,
+		0xf8, 0x81, 0xc4, 0xc0, 0x8f, 0x03, 0x27, 0xcf, 0x52, 0x33, 0xb4, 0xa3,
+		0xe4, 0x14, 0x4d, 0x8a, 0x3d, 0xd6, 0xf3, 0xca, 0xcf, 0xe0, 0x72, 0xde,
+		0x9c, 0xaa, 0xde, 0xad, 0x27, 0x2e, 0x20, 0x4f, 0x24, 0x1b, 0xdc, 0xef,
+		0x60, 0xd4, 0x2e, 0x07, 0xb2, 0x0e, 0xf1, 0x81, 0x53, 0xa0, 0x01, 0x5b,
+		0x6f, 0x1d, 0x8e, 0x8e, 0x4f, 0x8f, 0x66, 0xef, 0x89, 0x6b, 0x96, 0x7a,
+		0x57, 0x54, 0xd9, 0x79, 0x85, 0x8b, 0xd1, 0x87, 0x29, 0x8e, 0x28, 0x5c,
+		0x6a, 0x9d, 0x98, 0x05, 0x77, 0xdf, 0xbd, 0xbd, 0xbc, 0x41, 0xfe, 0xfc,
+		0xe9, 0xaf, 0xb3, 0x93, 0x13, 0x58, 0xdc, 0xb0, 0x68, 0x31, 0x73, 0x1c,
+		0xc2, 0xf8, 0xd8, 0x91, 0x61, 0xcb, 0x5f, 0x25, 0x86, 0xc6, 0xf0, 0xe0,
+		0xa5, 0x85, 0xc4, 0xed, 0x62, 0xae, 0x9f, 0xc5, 0x01, 0xe7, 0xfb, 0x92,
+		0xc1, 0x22, 0xe2, 0xc0, 0x02, 0xe1, 0x47, 0x5b, 0xcc, 0xab, 0xa7, 0x89,
+		0xe4, 0x75, 0x33, 0x63, 0x5b, 0xb2, 0xba, 0xab, 0xf6, 0x94, 0xef, 0x70,
+		0x77, 0xfb, 0xa1, 0x74, 0xc9, 0x3f, 0xb0, 0xe0, 0xf9, 0xe9, 0x8f, 0x9a,
+		0xc4, 0x79, 0x47, 0xc6, 0x30, 0x56, 0xd3, 0xe7, 0xa7, 0x3f, 0xa1, 0x1b,
+		0xb6, 0xba, 0x43, 0x7e, 0x5e, 0xbe, 0x29, 0x16, 0x60, 0xaa, 0x3b, 0x98,
+		0x67, 0x37, 0x31, 0x11, 0x12, 0x53, 0x80, 0x97, 0x07, 0x4a, 0x9e, 0xc4,
+		0xfe, 0x25, 0x1d, 0x52, 0x1c, 0xa2, 0xb2, 0xc3, 0x91, 0x97, 0x3a, 0x8c,
+		0x8e, 0x11, 0xbc, 0xda, 0x11, 0xf2, 0x9e, 0x1e, 0x71, 0x76, 0xb2, 0xd7,
+		0x59, 0xa0, 0x89, 0x09, 0x3a, 0xde, 0x2b, 0xff, 0x3a, 0xb2, 0x4c, 0x5d,
+		0xe1, 0x41, 0xab, 0xa9, 0x55, 0xb7, 0xc1, 0xf7, 0x87, 0x04, 0xb2, 0xec,
+		0xeb, 0x0a, 0x37, 0xa3, 0x60, 0xbd, 0x3c, 0x9c, 0xd6, 0xbe, 0x49, 0x87,
+		0x15, 0xe0, 0x47, 0xaa, 0x2d, 0xec, 0xb0, 0x9d, 0xc2, 0xb1, 0x88, 0x51,
+		0x42, 0x24, 0xb7, 0xd4, 0xb1, 0xef, 0x29, 0xf9, 0xdf, 0xa6, 0x64, 0x5e,
+		0x1c, 0x5d, 0x93, 0x98, 0x27, 0x99, 0xf9, 0x28, 0xe3, 0xa4, 0x90, 0x5a,
+		0x16, 0xd3, 0x05, 0x42, 0x94, 0x16, 0x3d, 0xf7, 0x31, 0xed, 0xa0, 0x5c,
+		0x4f, 0x15, 0x9f, 0x7e, 0x29, 0xb5, 0x8c, 0x9e, 0x55, 0xa9, 0x65, 0x2d,
+		0x2a, 0x5c, 0x5e, 0xe3, 0x87, 0xeb, 0xfd, 0x55, 0x33, 0x48, 0x76, 0x7b,
+		0x2b, 0x55, 0xf6, 0xba, 0xc2, 0x55, 0x03, 0x82, 0xd6, 0x71, 0x60, 0x0c,
+		0x81, 0x04, 0x5e, 0xd1, 0x7b, 0x55, 0x2f, 0xed, 0x32, 0x8e, 0x36, 0x85,
+		0xea, 0x16, 0xd0, 0xb1, 0x6d, 0x59, 0x0d, 0xeb, 0xe5, 0x3c, 0x33, 0x95,
+		0x63, 0xfd, 0xfc, 0xf4, 0xbb, 0x8b, 0x90, 0x68, 0xa8, 0x13, 0x4f, 0xd9,
+		0x7b, 0xc3, 0x2e, 0x8e, 0x49, 0x39, 0x34, 0x55, 0xf6, 0xcf, 0x00, 0x50,
+		0x4b, 0x07, 0x08, 0x5b, 0xc1, 0xc1, 0xc7, 0xc7, 0x02, 0x00, 0x00, 0x74,
+		0x04, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08,
 		0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x1d, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x23, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74,
 		0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-		0x6e, 0x64, 0x73, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x2e,
-		0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x64,
-		0x53, 0x4d, 0x6b, 0x24, 0x37, 0x10, 0xbd, 0xf7, 0xaf, 0x78, 0x90, 0x3d,
-		0xcc, 0x68, 0xdd, 0x1a, 0x9c, 0xcd, 0x07, 0x8c, 0xc9, 0x65, 0xb1, 0x21,
-		0x26, 0x84, 0x0d, 0xb6, 0x6f, 0x21, 0xb0, 0x65, 0xa9, 0x7a, 0x5a, 0x8c,
-		0x5a, 0x12, 0x52, 0x69, 0xc6, 0x7d, 0xf3, 0x29, 0x3f, 0x20, 0xe4, 0x98,
-		0xfc, 0x39, 0xff, 0x92, 0xa0, 0x9e, 0xf1, 0xc1, 0xce, 0x4d, 0xf5, 0x90,
-		0x54, 0xaf, 0xde, 0x7b, 0xd5, 0xf7, 0x7d, 0x67, 0xb9, 0x98, 0xec, 0x92,
-		0xb8, 0x18, 0xb6, 0xb8, 0x79, 0x62, 0x53, 0x85, 0x21, 0x23, 0x63, 0x72,
-		0xc1, 0x4d, 0xe4, 0x61, 0x46, 0x0a, 0x3b, 0x46, 0x60, 0xb6, 0x6c, 0x21,
-		0x11, 0x13, 0xed, 0x19, 0x26, 0x06, 0xc9, 0x64, 0x04, 0xc2, 0x45, 0x0a,
-		0x76, 0x99, 0x39, 0x60, 0x75, 0x2a, 0x5e, 0xfe, 0xfc, 0x0b, 0x26, 0x5a,
-		0x5e, 0x0e, 0x99, 0x73, 0x0d, 0xeb, 0x8e, 0xf2, 0xae, 0x4e, 0x1c, 0xa4,
-		0x1f, 0x5d, 0x90, 0x2d, 0x7e, 0x1f, 0x98, 0xa4, 0x66, 0xee, 0xf7, 0x3c,
-		0xff, 0xd1, 0x91, 0xf7, 0xf1, 0xc8, 0xb6, 0x97, 0x18, 0x7d, 0xd9, 0xe2,
-		0x8e, 0xc9, 0x5e, 0xe0, 0xc6, 0x3a, 0xb9, 0xc0, 0xaf, 0xd5, 0x8b, 0x5b,
-		0x8e, 0x1d, 0xf0, 0x99, 0xca, 0xb8, 0xda, 0xc5, 0xa5, 0x27, 0xf4, 0x46,
-		0x6b, 0xbd, 0xbe, 0x38, 0x81, 0x29, 0xa4, 0x69, 0x81, 0xd5, 0x2b, 0xf2,
-		0x3f, 0x60, 0xa6, 0x1c, 0xde, 0x22, 0x8f, 0xf5, 0x1d, 0x70, 0x70, 0xe7,
-		0xf2, 0xb5, 0x57, 0x3d, 0x20, 0xd7, 0x80, 0x34, 0xbf, 0xb9, 0xf6, 0xae,
-		0x9c, 0x0e, 0xef, 0xbe, 0xd9, 0x65, 0xb2, 0x9e, 0xdf, 0x62, 0x83, 0xaf,
-		0x22, 0x9c, 0xcf, 0x60, 0xd7, 0x94, 0xef, 0xbe, 0xc1, 0x6d, 0x48, 0x55,
-		0xb6, 0xf8, 0x70, 0x89, 0x9f, 0x70, 0x56, 0x04, 0x7b, 0x9e, 0xbb, 0xee,
-		0x52, 0xe3, 0x8e, 0xfb, 0xcc, 0x64, 0xf1, 0xd5, 0x46, 0x53, 0x36, 0x25,
-		0xb1, 0x29, 0x9b, 0x0f, 0x97, 0x7d, 0xf2, 0x14, 0xf4, 0x64, 0xbf, 0x5e,
-		0x21, 0x73, 0x11, 0x12, 0x06, 0x3f, 0x35, 0x1f, 0x06, 0xe7, 0xb9, 0x34,
-		0x7b, 0x24, 0x56, 0x33, 0xea, 0xee, 0x5b, 0x0d, 0xa5, 0x1e, 0x16, 0x3f,
-		0x06, 0x97, 0x8b, 0x60, 0xf5, 0x6a, 0x59, 0xef, 0xf9, 0xc0, 0x7e, 0xad,
-		0xd4, 0x16, 0x36, 0xd3, 0x20, 0x20, 0x94, 0x31, 0x66, 0x39, 0xdf, 0xc7,
-		0x3d, 0x7b, 0x36, 0x2d, 0x0f, 0x4a, 0xc1, 0xbb, 0x22, 0xa8, 0xc5, 0x85,
-		0xdd, 0x92, 0x89, 0x87, 0xfb, 0x9f, 0xf1, 0xf2, 0xfc, 0x37, 0x52, 0x8e,
-		0x29, 0x16, 0xc6, 0xa7, 0xfe, 0x47, 0x18, 0x2a, 0xad, 0xf1, 0x48, 0x02,
-		0xcb, 0x03, 0x07, 0x8b, 0xcc, 0xe4, 0xe1, 0xc2, 0x81, 0xb2, 0xa3, 0x20,
-		0xe5, 0x0a, 0x8f, 0xd9, 0xf1, 0xe0, 0x67, 0x84, 0x28, 0x8c, 0xe3, 0xe8,
-		0xcc, 0x88, 0x97, 0xe7, 0x7f, 0xe2, 0xe3, 0xc1, 0xc5, 0x5a, 0x36, 0x92,
-		0xdd, 0xc1, 0x91, 0x7f, 0x79, 0xfe, 0xf7, 0x1c, 0xa6, 0x39, 0x56, 0x50,
-		0x66, 0x28, 0x15, 0xa2, 0x28, 0x05, 0xb2, 0xb6, 0x11, 0xa0, 0x60, 0x71,
-		0x1c, 0x67, 0x8d, 0x5f, 0x98, 0x13, 0x06, 0x2a, 0x72, 0x85, 0x29, 0x9a,
-		0x3d, 0x02, 0xcb, 0x31, 0xe6, 0xfd, 0xe6, 0xfa, 0xb3, 0xee, 0x3e, 0x69,
-		0xdc, 0x4e, 0xc9, 0x73, 0xcb, 0x1a, 0x94, 0x2a, 0x13, 0x79, 0xdf, 0x86,
-		0x3a, 0x38, 0x7a, 0xf4, 0x0c, 0xeb, 0x86, 0x41, 0xa9, 0x13, 0xdb, 0x42,
-		0xe2, 0xca, 0xe0, 0xb8, 0x80, 0x8c, 0xe1, 0x24, 0x14, 0x0c, 0xc3, 0x8c,
-		0x6c, 0xf6, 0x45, 0xe3, 0xa1, 0xa9, 0x88, 0x18, 0xfc, 0x8c, 0xa6, 0x78,
-		0x60, 0x7b, 0x52, 0x58, 0x77, 0xdf, 0x69, 0xdc, 0xb5, 0xe0, 0x8c, 0x8c,
-		0x52, 0x9d, 0x30, 0x6a, 0x10, 0xe7, 0xa1, 0xd4, 0xb2, 0x03, 0x4a, 0x69,
-		0xdc, 0x0e, 0xa8, 0x21, 0xb3, 0x27, 0x69, 0xaf, 0xc8, 0xf9, 0x02, 0x4a,
-		0x89, 0x29, 0x5f, 0x34, 0x46, 0x12, 0x53, 0x9b, 0x29, 0xd8, 0x36, 0x17,
-		0x1e, 0xbe, 0x5c, 0x7f, 0x59, 0x8d, 0x75, 0xa2, 0xb0, 0xc6, 0xd1, 0xc9,
-		0xb8, 0x74, 0xc1, 0xc7, 0x53, 0xc4, 0x03, 0x4d, 0xac, 0xbb, 0xef, 0x35,
-		0x6e, 0x26, 0xd7, 0x6c, 0x52, 0xca, 0xc4, 0x60, 0x5c, 0x61, 0x24, 0x12,
-		0x33, 0xa2, 0xd4, 0x69, 0xa2, 0x3c, 0x2b, 0x85, 0xd5, 0x69, 0x4b, 0xcf,
-		0x24, 0xf1, 0x11, 0x99, 0x9a, 0x83, 0xe4, 0x79, 0xad, 0xbb, 0x1f, 0x34,
-		0xee, 0x25, 0x26, 0x8d, 0xeb, 0xd8, 0x2c, 0x80, 0x89, 0x53, 0xfb, 0x2f,
-		0x66, 0xc4, 0xc4, 0x01, 0xbf, 0xdd, 0x15, 0xdd, 0xfd, 0x17, 0x00, 0x00,
-		0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0x17, 0x4a, 0xcf, 0xd0, 0x73, 0x02,
-		0x00, 0x00, 0x0b, 0x04, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00,
-		0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1a, 0x00, 0x09, 0x00,
-		0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f,
-		0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x2e,
-		0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x64,
-		0x53, 0xdd, 0x6a, 0xdc, 0x46, 0x14, 0xbe, 0xd7, 0x53, 0x7c, 0xa4, 0xbe,
-		0xd8, 0x55, 0x2d, 0xad, 0x37, 0x29, 0x14, 0xd6, 0x14, 0xe2, 0x62, 0x27,
-		0x18, 0x9a, 0x26, 0xac, 0x0d, 0xa5, 0xb4, 0x85, 0x1c, 0xcf, 0x1c, 0x49,
-		0x83, 0xa4, 0x99, 0x61, 0xce, 0x91, 0xdd, 0x7d, 0x81, 0x42, 0x6f, 0xfb,
-		0x0c, 0x7d, 0xb2, 0x3c, 0x49, 0x99, 0xb1, 0xb3, 0x36, 0xf4, 0x42, 0x37,
-		0x07, 0x7d, 0xdf, 0xf9, 0x7e, 0xce, 0x34, 0x4d, 0x53, 0x59, 0x16, 0x93,
-		0x5c, 0x54, 0x17, 0xfc, 0x0e, 0x9f, 0x26, 0xf2, 0x20, 0x98, 0x81, 0x7c,
-		0xcf, 0x60, 0x6f, 0x1b, 0x0d, 0x0d, 0x7b, 0x8b, 0xd5, 0x4c, 0x11, 0x5f,
-		0xfe, 0xfa, 0x07, 0x71, 0x22, 0xbf, 0x86, 0xf3, 0x08, 0x9e, 0x11, 0x49,
-		0xe4, 0x1c, 0xa2, 0x21, 0xa2, 0x0b, 0x09, 0xc3, 0x32, 0x93, 0x47, 0xe2,
-		0x7b, 0xc7, 0x0f, 0x15, 0xa5, 0x7e, 0x99, 0xd9, 0x6b, 0x33, 0x38, 0xaf,
-		0x3b, 0xfc, 0xd6, 0x31, 0xe9, 0x92, 0xb8, 0x19, 0xf9, 0xf0, 0x47, 0x45,
-		0xd3, 0x14, 0x1e, 0x38, 0xb3, 0x87, 0x49, 0x76, 0xd8, 0x33, 0xd9, 0x53,
-		0x5c, 0x59, 0xa7, 0xa7, 0xf8, 0x91, 0x64, 0x58, 0xf5, 0x4e, 0x31, 0x49,
-		0xd3, 0xb9, 0x89, 0x65, 0x57, 0xaf, 0x5f, 0x4c, 0xfb, 0xc4, 0x11, 0x8d,
-		0x7f, 0x1e, 0x76, 0xce, 0x5b, 0xec, 0xea, 0x75, 0x95, 0xcd, 0x54, 0xdf,
-		0xe0, 0xda, 0xc7, 0x45, 0x77, 0x38, 0xd9, 0xe2, 0x07, 0x3c, 0xed, 0xc4,
-		0xc8, 0x87, 0xaa, 0xda, 0xb6, 0xd8, 0x5f, 0x5d, 0x5c, 0xe2, 0xdd, 0xf5,
-		0xfe, 0xe6, 0xb6, 0xc5, 0xcd, 0xe8, 0x66, 0xbc, 0xcd, 0x93, 0x0f, 0x57,
-		0xed, 0x6c, 0x41, 0x3e, 0x7f, 0x07, 0xbc, 0xb5, 0xc1, 0xc8, 0xe6, 0xf7,
-		0x1a, 0x59, 0xbc, 0x0b, 0xde, 0xf9, 0x1e, 0xaf, 0x4e, 0xb6, 0xaf, 0xda,
-		0xea, 0x75, 0x8b, 0x4f, 0x29, 0xd8, 0xc5, 0x30, 0xea, 0x3a, 0xdb, 0xcf,
-		0xf2, 0xea, 0x7a, 0x87, 0xcf, 0x05, 0x22, 0x91, 0x8d, 0x6c, 0x4e, 0xb6,
-		0x4d, 0x8e, 0xa8, 0x9d, 0xed, 0x67, 0xac, 0xbe, 0xfc, 0xfd, 0x2f, 0xbe,
-		0x3b, 0x3b, 0x83, 0x86, 0x91, 0xbd, 0xac, 0x61, 0x82, 0x57, 0x72, 0x99,
-		0x72, 0x57, 0x01, 0x68, 0x50, 0xd7, 0x1f, 0x28, 0xe2, 0xf6, 0xa7, 0xf3,
-		0xcb, 0x7d, 0xf9, 0x7b, 0xfb, 0xfa, 0x6c, 0x9d, 0x19, 0xcd, 0x92, 0x12,
-		0x7b, 0xc5, 0x1d, 0x0f, 0x74, 0xef, 0x42, 0xda, 0x64, 0x64, 0x22, 0xa3,
-		0x72, 0x8a, 0x98, 0xdc, 0x4c, 0xe9, 0x50, 0xb6, 0xcb, 0x23, 0xea, 0x6c,
-		0x8d, 0x07, 0xa7, 0x03, 0x26, 0xe7, 0x19, 0x12, 0xc9, 0x4b, 0xfb, 0x95,
-		0xff, 0x7d, 0xa0, 0x29, 0x33, 0xc6, 0x14, 0xee, 0x26, 0x9e, 0x4b, 0x87,
-		0x96, 0xc5, 0x25, 0xb6, 0x47, 0x76, 0xac, 0xb6, 0x88, 0x94, 0xa8, 0x4f,
-		0x14, 0x87, 0xf5, 0x11, 0x7a, 0x61, 0x0c, 0x47, 0x25, 0x6f, 0x18, 0x66,
-		0x60, 0x33, 0x0a, 0x56, 0x6f, 0x9a, 0xef, 0x8b, 0xbe, 0x7b, 0x4e, 0xae,
-		0x73, 0x74, 0x37, 0x31, 0x4c, 0x98, 0x67, 0xf2, 0x56, 0x4e, 0xe1, 0x83,
-		0xe6, 0x35, 0xc2, 0x47, 0x86, 0x77, 0x45, 0xa2, 0x06, 0x68, 0x58, 0xcc,
-		0x90, 0x81, 0xfc, 0x27, 0x19, 0xc5, 0xe4, 0x44, 0xcf, 0xe1, 0xbc, 0x66,
-		0x8f, 0x91, 0x53, 0xf1, 0x82, 0xd5, 0xc8, 0x1c, 0xe1, 0x14, 0x32, 0x84,
-		0xa4, 0xeb, 0x16, 0x1f, 0xfd, 0x74, 0x80, 0x0e, 0x5c, 0x8e, 0xee, 0xf1,
-		0x1f, 0x27, 0x78, 0x48, 0x4e, 0x95, 0x3d, 0xec, 0x92, 0x72, 0x37, 0x9b,
-		0x12, 0xf7, 0xd7, 0x8d, 0x97, 0xae, 0xeb, 0x10, 0x16, 0xcd, 0x49, 0x14,
-		0xdb, 0xc2, 0x8b, 0x0d, 0x8d, 0x09, 0x96, 0x11, 0xba, 0xa7, 0xc3, 0x16,
-		0xac, 0xba, 0xc5, 0x9b, 0xdc, 0xae, 0x6c, 0x44, 0xd3, 0x62, 0x54, 0x36,
-		0x29, 0x2c, 0xca, 0xf2, 0xec, 0x7e, 0xef, 0x64, 0x94, 0x42, 0xc1, 0xa9,
-		0xdb, 0xcc, 0xae, 0x4f, 0xf4, 0x08, 0xb8, 0x4b, 0x4c, 0x23, 0xf5, 0x8c,
-		0x6f, 0x61, 0x59, 0xb9, 0xd0, 0x60, 0x66, 0x1d, 0x82, 0x3d, 0x82, 0x6f,
-		0x59, 0x14, 0xa2, 0x89, 0x94, 0xfb, 0x43, 0x29, 0xf4, 0xa9, 0x3f, 0x28,
-		0x8b, 0x0a, 0x42, 0x71, 0x16, 0x72, 0x5c, 0xf7, 0xfc, 0xa2, 0x87, 0x1c,
-		0xcc, 0x63, 0xb1, 0x9b, 0xa3, 0xc2, 0x67, 0x49, 0x1f, 0x17, 0xcd, 0x26,
-		0xc4, 0x84, 0x58, 0xcc, 0xb1, 0xef, 0x42, 0x32, 0x8c, 0x5f, 0x2f, 0xde,
-		0xff, 0x7c, 0xdd, 0x56, 0x6f, 0x5a, 0xdc, 0x68, 0x88, 0x2d, 0x7e, 0x21,
-		0xa7, 0xff, 0x7b, 0x90, 0xf9, 0xcd, 0x9a, 0x81, 0xf2, 0x4d, 0x75, 0x21,
-		0x71, 0xb9, 0x75, 0xb6, 0x4e, 0xa5, 0xad, 0xfe, 0x0b, 0x00, 0x00, 0xff,
-		0xff, 0x50, 0x4b, 0x07, 0x08, 0xd8, 0x16, 0xc6, 0x04, 0x7e, 0x02, 0x00,
-		0x00, 0x07, 0x04, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08,
-		0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x00, 0x09, 0x00, 0x64,
-		0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d,
-		0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
-		0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x2e, 0x6d, 0x64, 0x55,
-		0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x9c, 0x92, 0x4f, 0x6b,
-		0xdb, 0x40, 0x10, 0xc5, 0xef, 0xfa, 0x14, 0xaf, 0xa6, 0x18, 0x2b, 0x58,
-		0x36, 0x49, 0x6f, 0x82, 0x86, 0xfe, 0xa3, 0x7f, 0x28, 0xa5, 0x01, 0xf7,
-		0x6e, 0x0d, 0xda, 0x91, 0xb5, 0x78, 0xb5, 0x2b, 0x76, 0x46, 0x76, 0x0e,
-		0xf9, 0xf0, 0x65, 0x65, 0xd9, 0x21, 0x39, 0x99, 0xdc, 0x96, 0xd9, 0xb7,
-		0xef, 0x37, 0xf3, 0x66, 0x8b, 0xa2, 0xc8, 0x0c, 0x4b, 0x1d, 0x6d, 0xaf,
-		0x36, 0xf8, 0x12, 0x1b, 0xa5, 0x1d, 0x43, 0xc3, 0x50, 0xb7, 0x6c, 0xd0,
-		0x58, 0xc7, 0x02, 0xf2, 0x06, 0x26, 0x52, 0xa3, 0x20, 0xd4, 0xa1, 0xeb,
-		0xac, 0xa2, 0x63, 0x91, 0x24, 0x5c, 0xf8, 0x80, 0x7e, 0x90, 0x36, 0xcf,
-		0xc8, 0xb9, 0x70, 0x64, 0x53, 0x68, 0x08, 0x4e, 0x4a, 0x7c, 0x21, 0x69,
-		0x17, 0x3b, 0xab, 0x20, 0x63, 0xca, 0x9b, 0x7c, 0xf9, 0x5c, 0x10, 0x25,
-		0x1d, 0xe4, 0x65, 0xcd, 0xd8, 0xa6, 0x41, 0x51, 0x48, 0x82, 0xbf, 0x92,
-		0x4f, 0xc0, 0xe2, 0xfb, 0xa5, 0x5a, 0x93, 0x5e, 0xce, 0xdd, 0xde, 0xd8,
-		0x88, 0xa2, 0xbf, 0xc9, 0xb3, 0x34, 0xca, 0x6d, 0x3e, 0x0d, 0xc0, 0x8f,
-		0xbd, 0xb3, 0xb5, 0x55, 0xf4, 0xa4, 0xad, 0xa0, 0x89, 0xa1, 0x83, 0xb6,
-		0x8c, 0xde, 0x91, 0x47, 0x88, 0x70, 0x24, 0x13, 0x75, 0x41, 0x87, 0x60,
-		0x0d, 0x5c, 0xa8, 0xf7, 0xe3, 0xb4, 0xeb, 0x1d, 0x7b, 0x8e, 0xa4, 0x6c,
-		0x40, 0x22, 0xac, 0x92, 0xaf, 0xb2, 0xec, 0x2e, 0xc7, 0xb7, 0x31, 0x80,
-		0xe4, 0xf1, 0x2a, 0x82, 0xd1, 0xfb, 0xd3, 0xaa, 0x76, 0x34, 0x18, 0x5e,
-		0x2b, 0x77, 0xbd, 0x23, 0x65, 0x59, 0x9f, 0x64, 0xdb, 0x49, 0xb6, 0x3d,
-		0x5f, 0xac, 0xf4, 0x51, 0x57, 0x19, 0x80, 0x02, 0xbf, 0x99, 0x7b, 0x8c,
-		0x23, 0xfa, 0xda, 0x0a, 0x8f, 0x39, 0x53, 0x9d, 0xf6, 0x50, 0x84, 0x68,
-		0xd9, 0xa7, 0x1e, 0x52, 0xc0, 0xcf, 0x1d, 0x35, 0x21, 0x28, 0xc7, 0xd4,
-		0xd2, 0x68, 0xb0, 0xa1, 0x03, 0x27, 0x03, 0x0d, 0xa8, 0xce, 0x0d, 0xac,
-		0x84, 0x45, 0x92, 0xc5, 0x89, 0x5f, 0x4c, 0xfc, 0x84, 0xad, 0x46, 0x82,
-		0xb4, 0xe1, 0x08, 0xab, 0x65, 0x96, 0x3c, 0xde, 0x55, 0xe7, 0x04, 0x31,
-		0x19, 0xe0, 0x7e, 0x6d, 0xf8, 0xb0, 0xf6, 0x83, 0x73, 0xb8, 0xbb, 0x9f,
-		0xdf, 0xe2, 0xe9, 0x09, 0x1a, 0x07, 0xae, 0x4e, 0xf2, 0x3f, 0x9b, 0x1f,
-		0xdb, 0x87, 0xcf, 0xff, 0x7e, 0x7e, 0x9c, 0x5d, 0x01, 0x9c, 0x61, 0x3e,
-		0x07, 0xd7, 0x6d, 0xc0, 0xec, 0xfd, 0xf9, 0xe1, 0x6c, 0x34, 0x5a, 0x7c,
-		0x1d, 0x1f, 0x97, 0x38, 0x46, 0xab, 0x3c, 0xc6, 0x7a, 0xce, 0xd3, 0xfa,
-		0x34, 0xce, 0x45, 0x5f, 0x2d, 0xd3, 0xad, 0xcf, 0x4f, 0xf8, 0x9a, 0x14,
-		0x57, 0x80, 0xab, 0x2c, 0xfb, 0x90, 0xe3, 0x57, 0x33, 0xed, 0x4a, 0x40,
-		0x91, 0x31, 0xfd, 0x50, 0x2c, 0x3a, 0x8a, 0x7b, 0x8e, 0xe8, 0x23, 0x0b,
-		0x7b, 0xcd, 0x97, 0x88, 0x83, 0x2f, 0x4f, 0x89, 0x56, 0x2f, 0xbe, 0xdc,
-		0x75, 0x28, 0x00, 0x7f, 0xb5, 0xe5, 0x78, 0xb4, 0xc2, 0x93, 0xcd, 0x43,
-		0xb4, 0x5e, 0x61, 0xbd, 0x68, 0x1c, 0xc6, 0x85, 0x0a, 0x9a, 0x10, 0xd1,
-		0x91, 0x1f, 0xc8, 0x4d, 0xfe, 0x25, 0x90, 0xc4, 0x78, 0x13, 0xf3, 0x7f,
-		0x00, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0x2f, 0xd9, 0xa5,
-		0xcd, 0xc5, 0x01, 0x00, 0x00, 0xb8, 0x03, 0x00, 0x00, 0x50, 0x4b, 0x03,
+		0x6e, 0x64, 0x73, 0x2f, 0x72, 0x65, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+		0x2d, 0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00,
+		0x01, 0x00, 0xa6, 0xce, 0x12, 0x74, 0x90, 0x31, 0x8e, 0xd4, 0x40, 0x10,
+		0x45, 0x73, 0x9f, 0xe2, 0x07, 0x24, 0x23, 0x4d, 0x7b, 0xb4, 0x90, 0x6d,
+		0x0c, 0x12, 0x04, 0x48, 0x1b, 0x70, 0x80, 0xed, 0xed, 0xfe, 0x1e, 0xb7,
+		0xdc, 0xee, 0x6a, 0xaa, 0xca, 0xbb, 0x4c, 0xc6, 0x15, 0xb8, 0x22, 0x27,
+		0x41, 0xf6, 0x6a, 0x42, 0x0e, 0x50, 0xbf, 0xde, 0x7b, 0x21, 0x84, 0x21,
+		0xd3, 0x92, 0x96, 0xee, 0x45, 0xda, 0x23, 0x9e, 0x6a, 0x6c, 0x88, 0xb0,
+		0x38, 0x11, 0xeb, 0x56, 0xbd, 0x04, 0x73, 0x76, 0x28, 0xa7, 0x98, 0x5c,
+		0x14, 0x6f, 0xc5, 0x67, 0xa4, 0x99, 0x69, 0xe9, 0x52, 0x9a, 0x1b, 0x62,
+		0xcb, 0x50, 0xa9, 0xf5, 0x25, 0xa6, 0x65, 0x88, 0xb5, 0xca, 0x1b, 0x73,
+		0x70, 0x91, 0x6a, 0x8f, 0xf8, 0x92, 0x8b, 0x0f, 0x21, 0x84, 0xe1, 0xc7,
+		0x5c, 0xda, 0x82, 0x4c, 0xf6, 0x7a, 0x3b, 0x2e, 0xd8, 0xb6, 0x95, 0x1a,
+		0x9d, 0x70, 0x8d, 0x99, 0x7f, 0x7f, 0xff, 0x91, 0x69, 0xb2, 0x11, 0xdf,
+		0x26, 0xf8, 0x4c, 0xf4, 0x9d, 0xc2, 0xc8, 0xd5, 0xa0, 0xc5, 0x96, 0xdb,
+		0x19, 0x0b, 0xd9, 0xe1, 0xfb, 0x4c, 0x69, 0xd7, 0x63, 0xa2, 0xab, 0x74,
+		0x31, 0x1e, 0xa8, 0x8a, 0x58, 0x9d, 0xda, 0xa2, 0x97, 0x57, 0x1a, 0x5e,
+		0x38, 0x89, 0x12, 0xcc, 0xc5, 0x6d, 0x1c, 0x1e, 0x4e, 0x78, 0x52, 0xc9,
+		0x5b, 0x22, 0x9e, 0xb3, 0x24, 0xbb, 0xdc, 0x65, 0xec, 0xf2, 0xe1, 0x21,
+		0xec, 0xaf, 0xc6, 0x35, 0x3f, 0xbf, 0x9b, 0xf5, 0x39, 0x1a, 0xed, 0x8c,
+		0xcc, 0xce, 0x96, 0xd9, 0xd2, 0x0d, 0x57, 0x8d, 0x7d, 0x3e, 0x1f, 0x2f,
+		0x9d, 0xe6, 0x30, 0xdf, 0xb9, 0xaf, 0xb7, 0x71, 0xf8, 0x78, 0xc2, 0xf7,
+		0xa8, 0x0b, 0x52, 0x65, 0xd4, 0xff, 0x46, 0xc1, 0x1e, 0xd0, 0xd0, 0xa9,
+		0xef, 0xeb, 0xe3, 0xf0, 0xe9, 0x84, 0xcf, 0x82, 0x26, 0x8e, 0x34, 0xc7,
+		0x76, 0x25, 0x92, 0x64, 0x8e, 0xf8, 0xba, 0xad, 0x7b, 0xfb, 0xde, 0x55,
+		0x5e, 0x63, 0x85, 0xf2, 0xe7, 0x56, 0x94, 0xf9, 0x6e, 0x73, 0xe1, 0x2f,
+		0xa6, 0xcd, 0x39, 0x0e, 0xff, 0x06, 0x00, 0x50, 0x4b, 0x07, 0x08, 0x4f,
+		0x66, 0xd1, 0x63, 0x22, 0x01, 0x00, 0x00, 0xb5, 0x01, 0x00, 0x00, 0x50,
+		0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x1c, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
+		0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f,
+		0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05,
+		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x54, 0x53, 0x41, 0x6f, 0x23, 0x45,
+		0x17, 0xbc, 0xcf, 0xaf, 0xa8, 0xc3, 0x77, 0x88, 0x5b, 0x1e, 0x3b, 0xc9,
+		0x87, 0x38, 0x4c, 0xc4, 0x61, 0xc9, 0x5a, 0x4b, 0x84, 0xb4, 0x09, 0x76,
+		0xe0, 0x82, 0x90, 0xe6, 0xa5, 0xfb, 0x8d, 0xa7, 0x37, 0xed, 0xee, 0x51,
+		0xbf, 0xd7, 0x31, 0x91, 0xf6, 0x0a, 0x12, 0xd7, 0x05, 0x71, 0x42, 0xe2,
+		0xc2, 0x2f, 0xcb, 0x2f, 0xe0, 0x27, 0xa0, 0xb6, 0x37, 0x6c, 0x38, 0xb5,
+		0xe6, 0x75, 0xbd, 0x9a, 0x9a, 0xaa, 0x9a, 0xb6, 0x6d, 0x1b, 0xc7, 0x62,
+		0xb3, 0x9f, 0xd4, 0xa7, 0xd8, 0x61, 0x33, 0x05, 0xaf, 0x6d, 0x4e, 0x81,
+		0x91, 0xf9, 0xc1, 0xf3, 0x1e, 0x27, 0xc2, 0xb6, 0x64, 0xaf, 0x8f, 0x73,
+		0x64, 0x26, 0x47, 0x77, 0x3e, 0x1c, 0x1e, 0x94, 0x45, 0x65, 0x86, 0xa7,
+		0x9f, 0x3f, 0x40, 0x7c, 0xdc, 0x06, 0x86, 0x63, 0xeb, 0xc5, 0xa7, 0xd8,
+		0x50, 0xde, 0x96, 0x1d, 0x47, 0x6d, 0x47, 0x1f, 0xb5, 0xc3, 0xf7, 0x03,
+		0x93, 0x96, 0xcc, 0xed, 0x3d, 0x3f, 0xfe, 0xd0, 0x50, 0x08, 0x69, 0xcf,
+		0xae, 0xd5, 0x94, 0x82, 0x74, 0x58, 0x33, 0xb9, 0x39, 0xbe, 0x24, 0x19,
+		0x4f, 0xb6, 0x5e, 0xe1, 0xfc, 0x30, 0x74, 0x66, 0xf6, 0x62, 0x22, 0x4a,
+		0x5a, 0xa4, 0x33, 0xb3, 0xa6, 0x8a, 0x3d, 0x9b, 0xe1, 0x32, 0xed, 0x26,
+		0xca, 0x0c, 0x5b, 0x72, 0xe6, 0x78, 0x5c, 0x81, 0x26, 0xf4, 0x2e, 0x59,
+		0x59, 0xca, 0xc4, 0x56, 0x96, 0xff, 0x3b, 0x6b, 0xa7, 0x40, 0x71, 0xb1,
+		0x73, 0x3d, 0xc8, 0x5a, 0x9e, 0x94, 0xa2, 0x65, 0xd8, 0x91, 0xed, 0xbd,
+		0x80, 0xa2, 0x83, 0x31, 0x6f, 0x52, 0x70, 0x1c, 0xb1, 0x2e, 0x81, 0xc5,
+		0x98, 0x45, 0x73, 0x3e, 0xc3, 0xba, 0x44, 0xe8, 0x98, 0x99, 0x21, 0x63,
+		0xca, 0x8a, 0x89, 0x44, 0xf8, 0x88, 0xb7, 0x29, 0x4a, 0x0a, 0xde, 0x91,
+		0x72, 0xd7, 0x00, 0x68, 0xf1, 0xf7, 0x9f, 0xbf, 0x7d, 0x80, 0x31, 0x9b,
+		0x8f, 0xf6, 0x18, 0xd3, 0x41, 0xd8, 0x66, 0x56, 0x59, 0xda, 0x14, 0x07,
+		0xbf, 0x9d, 0xc3, 0xc7, 0x77, 0x6c, 0xab, 0xb1, 0x90, 0x92, 0x07, 0xb2,
+		0x2c, 0x73, 0x64, 0x2f, 0xf7, 0x8f, 0xb8, 0x5a, 0x5e, 0x2f, 0x9e, 0x69,
+		0x7e, 0xfd, 0x1d, 0xc6, 0xac, 0x3f, 0x79, 0xbb, 0xbc, 0xe1, 0x3c, 0x54,
+		0x3a, 0x1b, 0xa8, 0x32, 0xcf, 0x11, 0x99, 0x5d, 0x60, 0x11, 0xd0, 0x9d,
+		0x68, 0xa6, 0x03, 0xe5, 0x1c, 0x63, 0x52, 0x4c, 0xa4, 0xa3, 0x7c, 0x64,
+		0x7a, 0xfa, 0xe3, 0x27, 0x18, 0x73, 0x5b, 0x73, 0x39, 0x6c, 0xa7, 0x78,
+		0xc0, 0xc2, 0xa6, 0x07, 0xce, 0xb4, 0x65, 0x4c, 0x9c, 0x51, 0x6d, 0xb9,
+		0xc0, 0xce, 0x4b, 0x0d, 0x0d, 0xec, 0xb6, 0x2c, 0x17, 0x30, 0x26, 0x73,
+		0x55, 0x0a, 0xcd, 0xfe, 0xc1, 0x53, 0xa8, 0x28, 0x1d, 0x52, 0xde, 0x1d,
+		0x53, 0x36, 0x06, 0x27, 0xc7, 0x18, 0xbe, 0x38, 0x3f, 0x3d, 0x5d, 0xbe,
+		0x93, 0x14, 0x5b, 0x19, 0x69, 0xe2, 0x19, 0x4a, 0x3c, 0xe8, 0x7a, 0x7e,
+		0x57, 0x5b, 0xed, 0xf7, 0x83, 0xb7, 0x17, 0x98, 0x32, 0x0f, 0x9c, 0xe1,
+		0xe3, 0x03, 0x65, 0x4f, 0x51, 0x65, 0x59, 0xcd, 0xcc, 0x55, 0xba, 0x40,
+		0x47, 0x52, 0x58, 0x52, 0x3b, 0xd6, 0x52, 0x05, 0x2f, 0xea, 0x2d, 0x76,
+		0x45, 0xe9, 0x70, 0xbd, 0x68, 0xfe, 0x3f, 0xc3, 0x75, 0xd1, 0xa9, 0x28,
+		0x08, 0x3b, 0xca, 0xf7, 0x2e, 0xed, 0x23, 0x8c, 0x51, 0xba, 0x0b, 0x6c,
+		0x0c, 0xf6, 0x5e, 0x47, 0xd8, 0x14, 0xca, 0x2e, 0x4a, 0x87, 0x7e, 0xf0,
+		0x81, 0xf1, 0x1e, 0xc1, 0xc7, 0x7a, 0x78, 0x91, 0x52, 0xcf, 0x5c, 0x02,
+		0x2f, 0x5f, 0x84, 0xff, 0x1e, 0x52, 0xb6, 0x5b, 0x16, 0x65, 0x87, 0xc1,
+		0xff, 0xd8, 0x2f, 0xf0, 0x35, 0xf3, 0x04, 0x1d, 0x19, 0xfd, 0x61, 0xa7,
+		0x87, 0xe5, 0x10, 0xf0, 0xf4, 0xcb, 0x5f, 0x67, 0xe7, 0xa7, 0xb0, 0x23,
+		0x65, 0x99, 0x1f, 0xaf, 0xff, 0xbb, 0xf8, 0x09, 0xf6, 0xf9, 0xbf, 0xb0,
+		0x5a, 0x11, 0x1f, 0x6d, 0x28, 0x8e, 0x5f, 0x14, 0xae, 0x3d, 0x14, 0x0e,
+		0x57, 0xaf, 0x05, 0xfb, 0x91, 0x23, 0xa6, 0x24, 0xe2, 0xef, 0x02, 0x2f,
+		0x9a, 0xcf, 0x66, 0xb8, 0xad, 0x13, 0x72, 0x0e, 0xf4, 0xfc, 0x03, 0xd5,
+		0x0f, 0xe8, 0xd0, 0xbf, 0x5e, 0x5d, 0x5e, 0x6d, 0xae, 0xae, 0xdf, 0x76,
+		0x78, 0x75, 0x73, 0xb3, 0xbe, 0xfe, 0x6e, 0xd5, 0x23, 0xe5, 0x97, 0xe3,
+		0xf5, 0xea, 0x9b, 0x6f, 0x57, 0x9b, 0xdb, 0xf6, 0xf2, 0xab, 0x57, 0x6f,
+		0xdf, 0xac, 0x36, 0xfd, 0xa2, 0xf9, 0x67, 0x00, 0x50, 0x4b, 0x07, 0x08,
+		0x56, 0xc3, 0x8f, 0xe5, 0x72, 0x02, 0x00, 0x00, 0xc8, 0x03, 0x00, 0x00,
+		0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x10, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61,
+		0x75, 0x64, 0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x55, 0x54,
+		0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x03, 0x04, 0x14,
+		0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x00, 0x09,
+		0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x68,
+		0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x54, 0x05,
+		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0xc4, 0x91, 0xb1, 0x6a, 0xc3, 0x30,
+		0x10, 0x40, 0x77, 0x7f, 0xc5, 0xa1, 0xb1, 0x98, 0x64, 0xe8, 0xe6, 0xb5,
+		0x74, 0x6c, 0x09, 0xa4, 0xa1, 0x43, 0x30, 0x42, 0x91, 0x2f, 0xb5, 0xa8,
+		0xa5, 0x3b, 0x4e, 0xa7, 0xc1, 0xa4, 0xfe, 0xf7, 0x62, 0x77, 0x68, 0x07,
+		0x93, 0xc9, 0xd0, 0xed, 0x24, 0xd0, 0x7b, 0x0f, 0xdd, 0xad, 0x02, 0x30,
+		0xa7, 0x8c, 0x72, 0x10, 0x8a, 0xac, 0xc7, 0x72, 0x89, 0x41, 0x4d, 0x03,
+		0xe7, 0x0a, 0x00, 0xe0, 0x06, 0xa6, 0x27, 0xfa, 0xcc, 0xf3, 0xc5, 0x7c,
+		0xd0, 0x91, 0xd1, 0x34, 0x60, 0x3c, 0xc5, 0xe8, 0x52, 0x67, 0xea, 0xdf,
+		0xb1, 0x01, 0xc3, 0xa3, 0xf6, 0x94, 0x1e, 0x61, 0xe7, 0x07, 0x57, 0x3a,
+		0xdc, 0x2f, 0x4f, 0xf7, 0x25, 0xa3, 0x58, 0x5e, 0xe8, 0x36, 0x2f, 0xf8,
+		0x1d, 0x8f, 0x06, 0x26, 0x68, 0x61, 0xaa, 0x00, 0xda, 0x7a, 0x2e, 0x38,
+		0x08, 0xbe, 0x11, 0x0d, 0xa7, 0x8c, 0x7f, 0xdd, 0xd1, 0xa9, 0xef, 0x51,
+		0x66, 0xf6, 0x83, 0xa9, 0x37, 0x68, 0x61, 0x41, 0xab, 0x44, 0x83, 0x2d,
+		0x19, 0xd7, 0x2a, 0x28, 0xeb, 0xfd, 0x8c, 0xe7, 0x2e, 0xe8, 0xd7, 0x4b,
+		0x19, 0x34, 0x2c, 0xd3, 0xbb, 0x04, 0xc5, 0x6d, 0xca, 0x28, 0xeb, 0xdd,
+		0x34, 0xc1, 0x27, 0x8a, 0xec, 0xfc, 0xb6, 0xcb, 0x61, 0x41, 0xff, 0x83,
+		0x5d, 0xf9, 0x8e, 0xa3, 0x12, 0x6f, 0x6a, 0x73, 0x57, 0x45, 0xb1, 0x59,
+		0x89, 0x6d, 0x1f, 0xd2, 0xaa, 0xb2, 0x5c, 0xdc, 0x07, 0x26, 0xfd, 0x07,
+		0xf5, 0x2b, 0x69, 0xb8, 0x06, 0xef, 0x34, 0x50, 0x32, 0x0d, 0x9c, 0xdb,
+		0x6a, 0xaa, 0xbe, 0x07, 0x00, 0x50, 0x4b, 0x07, 0x08, 0x1a, 0x8e, 0x16,
+		0x52, 0xe3, 0x00, 0x00, 0x00, 0x1e, 0x03, 0x00, 0x00, 0x50, 0x4b, 0x03,
 		0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1b,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x22,
 		0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65,
-		0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x70, 0x72,
-		0x69, 0x6d, 0x65, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00,
-		0xa6, 0xce, 0x12, 0x5c, 0x53, 0x4d, 0x8f, 0xdc, 0x44, 0x10, 0xbd, 0xfb,
-		0x57, 0x3c, 0x2d, 0x8b, 0xb0, 0xa3, 0xb1, 0x67, 0x3f, 0x88, 0x22, 0x4d,
-		0x84, 0x44, 0xa2, 0x8d, 0x94, 0x95, 0x80, 0x45, 0xbb, 0x83, 0x72, 0x40,
-		0x48, 0xd3, 0xeb, 0x2e, 0xdb, 0xad, 0x69, 0x57, 0x9b, 0xae, 0xf2, 0x7c,
-		0x70, 0xda, 0x6b, 0xee, 0x1c, 0xe1, 0x06, 0x7f, 0x6c, 0x7e, 0x09, 0x6a,
-		0xcf, 0x40, 0xb2, 0x1c, 0xbb, 0x5f, 0xe9, 0xd5, 0x7b, 0x4f, 0xaf, 0xca,
-		0xb2, 0xcc, 0x2c, 0x49, 0x1d, 0xdd, 0xa0, 0x2e, 0xf0, 0x02, 0x3f, 0x46,
-		0xd7, 0x13, 0xb4, 0x23, 0x08, 0x89, 0xb8, 0xc0, 0xa8, 0x03, 0x2b, 0xed,
-		0x14, 0x1f, 0x6e, 0x97, 0xef, 0xef, 0x7e, 0x5a, 0x62, 0x1b, 0x9d, 0x3a,
-		0x6e, 0xd1, 0x38, 0x4f, 0xf2, 0x1a, 0x6b, 0xa2, 0x01, 0x87, 0x8f, 0x7f,
-		0xbf, 0xba, 0xf8, 0x12, 0x1d, 0x19, 0x1b, 0x43, 0xe8, 0x33, 0x13, 0xdb,
-		0xb1, 0x27, 0xd6, 0xb2, 0x73, 0xac, 0x0b, 0xfc, 0xdc, 0x90, 0xd1, 0x31,
-		0x52, 0xb9, 0xa6, 0xfd, 0x2f, 0x99, 0xf1, 0x3e, 0x6c, 0xc9, 0x96, 0x1a,
-		0x82, 0x97, 0x05, 0xee, 0xc9, 0xd8, 0x19, 0xde, 0x1a, 0xe9, 0xf2, 0xd6,
-		0x29, 0x44, 0x8d, 0x8e, 0xb2, 0x78, 0x51, 0x7c, 0xf6, 0x67, 0x5d, 0xd3,
-		0xa0, 0x2c, 0x13, 0xf4, 0x1c, 0xf0, 0x52, 0x4e, 0x32, 0x9e, 0xff, 0xb6,
-		0x91, 0x06, 0x94, 0xbc, 0x78, 0x51, 0x64, 0xc9, 0x5f, 0xf6, 0x05, 0x6e,
-		0x79, 0x18, 0x75, 0x81, 0xf3, 0x4b, 0x7c, 0x83, 0x93, 0x96, 0xb9, 0x1a,
-		0x59, 0x63, 0x4d, 0x7b, 0xe4, 0x61, 0xf2, 0x6e, 0x7c, 0x91, 0x46, 0x8f,
-		0x01, 0xe4, 0x1c, 0x26, 0xa3, 0x24, 0x45, 0x96, 0x5d, 0x56, 0x78, 0xe8,
-		0xc2, 0x16, 0xab, 0x4f, 0xfa, 0x50, 0xca, 0x0a, 0x86, 0x2d, 0x0c, 0xa4,
-		0x0b, 0x51, 0x8f, 0xd8, 0x67, 0x3a, 0x57, 0x55, 0x76, 0x55, 0x4d, 0xde,
-		0x90, 0x07, 0xf6, 0xfb, 0x62, 0x91, 0x01, 0x28, 0xf1, 0xed, 0xfd, 0xbb,
-		0x37, 0x37, 0xdf, 0xbf, 0xab, 0x7a, 0x7b, 0x7a, 0xdb, 0x50, 0xcb, 0x7c,
-		0xcd, 0x61, 0xeb, 0xc9, 0xb6, 0x54, 0x3e, 0x1a, 0xa1, 0xff, 0x81, 0xff,
-		0x86, 0xd7, 0x9b, 0x41, 0xe6, 0xe7, 0x97, 0x55, 0x6f, 0x91, 0xbb, 0x06,
-		0x43, 0x24, 0x21, 0xd6, 0xe2, 0x38, 0xba, 0xec, 0x08, 0x2f, 0xd1, 0x07,
-		0x51, 0x44, 0xf2, 0xb4, 0x31, 0xac, 0xa0, 0xdd, 0x40, 0xd1, 0x11, 0xd7,
-		0x04, 0xe9, 0x4c, 0xb4, 0x82, 0x26, 0x86, 0x1e, 0xab, 0x89, 0xf5, 0x13,
-		0x38, 0x9f, 0x36, 0xc7, 0xaa, 0xb7, 0xab, 0xc4, 0x05, 0xe4, 0xd1, 0xf0,
-		0x1a, 0x8f, 0x7b, 0xa8, 0x69, 0xe7, 0x83, 0xd1, 0x0e, 0x61, 0x43, 0xd1,
-		0x9b, 0x01, 0x5b, 0xa7, 0x1d, 0xce, 0xce, 0x2f, 0xcf, 0x26, 0xef, 0x91,
-		0x6a, 0xe2, 0x7a, 0x5f, 0x54, 0xd9, 0x75, 0x85, 0xb7, 0xa3, 0xf3, 0x29,
-		0x8e, 0xc0, 0x54, 0x4a, 0x1d, 0x89, 0x18, 0xcb, 0xef, 0x5e, 0xdf, 0xdc,
-		0x23, 0x3f, 0x7c, 0xfc, 0xeb, 0xea, 0xe2, 0x02, 0x1a, 0xd6, 0xc4, 0x52,
-		0x4c, 0x1c, 0xa7, 0x30, 0x3e, 0x74, 0x46, 0xb1, 0xa5, 0xaf, 0x22, 0x41,
-		0x82, 0xdf, 0xa4, 0x56, 0x71, 0xd8, 0xce, 0xa6, 0xfa, 0x69, 0x18, 0x70,
-		0x7d, 0x2c, 0x19, 0x34, 0x20, 0x0c, 0xc4, 0x60, 0xda, 0xe9, 0x6c, 0x5a,
-		0x9d, 0x26, 0xa2, 0x93, 0xf5, 0x84, 0x6d, 0x8d, 0xd6, 0x5d, 0x75, 0xa4,
-		0x7c, 0x83, 0xe5, 0xc3, 0xfb, 0xd2, 0x46, 0xb7, 0x21, 0xc6, 0xe1, 0xe9,
-		0x8f, 0xda, 0xb0, 0x75, 0xd6, 0x28, 0x41, 0x49, 0x54, 0x0e, 0x4f, 0x7f,
-		0x42, 0xd6, 0xa4, 0x75, 0x87, 0xfc, 0xba, 0x7c, 0x55, 0xcc, 0x40, 0xa6,
-		0xee, 0xa0, 0x8e, 0x6c, 0x62, 0x32, 0x88, 0x64, 0x3c, 0x1c, 0x6f, 0x4c,
-		0x74, 0x86, 0xf5, 0x3f, 0xd2, 0x21, 0x86, 0x21, 0x08, 0x59, 0x9c, 0x39,
-		0xae, 0xfd, 0x68, 0x09, 0xde, 0x89, 0x9e, 0x21, 0xef, 0xcd, 0x0e, 0x57,
-		0x17, 0x47, 0x9d, 0x05, 0x9a, 0x10, 0x21, 0xe3, 0xa3, 0xd0, 0xaf, 0x23,
-		0x71, 0xea, 0x0a, 0x0d, 0x52, 0xa5, 0x56, 0x3d, 0x78, 0xd7, 0x9f, 0x12,
-		0xc8, 0xb2, 0xaf, 0x2b, 0xdc, 0x8f, 0x8c, 0xd5, 0xfc, 0x74, 0x5a, 0xc7,
-		0x26, 0x9d, 0x56, 0x80, 0x76, 0xa6, 0x56, 0xbf, 0xc7, 0x36, 0x85, 0xa3,
-		0x01, 0x23, 0xfb, 0x60, 0xec, 0x5c, 0xc6, 0xbe, 0x37, 0xd1, 0xfd, 0x96,
-		0x92, 0x79, 0x76, 0x74, 0x4d, 0x24, 0x4a, 0x32, 0xf3, 0x91, 0xc7, 0xa4,
-		0xd0, 0xb4, 0xc4, 0x2a, 0x33, 0xf8, 0xc0, 0x2d, 0x7a, 0xea, 0x43, 0xdc,
-		0x43, 0xa8, 0x4e, 0x15, 0x4f, 0xbf, 0x26, 0xb6, 0x84, 0x9e, 0x44, 0x4c,
-		0x4b, 0x52, 0x54, 0xb8, 0xb9, 0xc3, 0x0f, 0x77, 0xc7, 0xab, 0x26, 0x18,
-		0xde, 0x1f, 0xad, 0x54, 0xd9, 0xcb, 0x0a, 0xb7, 0x4d, 0xaa, 0x77, 0x1d,
-		0x06, 0xc2, 0xe0, 0x0d, 0xc3, 0x09, 0x7a, 0x27, 0xe2, 0xb8, 0x9d, 0x87,
-		0x51, 0x53, 0xa8, 0x76, 0x06, 0x19, 0xdb, 0x96, 0x44, 0xb1, 0x9a, 0x4f,
-		0x33, 0xa9, 0x1c, 0xab, 0xc3, 0xd3, 0xef, 0x36, 0x80, 0x83, 0xa2, 0x8e,
-		0x94, 0xb2, 0x77, 0x8a, 0x7d, 0x18, 0xa3, 0x90, 0x6f, 0xaa, 0xec, 0x9f,
-		0x00, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0x5b, 0xc1, 0xc1,
-		0xc7, 0xc6, 0x02, 0x00, 0x00, 0x74, 0x04, 0x00, 0x00, 0x50, 0x4b, 0x03,
+		0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x61, 0x66, 0x74, 0x65, 0x72,
+		0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x2e, 0x70,
+		0x79, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x94, 0x57,
+		0xcd, 0x8e, 0xe3, 0xb8, 0x11, 0xbe, 0xeb, 0x29, 0x6a, 0x39, 0xc0, 0xae,
+		0x04, 0xd8, 0xee, 0x01, 0x72, 0x59, 0x78, 0xe2, 0x01, 0x72, 0x98, 0x4b,
+		0x90, 0x9d, 0x5d, 0x4c, 0x2f, 0x02, 0x04, 0x9d, 0x86, 0x86, 0x2d, 0x95,
+		0x6c, 0x6e, 0x4b, 0x24, 0xc1, 0x2a, 0x79, 0xba, 0x31, 0x59, 0x20, 0x0f,
+		0x91, 0x27, 0xcc, 0x93, 0x2c, 0x8a, 0x92, 0x65, 0xfd, 0xb8, 0xdd, 0xb3,
+		0xbc, 0x18, 0x26, 0xab, 0xbe, 0xaf, 0x7e, 0xa9, 0xe2, 0x9b, 0xef, 0x6e,
+		0x5a, 0x0a, 0x37, 0x0f, 0xc6, 0xde, 0xa0, 0x3d, 0x82, 0x7f, 0xe6, 0x83,
+		0xb3, 0x7f, 0x49, 0xde, 0xc0, 0x4f, 0xc6, 0x9a, 0x46, 0xd7, 0x80, 0xb6,
+		0x5c, 0xbb, 0x6a, 0xcd, 0x6d, 0xb0, 0x60, 0xdb, 0x72, 0x8f, 0x5b, 0x08,
+		0xad, 0x25, 0x70, 0x16, 0x6e, 0xd9, 0xf9, 0x9b, 0xdb, 0xf6, 0x41, 0xef,
+		0xd1, 0xf2, 0x2d, 0x3b, 0xbf, 0x49, 0xde, 0xc0, 0x27, 0xd4, 0x25, 0x01,
+		0x1f, 0x10, 0x38, 0x68, 0x4b, 0x45, 0x30, 0x9e, 0xe1, 0xef, 0xb7, 0x3f,
+		0x7f, 0xfc, 0xc7, 0x0a, 0x6a, 0xe7, 0x1e, 0x09, 0x34, 0x03, 0x1f, 0x10,
+		0x6a, 0x4d, 0x0c, 0x2d, 0x61, 0x00, 0x1f, 0x5c, 0xe3, 0x79, 0x95, 0xbc,
+		0x01, 0x6d, 0x4b, 0xf0, 0xc1, 0x58, 0x26, 0xd0, 0x40, 0x8d, 0xae, 0x6b,
+		0xa0, 0x67, 0x62, 0x6c, 0x7e, 0x42, 0x22, 0xbd, 0x47, 0xf8, 0x62, 0xf8,
+		0x10, 0xc1, 0x2d, 0x3e, 0x31, 0x50, 0xbb, 0xdf, 0x23, 0x31, 0x96, 0x40,
+		0x8c, 0x7e, 0x93, 0x98, 0xc6, 0xbb, 0xc0, 0xf0, 0x1b, 0x39, 0xbb, 0x02,
+		0x7a, 0xa6, 0x15, 0x38, 0x4a, 0x92, 0xa4, 0xc4, 0x0a, 0x02, 0xea, 0x32,
+		0x17, 0xca, 0xbc, 0x25, 0x0c, 0x39, 0xe3, 0x13, 0xa7, 0x67, 0x03, 0x73,
+		0xaf, 0xf9, 0xb0, 0x05, 0xe2, 0x90, 0xc1, 0xfa, 0xbd, 0xfc, 0x6e, 0x13,
+		0x00, 0x00, 0x53, 0x81, 0x75, 0x3c, 0xf2, 0x24, 0x0a, 0x82, 0x0b, 0x71,
+		0xdb, 0xd1, 0x46, 0xfe, 0x6e, 0xf0, 0xc9, 0x10, 0xd3, 0x1c, 0x2e, 0xeb,
+		0x20, 0x64, 0x05, 0x8c, 0xe1, 0x53, 0x2a, 0xee, 0x44, 0x2b, 0xc4, 0x00,
+		0xd8, 0x9d, 0xb6, 0x38, 0x3c, 0x9f, 0xa5, 0xa3, 0x8f, 0xce, 0xa3, 0x9d,
+		0x23, 0xae, 0x40, 0x05, 0xb5, 0x02, 0xb4, 0x85, 0x2b, 0x8d, 0xdd, 0xef,
+		0x54, 0xcb, 0xd5, 0xfa, 0x47, 0x95, 0x81, 0x26, 0xa8, 0xce, 0xfa, 0xb2,
+		0x2a, 0x17, 0xa0, 0x36, 0x16, 0xc1, 0xd8, 0xf9, 0xd1, 0x82, 0x6f, 0xbc,
+		0xf0, 0x08, 0xbb, 0x18, 0xbf, 0x4d, 0xed, 0x74, 0x49, 0xa9, 0x60, 0x64,
+		0x0b, 0x49, 0x7c, 0x2a, 0xd0, 0x33, 0x7c, 0x88, 0x3f, 0xc6, 0xd9, 0x25,
+		0x81, 0xac, 0xc2, 0x59, 0x36, 0xb6, 0xc5, 0xc5, 0xa1, 0x18, 0xd7, 0xd0,
+		0x5e, 0x6c, 0xcb, 0x0b, 0x6d, 0x4b, 0x53, 0x6a, 0xc6, 0xbc, 0xe9, 0x52,
+		0x4c, 0x29, 0x1e, 0x47, 0xa1, 0x1b, 0x2f, 0x53, 0x89, 0xda, 0x66, 0x8f,
+		0x9c, 0xaa, 0xe0, 0x6a, 0x54, 0x19, 0x7c, 0xb7, 0x03, 0x25, 0x09, 0x55,
+		0x97, 0x35, 0xae, 0x9a, 0x21, 0xab, 0x4f, 0x43, 0x5e, 0x38, 0x0c, 0x05,
+		0xc6, 0xac, 0xa4, 0x03, 0x87, 0x38, 0x80, 0x96, 0x55, 0x96, 0xbd, 0x64,
+		0x8e, 0xc8, 0xbf, 0xcc, 0x3c, 0x4e, 0xb4, 0x48, 0x26, 0xd7, 0x63, 0xe7,
+		0x35, 0x51, 0x32, 0x2a, 0x97, 0x41, 0x7d, 0x43, 0x1c, 0x8c, 0x4f, 0xb3,
+		0xbe, 0x94, 0xaf, 0xc6, 0x4c, 0x29, 0xf5, 0x2f, 0x83, 0x75, 0x09, 0xfd,
+		0xd1, 0xba, 0x36, 0x8f, 0x08, 0xa5, 0x29, 0x98, 0x20, 0xe0, 0x5e, 0x87,
+		0xb2, 0x46, 0x22, 0x70, 0x15, 0xe0, 0x11, 0x2d, 0x03, 0x1d, 0xb4, 0xc7,
+		0x8d, 0x52, 0x6a, 0x5c, 0xef, 0x86, 0x8c, 0x25, 0xd6, 0xb6, 0xc0, 0x14,
+		0x8f, 0xab, 0xa8, 0xbd, 0xac, 0xe6, 0xbb, 0xfb, 0x48, 0x38, 0x18, 0x43,
+		0xb0, 0x3b, 0xed, 0x49, 0x72, 0x77, 0x80, 0xc7, 0x2e, 0x8c, 0xbd, 0x25,
+		0x2a, 0x3b, 0xb5, 0xd4, 0x08, 0xbe, 0xa1, 0xfd, 0x02, 0xff, 0x8c, 0xb8,
+		0xd1, 0xde, 0xa3, 0x2d, 0x45, 0xea, 0x92, 0xf2, 0x8c, 0x80, 0x54, 0xb6,
+		0x82, 0xda, 0xd0, 0x0b, 0x58, 0xf8, 0xc4, 0x11, 0xab, 0x2b, 0x3f, 0x29,
+		0x3e, 0x3c, 0xde, 0x9d, 0x8c, 0x23, 0x75, 0x3f, 0x03, 0x6f, 0x7a, 0xbb,
+		0xae, 0x11, 0x97, 0x9a, 0xb5, 0xca, 0x16, 0x0e, 0xc8, 0x76, 0xf4, 0xff,
+		0xae, 0x93, 0xb8, 0x1f, 0x4e, 0xa6, 0x14, 0x72, 0x38, 0x0b, 0xd1, 0x22,
+		0x18, 0x97, 0x03, 0x22, 0x9a, 0x83, 0xed, 0xea, 0x3e, 0xfb, 0x56, 0x82,
+		0x4b, 0x21, 0x7a, 0x35, 0x4c, 0x13, 0xb2, 0xd7, 0x02, 0xc5, 0xce, 0xc3,
+		0x0e, 0xbe, 0x3e, 0x6e, 0x4f, 0xe9, 0x7f, 0xcc, 0x62, 0xc0, 0x1f, 0x25,
+		0xe0, 0x7d, 0xdb, 0xae, 0xe0, 0xdc, 0x5a, 0x02, 0x16, 0xcf, 0xf0, 0xf8,
+		0xfb, 0x29, 0xd2, 0x82, 0xa1, 0x6d, 0x09, 0x9d, 0xb4, 0x1c, 0xb2, 0xf3,
+		0xd7, 0xea, 0x83, 0x9d, 0xcf, 0xc6, 0x9d, 0x73, 0xae, 0xa0, 0xa1, 0x65,
+		0x46, 0xfd, 0xdd, 0x73, 0xf7, 0x55, 0x32, 0x4d, 0x49, 0x7f, 0xb6, 0x92,
+		0x4f, 0xc0, 0xa8, 0x8c, 0x4e, 0xb8, 0xdd, 0xe9, 0x35, 0xbd, 0x59, 0xfa,
+		0xfa, 0xee, 0xef, 0x15, 0x63, 0x40, 0x94, 0xec, 0xa9, 0x4c, 0x3e, 0x22,
+		0x93, 0xed, 0xfe, 0x4f, 0xdf, 0x26, 0x4b, 0x0a, 0x51, 0x9b, 0xdb, 0x35,
+		0xf2, 0x79, 0xb8, 0x60, 0x5e, 0xd2, 0xbc, 0x90, 0xf6, 0xde, 0xad, 0xc9,
+		0xed, 0x27, 0xc2, 0x13, 0x13, 0x26, 0x46, 0xf2, 0xb3, 0x97, 0x6b, 0x77,
+		0xb7, 0x83, 0xce, 0x8d, 0x98, 0xa7, 0x65, 0x1c, 0xc6, 0x8e, 0x5e, 0xb1,
+		0xf9, 0x82, 0xf4, 0xb5, 0xd8, 0xce, 0x3c, 0xf0, 0x06, 0x8b, 0xf1, 0xad,
+		0x73, 0xfa, 0xb4, 0x18, 0xc6, 0xd8, 0xde, 0x3d, 0xfa, 0xd4, 0xe5, 0x29,
+		0xb4, 0x88, 0x5e, 0x6c, 0xb9, 0x93, 0x15, 0x8c, 0xcd, 0xb7, 0x39, 0x3e,
+		0x92, 0x7c, 0xd1, 0xeb, 0xd3, 0xea, 0x0c, 0x3f, 0x15, 0xef, 0x4c, 0x73,
+		0x05, 0xea, 0xd2, 0x07, 0x07, 0x6b, 0x53, 0x9d, 0x62, 0x6e, 0x6c, 0x34,
+		0x6c, 0x1e, 0x7b, 0xd9, 0xbb, 0xeb, 0x44, 0xee, 0xff, 0x1c, 0xfd, 0x49,
+		0xeb, 0x25, 0xda, 0x3e, 0x92, 0xaf, 0x30, 0x9f, 0xa4, 0xfe, 0x34, 0xf9,
+		0xa0, 0x38, 0xe5, 0x8f, 0xdc, 0x33, 0x96, 0x97, 0xa0, 0x97, 0xb0, 0x59,
+		0x32, 0x2b, 0x36, 0xf5, 0x6f, 0xab, 0x36, 0xbf, 0x39, 0x63, 0xd3, 0x4e,
+		0x38, 0x1b, 0x3e, 0xab, 0xa3, 0x2e, 0x52, 0xaa, 0xbf, 0x31, 0x0e, 0xc6,
+		0x72, 0x5e, 0xb9, 0x10, 0xfb, 0x61, 0x3b, 0x62, 0x95, 0x76, 0x8e, 0x9b,
+		0xd2, 0xc0, 0x4a, 0x4d, 0x51, 0x4e, 0xf3, 0xe2, 0x76, 0x4e, 0xfe, 0xd1,
+		0xd9, 0x6e, 0x0e, 0xaa, 0x4c, 0x20, 0x81, 0xe0, 0x0d, 0xf9, 0xda, 0xb0,
+		0xcc, 0x57, 0x94, 0x66, 0x77, 0x6f, 0xef, 0xe7, 0x38, 0x51, 0x70, 0x43,
+		0xac, 0x03, 0x93, 0x4c, 0x84, 0xa9, 0xba, 0xf1, 0xc1, 0x34, 0xa8, 0xb2,
+		0xed, 0xdc, 0xb3, 0x1f, 0x3e, 0xca, 0x18, 0x02, 0x37, 0xbe, 0xd6, 0x16,
+		0xd4, 0x5f, 0x1f, 0xf1, 0xf9, 0xbd, 0x02, 0x76, 0xd0, 0x68, 0x0f, 0xdf,
+		0x83, 0xec, 0xae, 0xc4, 0xd8, 0x1b, 0x7c, 0xc2, 0xa2, 0x65, 0x1c, 0x44,
+		0x4c, 0x05, 0x3a, 0x1e, 0x43, 0x37, 0xc1, 0x6e, 0x7e, 0xb8, 0x46, 0x5e,
+		0x6b, 0x7b, 0x85, 0x7b, 0x8e, 0xfd, 0xff, 0xff, 0xfe, 0x0f, 0x18, 0x89,
+		0xa9, 0x73, 0x64, 0xd5, 0x4d, 0xf3, 0x48, 0x0c, 0x47, 0xa3, 0x1f, 0x6a,
+		0x19, 0x49, 0xaa, 0xea, 0x2a, 0x61, 0x8f, 0x78, 0x85, 0x33, 0xe0, 0xd1,
+		0xe0, 0x97, 0xc1, 0x1d, 0xa1, 0xd4, 0xa6, 0x81, 0xbf, 0xfd, 0xf2, 0xcb,
+		0xa7, 0x9f, 0xff, 0xf9, 0xe1, 0x9d, 0xbc, 0x13, 0x2c, 0xdc, 0xf8, 0x80,
+		0x5e, 0x07, 0x5c, 0x17, 0xae, 0x69, 0x0c, 0x5f, 0xa5, 0xec, 0x00, 0xaf,
+		0x30, 0x4e, 0xb1, 0x22, 0xe3, 0xa1, 0x6d, 0xb4, 0x85, 0x4e, 0x93, 0xe0,
+		0x7b, 0xe8, 0x68, 0x5e, 0x89, 0xe5, 0x04, 0xe6, 0x02, 0x9f, 0xfa, 0x84,
+		0x8d, 0xb1, 0x25, 0x86, 0x6d, 0x8f, 0xdf, 0xa3, 0xbe, 0x83, 0x47, 0x44,
+		0x1f, 0x63, 0x47, 0xc0, 0x66, 0x7f, 0xe0, 0xd8, 0x8a, 0xa7, 0x21, 0x06,
+		0x8a, 0x1a, 0x75, 0xd8, 0xa8, 0x71, 0x45, 0xc7, 0xca, 0xeb, 0x6a, 0xba,
+		0xd1, 0xc6, 0xa6, 0xd9, 0x76, 0xf9, 0xe2, 0xf8, 0x30, 0x1e, 0xfa, 0x53,
+		0x7a, 0xa6, 0x0d, 0x71, 0x69, 0x6c, 0xf6, 0xca, 0xc0, 0x2a, 0x82, 0xf8,
+		0x64, 0x38, 0x7d, 0x9b, 0x25, 0x51, 0xf4, 0xfc, 0x5e, 0x81, 0x1d, 0x7c,
+		0xe8, 0x2f, 0xce, 0x61, 0x2f, 0xbe, 0x9d, 0xba, 0x0f, 0x5f, 0x3f, 0x6e,
+		0x0e, 0xef, 0x31, 0xd8, 0xbd, 0xf2, 0x40, 0xeb, 0x4c, 0xe9, 0xe6, 0xca,
+		0xa1, 0x35, 0x07, 0xf5, 0x49, 0xf3, 0x35, 0xb4, 0xbf, 0x6a, 0xe2, 0xe8,
+		0xa5, 0xd5, 0xbf, 0x17, 0xa9, 0x7d, 0xf0, 0xc1, 0x15, 0x48, 0x94, 0x0c,
+		0x47, 0xae, 0x15, 0x1f, 0xce, 0x27, 0x9b, 0xe2, 0x80, 0xc5, 0x63, 0xee,
+		0x5a, 0xf6, 0x2d, 0xa7, 0x83, 0x98, 0xac, 0x3b, 0xf5, 0xa0, 0xe9, 0x20,
+		0x17, 0xf7, 0xba, 0x2e, 0xe4, 0x67, 0x6f, 0x38, 0xa6, 0x08, 0xd6, 0x6b,
+		0xab, 0x1b, 0x5c, 0x3b, 0x5b, 0x3f, 0xc3, 0x7f, 0xe0, 0x4b, 0x01, 0xeb,
+		0x5a, 0x6e, 0x47, 0xb9, 0x3d, 0x76, 0xbf, 0x86, 0xd1, 0xcb, 0x68, 0x7a,
+		0x8b, 0xc8, 0xb2, 0xb0, 0x03, 0x63, 0x39, 0x15, 0x3b, 0x24, 0x60, 0x6f,
+		0xa7, 0x73, 0x81, 0x85, 0xf7, 0xf0, 0x63, 0x97, 0x7b, 0xda, 0x4f, 0x6e,
+		0x89, 0xbe, 0x52, 0x2f, 0x74, 0x8f, 0xac, 0xf8, 0xb8, 0x9e, 0x9a, 0x2f,
+		0x2b, 0x26, 0xbf, 0x6c, 0x1b, 0x4f, 0xcb, 0x33, 0x59, 0x5f, 0x2f, 0xee,
+		0xca, 0x52, 0x93, 0xf7, 0xb9, 0xda, 0x82, 0xfa, 0xf5, 0x60, 0x08, 0x0c,
+		0x81, 0x86, 0x5a, 0x87, 0x7d, 0xd7, 0xe8, 0xef, 0xe4, 0x63, 0x4c, 0xa6,
+		0xc4, 0x00, 0x9f, 0x25, 0xd1, 0x18, 0x20, 0xde, 0x13, 0x9f, 0x21, 0xa5,
+		0xc2, 0xb5, 0x9c, 0xc1, 0x03, 0x56, 0x2e, 0xe0, 0x60, 0x77, 0xbc, 0xb0,
+		0x3e, 0x77, 0x5d, 0x75, 0x16, 0xd6, 0x82, 0x5a, 0x19, 0xab, 0x6b, 0xd0,
+		0x6d, 0x69, 0xb8, 0xaf, 0xf4, 0xf9, 0xfa, 0x7d, 0xb1, 0x7b, 0x8e, 0xdd,
+		0xf2, 0xdf, 0xb8, 0x8a, 0xbf, 0xe1, 0x81, 0x96, 0x9c, 0xab, 0xe3, 0xeb,
+		0xc2, 0xf9, 0x86, 0xf6, 0x1d, 0x79, 0x17, 0xe7, 0x51, 0x58, 0xc5, 0xc9,
+		0x2c, 0x99, 0xf3, 0x25, 0x89, 0xa9, 0x20, 0xcf, 0xa5, 0x4a, 0xf2, 0x3c,
+		0x0e, 0x17, 0x79, 0x2e, 0x5d, 0x9a, 0xe7, 0xfd, 0x83, 0xb6, 0xd1, 0xc6,
+		0xa6, 0x59, 0xf2, 0xc7, 0x00, 0x50, 0x4b, 0x07, 0x08, 0x7a, 0xbe, 0x52,
+		0xce, 0x9b, 0x05, 0x00, 0x00, 0xa6, 0x11, 0x00, 0x00, 0x50, 0x4b, 0x03,
 		0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x23,
-		0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65,
-		0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x72, 0x65,
-		0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x70, 0x6c, 0x61, 0x6e, 0x2e,
-		0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x74,
-		0x90, 0x3d, 0x6e, 0x14, 0x41, 0x10, 0x85, 0xf3, 0x39, 0xc5, 0x0b, 0x48,
-		0x2c, 0xed, 0xcc, 0xca, 0x90, 0x39, 0x06, 0x09, 0x02, 0x24, 0x07, 0x1c,
-		0xc0, 0xe5, 0xee, 0x37, 0x3b, 0xad, 0xe9, 0xe9, 0x6a, 0xaa, 0x6a, 0x6c,
-		0x36, 0xe3, 0x0a, 0x5c, 0x91, 0x93, 0xa0, 0x59, 0xcb, 0x21, 0x07, 0x78,
-		0x3f, 0xdf, 0x37, 0x8e, 0xe3, 0x90, 0xe9, 0xc9, 0x4a, 0x8f, 0xa2, 0xed,
-		0x01, 0x8f, 0x55, 0x1a, 0x04, 0x2e, 0x33, 0xb1, 0xed, 0x35, 0xca, 0xe8,
-		0xc1, 0x0e, 0xe3, 0x2c, 0x29, 0xd4, 0xf0, 0x5a, 0x62, 0x41, 0x5a, 0x98,
-		0xd6, 0xae, 0xa5, 0x85, 0x43, 0x5a, 0x86, 0x69, 0xad, 0xcf, 0x92, 0xd6,
-		0x41, 0x6a, 0xd5, 0x57, 0xe6, 0x31, 0x54, 0xab, 0x3f, 0xe0, 0x4b, 0x2e,
-		0x31, 0x1c, 0x13, 0x3f, 0x96, 0xd2, 0x56, 0x64, 0xb2, 0xd7, 0xeb, 0x2d,
-		0xc1, 0xb6, 0x6f, 0x34, 0x09, 0x22, 0x4c, 0x32, 0xff, 0xfe, 0xfe, 0xa3,
-		0xf3, 0xec, 0x13, 0xbe, 0xcd, 0x88, 0x85, 0xe8, 0xc7, 0x0b, 0x27, 0x37,
-		0x87, 0x15, 0x5f, 0xaf, 0x27, 0xac, 0x64, 0x47, 0x1c, 0x35, 0xa5, 0x5d,
-		0x6e, 0x15, 0xdd, 0xb4, 0xab, 0xf3, 0x76, 0xd5, 0x20, 0x35, 0x68, 0x4d,
-		0xa2, 0xbc, 0xd0, 0xf1, 0xcc, 0x59, 0x8d, 0x60, 0x2e, 0xe1, 0xd3, 0x70,
-		0x7f, 0x87, 0x47, 0xd3, 0xbc, 0x27, 0xe2, 0x29, 0x6b, 0xf2, 0xf3, 0x3b,
-		0x8c, 0x9f, 0x3f, 0xdc, 0x8f, 0xc7, 0xd4, 0xb4, 0xe5, 0xa7, 0x37, 0xb2,
-		0xbe, 0x88, 0xd3, 0x4f, 0xc8, 0xec, 0x6c, 0x99, 0x2d, 0x5d, 0x71, 0x31,
-		0xe9, 0xcb, 0xe9, 0x36, 0x19, 0xf4, 0x80, 0xc7, 0xf1, 0xfb, 0x72, 0x9d,
-		0x86, 0x8f, 0x77, 0xf8, 0x2e, 0xb6, 0x22, 0x55, 0x8a, 0xfd, 0x57, 0x0a,
-		0x0e, 0x81, 0x8e, 0x4e, 0x7b, 0x6b, 0x9f, 0x86, 0x4f, 0x77, 0xf8, 0xac,
-		0x68, 0x1a, 0x48, 0x8b, 0xb4, 0x0b, 0x91, 0x34, 0x73, 0xc2, 0xd7, 0x7d,
-		0x3b, 0xdc, 0xf7, 0x6e, 0xfa, 0x22, 0x15, 0xc6, 0x9f, 0x7b, 0x31, 0xe6,
-		0x77, 0x9a, 0x33, 0x7f, 0x31, 0xed, 0xc1, 0x69, 0xf8, 0x17, 0x00, 0x00,
-		0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0x4f, 0x66, 0xd1, 0x63, 0x23, 0x01,
-		0x00, 0x00, 0xb5, 0x01, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00,
-		0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x00, 0x09, 0x00,
-		0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f,
-		0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65,
-		0x77, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce,
-		0x12, 0x54, 0x53, 0x41, 0x6f, 0x23, 0x45, 0x17, 0xbc, 0xcf, 0xaf, 0xa8,
-		0xc3, 0x77, 0x88, 0x5b, 0x1e, 0x3b, 0xc9, 0x87, 0x38, 0x4c, 0xc4, 0x61,
-		0xc9, 0x5a, 0x4b, 0x84, 0xb4, 0x09, 0x76, 0xe0, 0x82, 0x90, 0xe6, 0xa5,
-		0xfb, 0x8d, 0xa7, 0x37, 0xed, 0xee, 0x51, 0xbf, 0xd7, 0x31, 0x91, 0xf6,
-		0x0a, 0x12, 0xd7, 0x05, 0x71, 0x42, 0xe2, 0xc2, 0x2f, 0xcb, 0x2f, 0xe0,
-		0x27, 0xa0, 0xb6, 0x37, 0x6c, 0x38, 0xb5, 0xe6, 0x75, 0xbd, 0x9a, 0x9a,
-		0xaa, 0x9a, 0xb6, 0x6d, 0x1b, 0xc7, 0x62, 0xb3, 0x9f, 0xd4, 0xa7, 0xd8,
-		0x61, 0x33, 0x05, 0xaf, 0x6d, 0x4e, 0x81, 0x91, 0xf9, 0xc1, 0xf3, 0x1e,
-		0x27, 0xc2, 0xb6, 0x64, 0xaf, 0x8f, 0x73, 0x64, 0x26, 0x47, 0x77, 0x3e,
-		0x1c, 0x1e, 0x94, 0x45, 0x65, 0x86, 0xa7, 0x9f, 0x3f, 0x40, 0x7c, 0xdc,
-		0x06, 0x86, 0x63, 0xeb, 0xc5, 0xa7, 0xd8, 0x50, 0xde, 0x96, 0x1d, 0x47,
-		0x6d, 0x47, 0x1f, 0xb5, 0xc3, 0xf7, 0x03, 0x93, 0x96, 0xcc, 0xed, 0x3d,
-		0x3f, 0xfe, 0xd0, 0x50, 0x08, 0x69, 0xcf, 0xae, 0xd5, 0x94, 0x82, 0x74,
-		0x58, 0x33, 0xb9, 0x39, 0xbe, 0x24, 0x19, 0x4f, 0xb6, 0x5e, 0xe1, 0xfc,
-		0x30, 0x74, 0x66, 0xf6, 0x62, 0x22, 0x4a, 0x5a, 0xa4, 0x33, 0xb3, 0xa6,
-		0x8a, 0x3d, 0x9b, 0xe1, 0x32, 0xed, 0x26, 0xca, 0x0c, 0x5b, 0x72, 0xe6,
-		0x78, 0x5c, 0x81, 0x26, 0xf4, 0x2e, 0x59, 0x59, 0xca, 0xc4, 0x56, 0x96,
-		0xff, 0x3b, 0x6b, 0xa7, 0x40, 0x71, 0xb1, 0x73, 0x3d, 0xc8, 0x5a, 0x9e,
-		0x94, 0xa2, 0x65, 0xd8, 0x91, 0xed, 0xbd, 0x80, 0xa2, 0x83, 0x31, 0x6f,
-		0x52, 0x70, 0x1c, 0xb1, 0x2e, 0x81, 0xc5, 0x98, 0x45, 0x73, 0x3e, 0xc3,
-		0xba, 0x44, 0xe8, 0x98, 0x99, 0x21, 0x63, 0xca, 0x8a, 0x89, 0x44, 0xf8,
-		0x88, 0xb7, 0x29, 0x4a, 0x0a, 0xde, 0x91, 0x72, 0xd7, 0x00, 0x68, 0xf1,
-		0xf7, 0x9f, 0xbf, 0x7d, 0x80, 0x31, 0x9b, 0x8f, 0xf6, 0x18, 0xd3, 0x41,
-		0xd8, 0x66, 0x56, 0x59, 0xda, 0x14, 0x07, 0xbf, 0x9d, 0xc3, 0xc7, 0x77,
-		0x6c, 0xab, 0xb1, 0x90, 0x92, 0x07, 0xb2, 0x2c, 0x73, 0x64, 0x2f, 0xf7,
-		0x8f, 0xb8, 0x5a, 0x5e, 0x2f, 0x9e, 0x69, 0x7e, 0xfd, 0x1d, 0xc6, 0xac,
-		0x3f, 0x79, 0xbb, 0xbc, 0xe1, 0x3c, 0x54, 0x3a, 0x1b, 0xe8, 0x68, 0x7c,
-		0x64, 0x76, 0x81, 0x45, 0x40, 0x77, 0xa2, 0x99, 0x0e, 0x94, 0x73, 0x8c,
-		0xa9, 0x2a, 0xd4, 0x51, 0x3e, 0x32, 0x3d, 0xfd, 0xf1, 0x13, 0x8c, 0xb9,
-		0xad, 0xb9, 0x1c, 0xb6, 0x53, 0x3c, 0x60, 0x61, 0xd3, 0x03, 0x67, 0xda,
-		0x32, 0x26, 0xce, 0xa8, 0xb6, 0x5c, 0x60, 0xe7, 0xa5, 0x86, 0x06, 0x76,
-		0x5b, 0x96, 0x0b, 0x18, 0x93, 0xb9, 0x2a, 0x85, 0x66, 0xff, 0xe0, 0x29,
-		0x54, 0x94, 0x0e, 0x29, 0xef, 0x8e, 0x29, 0x1b, 0x83, 0x93, 0x63, 0x0c,
-		0x5f, 0x9c, 0x9f, 0x9e, 0x2e, 0xdf, 0x49, 0x8a, 0xad, 0x8c, 0x34, 0xf1,
-		0x0c, 0x25, 0x1e, 0x74, 0x3d, 0xbf, 0xab, 0xad, 0xf6, 0xfb, 0xc1, 0xdb,
-		0x0b, 0x4c, 0x99, 0x07, 0xce, 0xf0, 0xf1, 0x81, 0xb2, 0xa7, 0xa8, 0xb2,
-		0xac, 0x66, 0xe6, 0x2a, 0x5d, 0xa0, 0x23, 0x29, 0x2c, 0xa9, 0x1d, 0x6b,
-		0xa9, 0x82, 0x17, 0xf5, 0x16, 0xbb, 0xa2, 0x74, 0xb8, 0x5e, 0x34, 0xff,
-		0x9f, 0xe1, 0xba, 0xe8, 0x54, 0x14, 0x84, 0x1d, 0xe5, 0x7b, 0x97, 0xf6,
-		0x11, 0xc6, 0x28, 0xdd, 0x05, 0x36, 0x06, 0x7b, 0xaf, 0x23, 0x6c, 0x0a,
-		0x65, 0x17, 0xa5, 0x43, 0x3f, 0xf8, 0xc0, 0x78, 0x8f, 0xe0, 0x63, 0x3d,
-		0xbc, 0x48, 0xa9, 0x67, 0x2e, 0x81, 0x97, 0x2f, 0xc2, 0x7f, 0x0f, 0x29,
-		0xdb, 0x2d, 0x8b, 0xb2, 0xc3, 0xe0, 0x7f, 0xec, 0x17, 0xf8, 0x9a, 0x79,
-		0x82, 0x8e, 0x8c, 0xfe, 0xb0, 0xd3, 0xc3, 0x72, 0x08, 0x78, 0xfa, 0xe5,
-		0xaf, 0xb3, 0xf3, 0x53, 0xd8, 0x91, 0xb2, 0xcc, 0x8f, 0xd7, 0xff, 0x5d,
-		0xfc, 0x04, 0xfb, 0xfc, 0x5f, 0x58, 0xad, 0x88, 0x8f, 0x36, 0x14, 0xc7,
-		0x2f, 0x0a, 0xd7, 0x1e, 0x0a, 0x87, 0xab, 0xd7, 0x82, 0xfd, 0xc8, 0x11,
-		0x53, 0x12, 0xf1, 0x77, 0x81, 0x17, 0xcd, 0x67, 0x33, 0xdc, 0xd6, 0x09,
-		0x39, 0x07, 0x7a, 0xfe, 0x81, 0xea, 0x07, 0x74, 0xe8, 0x5f, 0xaf, 0x2e,
-		0xaf, 0x36, 0x57, 0xd7, 0x6f, 0x3b, 0xbc, 0xba, 0xb9, 0x59, 0x5f, 0x7f,
-		0xb7, 0xea, 0x91, 0xf2, 0xcb, 0xf1, 0x7a, 0xf5, 0xcd, 0xb7, 0xab, 0xcd,
-		0x6d, 0x7b, 0xf9, 0xd5, 0xab, 0xb7, 0x6f, 0x56, 0x9b, 0x7e, 0xd1, 0xfc,
-		0x13, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0x56, 0xc3, 0x8f,
-		0xe5, 0x74, 0x02, 0x00, 0x00, 0xc8, 0x03, 0x00, 0x00, 0x50, 0x4b, 0x03,
-		0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x20,
 		0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65,
-		0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01,
-		0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00,
-		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x00, 0x09, 0x00, 0x64, 0x6f,
-		0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b,
-		0x73, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00,
-		0xa6, 0xce, 0x12, 0xc4, 0x91, 0x31, 0x6b, 0xc3, 0x30, 0x10, 0x46, 0x77,
-		0xff, 0x8a, 0x43, 0x63, 0x09, 0xc9, 0xd0, 0x2d, 0x6b, 0xe9, 0xd8, 0x12,
-		0x48, 0x43, 0x87, 0x62, 0x84, 0x22, 0x5f, 0x6a, 0x51, 0x4b, 0x77, 0x9c,
-		0x4e, 0x83, 0x49, 0xfd, 0xdf, 0x8b, 0xdc, 0xa1, 0x1d, 0x4c, 0x96, 0x1a,
-		0xba, 0x7d, 0x12, 0xe8, 0xbd, 0x07, 0xba, 0x36, 0x00, 0xe6, 0x94, 0x51,
-		0x0e, 0x42, 0x91, 0xf5, 0x58, 0xce, 0x31, 0xa8, 0xd9, 0xc3, 0x5b, 0x03,
-		0x00, 0x70, 0x05, 0xd3, 0x13, 0x7d, 0xe4, 0x7a, 0x51, 0x0f, 0x3a, 0x32,
-		0x9a, 0x3d, 0x18, 0x4f, 0x31, 0xba, 0xd4, 0x99, 0xcd, 0xcf, 0xdc, 0x83,
-		0xe1, 0x51, 0x7b, 0x4a, 0xf7, 0xb0, 0xf5, 0x83, 0x2b, 0x1d, 0xee, 0xe6,
-		0xa7, 0xbb, 0x92, 0x51, 0x2c, 0xcf, 0x74, 0x9b, 0x67, 0xfc, 0x96, 0x47,
-		0x03, 0x13, 0xb4, 0x30, 0x35, 0x00, 0xed, 0xa6, 0x16, 0x1c, 0x04, 0x5f,
-		0x88, 0x86, 0x53, 0xc6, 0xdf, 0xee, 0xe8, 0xd4, 0xf7, 0x28, 0x95, 0x7d,
-		0x57, 0x5d, 0x7f, 0x6e, 0x61, 0x41, 0xab, 0x44, 0x83, 0x2d, 0x19, 0x97,
-		0x2a, 0x28, 0xeb, 0xed, 0x8c, 0xc7, 0x2e, 0xe8, 0xe7, 0x53, 0x19, 0x34,
-		0xcc, 0xeb, 0x55, 0x82, 0xe2, 0x3a, 0x65, 0x94, 0xf5, 0x66, 0x9a, 0xe0,
-		0x03, 0x45, 0x76, 0x7e, 0xdd, 0xcf, 0x61, 0x41, 0xff, 0x8d, 0x5d, 0x70,
-		0x1e, 0x95, 0x78, 0x55, 0x9b, 0xbb, 0x28, 0x8a, 0xcd, 0x4a, 0x6c, 0xfb,
-		0x90, 0x16, 0x95, 0xe5, 0xec, 0xde, 0x31, 0xe9, 0x3f, 0xa8, 0x9f, 0x49,
-		0xc3, 0x25, 0x78, 0xa7, 0x81, 0x52, 0x35, 0xb5, 0xcd, 0xd4, 0x7c, 0x05,
-		0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0x1a, 0x8e, 0x16, 0x52,
-		0xe5, 0x00, 0x00, 0x00, 0x1e, 0x03, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04,
-		0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x22, 0x00,
-		0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f,
-		0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f,
-		0x73, 0x74, 0x6f, 0x70, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x2e, 0x70, 0x79,
-		0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x94, 0x57, 0xcd,
-		0x8e, 0xe3, 0xb8, 0x11, 0xbe, 0xfb, 0x29, 0x6a, 0xd9, 0xc0, 0xae, 0x05,
-		0xd8, 0xf2, 0x00, 0xb9, 0x2c, 0x3c, 0xf1, 0x00, 0x39, 0xf4, 0x25, 0xc8,
-		0xce, 0x2e, 0x66, 0x16, 0x01, 0x82, 0x4e, 0x43, 0xc3, 0x96, 0x4a, 0x32,
-		0xd7, 0x12, 0x49, 0xb0, 0x28, 0x4f, 0x37, 0x26, 0x03, 0xe4, 0x21, 0xf2,
-		0x84, 0x79, 0x92, 0x45, 0x91, 0xb2, 0xac, 0x1f, 0xdb, 0x3d, 0xc3, 0x83,
-		0x0d, 0xb1, 0xaa, 0xbe, 0xaf, 0x58, 0x3f, 0xfc, 0xb9, 0xfb, 0x61, 0xd3,
-		0x92, 0xdb, 0x3c, 0x29, 0xbd, 0x41, 0x7d, 0x04, 0xfb, 0xe2, 0xf7, 0x46,
-		0xff, 0x65, 0x71, 0x07, 0xbf, 0x28, 0xad, 0x1a, 0x59, 0x03, 0xea, 0x62,
-		0x6d, 0xca, 0xb5, 0x6f, 0x9d, 0x06, 0xdd, 0x16, 0x15, 0x6e, 0xc1, 0xb5,
-		0x9a, 0xc0, 0x68, 0xf8, 0xe8, 0x8d, 0xdd, 0x7c, 0x6c, 0x9f, 0x64, 0x85,
-		0xda, 0xf3, 0x47, 0xba, 0xb8, 0x83, 0x0f, 0x28, 0x0b, 0x02, 0xbf, 0x47,
-		0xf0, 0x4e, 0x6a, 0xca, 0x9d, 0xb2, 0x1e, 0xfe, 0xfe, 0xf1, 0xd7, 0xf7,
-		0xff, 0x58, 0x41, 0x6d, 0xcc, 0x81, 0x40, 0xfa, 0x20, 0xae, 0x25, 0x79,
-		0x68, 0x09, 0x1d, 0x58, 0x67, 0x1a, 0xeb, 0x57, 0x8b, 0x3b, 0x90, 0xba,
-		0x00, 0xeb, 0x94, 0xf6, 0x04, 0x12, 0xa8, 0x91, 0x75, 0x0d, 0xf4, 0x42,
-		0x1e, 0x9b, 0x5f, 0x90, 0x48, 0x56, 0x08, 0x9f, 0x95, 0xdf, 0x07, 0x6b,
-		0x8d, 0xcf, 0x1e, 0xa8, 0xad, 0x2a, 0x24, 0x8f, 0x05, 0x90, 0x47, 0x9b,
-		0x2e, 0x54, 0x63, 0x8d, 0xf3, 0xf0, 0x07, 0x19, 0xbd, 0x62, 0xc3, 0x15,
-		0x18, 0x5a, 0x2c, 0x16, 0x05, 0x96, 0xe0, 0x50, 0x16, 0x19, 0x53, 0x66,
-		0x4c, 0x99, 0x79, 0x7c, 0xf6, 0xcb, 0xb3, 0x83, 0x99, 0x95, 0x7e, 0xbf,
-		0x05, 0xf2, 0x2e, 0x81, 0xf5, 0x3b, 0xfe, 0xdf, 0x2e, 0x00, 0x00, 0x54,
-		0x09, 0xda, 0x78, 0x98, 0x28, 0x82, 0x71, 0x61, 0xda, 0x50, 0xca, 0x9f,
-		0x29, 0x3e, 0x2b, 0xf2, 0x34, 0x85, 0x4b, 0x22, 0x04, 0x0f, 0x87, 0x21,
-		0x7c, 0x42, 0x84, 0x99, 0xe0, 0x05, 0x3b, 0x00, 0xbb, 0xd3, 0x94, 0x77,
-		0x2f, 0x67, 0xed, 0xb0, 0x46, 0x63, 0x51, 0x4f, 0x11, 0x57, 0x20, 0x9c,
-		0x58, 0x01, 0xea, 0xdc, 0x14, 0x4a, 0x57, 0x3b, 0xd1, 0xfa, 0x72, 0xfd,
-		0xb3, 0x48, 0x40, 0x12, 0x94, 0x67, 0x7b, 0x1e, 0xa5, 0x71, 0x50, 0x2b,
-		0x8d, 0xa0, 0xf4, 0x54, 0x34, 0xe3, 0x1b, 0x0e, 0x3c, 0xc2, 0x2e, 0xc4,
-		0x2f, 0xad, 0x8d, 0x2c, 0x68, 0xc9, 0x18, 0xc9, 0x4c, 0x13, 0x9f, 0x73,
-		0xb4, 0x1e, 0xee, 0xc3, 0x9f, 0x32, 0xfa, 0x32, 0x56, 0x6e, 0xb4, 0x57,
-		0xba, 0xc5, 0x99, 0x90, 0x9d, 0x6b, 0xa8, 0x62, 0xdf, 0xb2, 0x5c, 0xea,
-		0x42, 0x15, 0xd2, 0x63, 0xd6, 0xc4, 0x14, 0xd3, 0x12, 0x8f, 0xc9, 0x65,
-		0x40, 0x55, 0xb2, 0x59, 0x5a, 0xa1, 0x5f, 0x0a, 0x67, 0x6a, 0x14, 0x09,
-		0xfc, 0xb0, 0x03, 0xc1, 0x09, 0x15, 0x97, 0x2d, 0xe0, 0x96, 0x1b, 0x3c,
-		0xba, 0x34, 0x64, 0xb9, 0x41, 0x97, 0x63, 0x2c, 0x8b, 0x9e, 0x83, 0x2d,
-		0x51, 0x7b, 0x91, 0xcc, 0x23, 0x00, 0xd1, 0x1d, 0xd6, 0xbf, 0xce, 0x3c,
-		0x4c, 0x34, 0xff, 0x05, 0xc5, 0xeb, 0xb1, 0xb3, 0x92, 0x28, 0x7c, 0x74,
-		0xe5, 0xd2, 0x9b, 0xa7, 0xe4, 0x9d, 0xb2, 0xcb, 0xa4, 0x2b, 0xe5, 0x9b,
-		0x31, 0x13, 0x42, 0xfc, 0x4b, 0x61, 0x5d, 0x40, 0x27, 0x5a, 0xd7, 0xea,
-		0x80, 0x50, 0xa8, 0xdc, 0x13, 0x38, 0xac, 0xa4, 0x2b, 0x6a, 0x24, 0x02,
-		0x53, 0x02, 0x1e, 0x51, 0x7b, 0xa0, 0xbd, 0xb4, 0x98, 0x8a, 0xae, 0x0e,
-		0xbb, 0x7a, 0x57, 0xa4, 0x34, 0x79, 0xa9, 0x73, 0x5c, 0xe2, 0x71, 0x15,
-		0xac, 0xe7, 0xd5, 0xfc, 0xf0, 0x18, 0x66, 0x7a, 0x67, 0x08, 0x76, 0xa7,
-		0x39, 0x4e, 0xee, 0x0e, 0xf0, 0x18, 0xc3, 0xd8, 0x79, 0x22, 0x92, 0x13,
-		0xc5, 0x00, 0xbe, 0xa1, 0x6a, 0x86, 0x7f, 0x46, 0x4c, 0xa5, 0xb5, 0xa8,
-		0x0b, 0xd6, 0xba, 0x64, 0x3c, 0x21, 0x20, 0x91, 0xac, 0xa0, 0x56, 0x74,
-		0x05, 0x0b, 0x9f, 0x7d, 0xc0, 0x8a, 0xe5, 0xc7, 0xc5, 0x87, 0xc7, 0x87,
-		0xb3, 0xed, 0xe3, 0xd4, 0xb3, 0xce, 0xaf, 0x5b, 0xc4, 0x85, 0xf4, 0x92,
-		0x49, 0x27, 0x0b, 0xe0, 0xe9, 0xb0, 0xfe, 0x87, 0xa8, 0xf1, 0xd8, 0x4b,
-		0xc6, 0x30, 0x2c, 0x9c, 0x84, 0x68, 0x86, 0x75, 0x39, 0x20, 0x6c, 0xd9,
-		0xfb, 0x2e, 0x1e, 0x93, 0x6f, 0x25, 0xb8, 0x14, 0x22, 0x78, 0x2d, 0x4c,
-		0x23, 0xb2, 0xd7, 0x02, 0xe5, 0x8d, 0x85, 0x1d, 0x7c, 0x39, 0x6c, 0x4f,
-		0xe9, 0x3f, 0x24, 0x01, 0xe9, 0xc0, 0x48, 0x5d, 0xdb, 0xae, 0xe0, 0xdc,
-		0x5a, 0x0c, 0x76, 0x88, 0xc9, 0xf8, 0x7a, 0x8a, 0x34, 0x63, 0xf0, 0x49,
-		0x10, 0xb5, 0x59, 0xe8, 0x8d, 0xbd, 0x55, 0x1f, 0xde, 0xd8, 0x64, 0xd8,
-		0x39, 0x67, 0x8d, 0xbe, 0x65, 0x06, 0xfd, 0xdd, 0x71, 0x27, 0xdb, 0x0b,
-		0x99, 0xed, 0x64, 0xab, 0x70, 0x14, 0xcc, 0x4a, 0xbe, 0x93, 0xde, 0xb2,
-		0x9b, 0xa4, 0xaf, 0xeb, 0xfe, 0x4e, 0x1c, 0x73, 0xc1, 0x73, 0x22, 0xe1,
-		0x43, 0x64, 0x34, 0xdd, 0x87, 0xe4, 0x4a, 0x32, 0xd9, 0x6c, 0xea, 0xd7,
-		0xc0, 0xb7, 0x7e, 0x83, 0xb9, 0x66, 0x79, 0x21, 0xed, 0x9d, 0xe9, 0x28,
-		0x3a, 0xfc, 0x33, 0x72, 0x61, 0xec, 0xfb, 0x8b, 0xe5, 0x6d, 0x77, 0xb7,
-		0x83, 0xb8, 0x8c, 0x90, 0xa7, 0x79, 0x1c, 0x86, 0x0b, 0xbd, 0xe1, 0xf3,
-		0x05, 0xed, 0x5b, 0xb1, 0x9d, 0xac, 0xc0, 0x2a, 0xcc, 0x87, 0xbb, 0x0e,
-		0x74, 0x47, 0x8b, 0xf2, 0x18, 0xea, 0xb6, 0xb3, 0x1b, 0x33, 0x8f, 0xa1,
-		0x59, 0xf5, 0x62, 0xcb, 0x9d, 0x54, 0x3d, 0x36, 0xdf, 0xb6, 0xf0, 0x81,
-		0xe6, 0xd5, 0x55, 0x9f, 0x46, 0x74, 0xfc, 0x54, 0xbc, 0x13, 0xcb, 0x15,
-		0x88, 0x4b, 0x07, 0x0e, 0xd6, 0xaa, 0x3c, 0x51, 0x2b, 0x1d, 0xd7, 0x78,
-		0xc1, 0x85, 0x87, 0xa8, 0xf2, 0xf8, 0x7d, 0xf4, 0x27, 0xab, 0x6b, 0xb4,
-		0xa7, 0xd2, 0xbc, 0xcd, 0x7c, 0xd2, 0xfa, 0x6e, 0xf2, 0xde, 0x70, 0xcc,
-		0x1f, 0xb8, 0x67, 0xc9, 0xba, 0x0c, 0x3d, 0x87, 0x4d, 0xa6, 0xcd, 0x2b,
-		0xfe, 0xad, 0x45, 0xfa, 0x87, 0x51, 0x7a, 0x19, 0x95, 0x93, 0xfe, 0x58,
-		0x85, 0xd1, 0x15, 0x2d, 0xee, 0x18, 0x7b, 0xa5, 0x7d, 0x56, 0x1a, 0x17,
-		0xfa, 0x61, 0x3b, 0x60, 0xe5, 0x76, 0x0e, 0x93, 0xdc, 0xc0, 0x42, 0x8c,
-		0x51, 0x4e, 0xf7, 0xc5, 0xd9, 0xce, 0xf1, 0xde, 0xe8, 0x78, 0x01, 0x29,
-		0x95, 0xa3, 0x70, 0x1f, 0x48, 0xc9, 0xd6, 0xca, 0xf3, 0xfd, 0x8a, 0x96,
-		0xc9, 0xc3, 0x9b, 0xc7, 0x29, 0x4e, 0x50, 0x4c, 0xc9, 0x4b, 0xe7, 0x89,
-		0x6f, 0x84, 0x4b, 0xb1, 0xb1, 0x4e, 0x35, 0x28, 0xe6, 0xdb, 0xd2, 0x4f,
-		0xef, 0x83, 0x8f, 0x1b, 0x5b, 0x4b, 0x0d, 0xe2, 0xaf, 0x07, 0x7c, 0x79,
-		0x27, 0xc0, 0x1b, 0x68, 0xa4, 0x85, 0x1f, 0x81, 0x67, 0x57, 0xec, 0xec,
-		0x06, 0x9f, 0x31, 0x6f, 0x3d, 0xf6, 0x2a, 0xaa, 0x04, 0x19, 0xc4, 0x10,
-		0x6f, 0xb0, 0xe9, 0x4f, 0xb7, 0xc8, 0x6b, 0xa9, 0x6f, 0x70, 0x4f, 0xb1,
-		0xff, 0xff, 0xdf, 0xff, 0x81, 0x47, 0xf2, 0x14, 0xb1, 0x56, 0xf1, 0x36,
-		0x8f, 0xe4, 0xe1, 0xa8, 0xe4, 0x53, 0xcd, 0x57, 0x92, 0xb2, 0xbc, 0x49,
-		0xd8, 0x21, 0xde, 0xe0, 0x74, 0x78, 0x54, 0xf8, 0x79, 0x44, 0x29, 0x55,
-		0x03, 0x7f, 0xfb, 0xed, 0xb7, 0x0f, 0xbf, 0xfe, 0xf3, 0xfe, 0x2d, 0xbf,
-		0x13, 0x34, 0x6c, 0xac, 0x43, 0x2b, 0x1d, 0xae, 0x73, 0xd3, 0x34, 0xca,
-		0xdf, 0xa4, 0x8c, 0x80, 0xb7, 0x22, 0x3c, 0xc2, 0x0a, 0x8c, 0xfb, 0xb6,
-		0x91, 0x1a, 0xa2, 0x25, 0xc1, 0x8f, 0x10, 0x45, 0xaf, 0xc4, 0x72, 0x04,
-		0x73, 0x81, 0x4f, 0x7c, 0xc0, 0x46, 0xe9, 0x02, 0xdd, 0xb6, 0xc3, 0xef,
-		0x50, 0xdf, 0xc2, 0x01, 0xd1, 0x86, 0xd8, 0x11, 0x78, 0x55, 0xed, 0x7d,
-		0x68, 0xc5, 0xd3, 0xd9, 0x0c, 0x79, 0x8d, 0xd2, 0xa5, 0x62, 0x31, 0xad,
-		0xbc, 0x58, 0xd3, 0x8d, 0x54, 0x7a, 0x79, 0x2a, 0xe3, 0xe1, 0x0b, 0xe0,
-		0x7e, 0x78, 0xe9, 0x5f, 0xd2, 0x0b, 0xa5, 0xe4, 0x0b, 0xa5, 0x63, 0x2d,
-		0x5e, 0xbf, 0xb0, 0xb2, 0x22, 0x3e, 0x2b, 0xbf, 0x7c, 0x93, 0x2c, 0x3a,
-		0xd0, 0xfe, 0xc5, 0xb7, 0x83, 0xfb, 0x6e, 0x53, 0x1b, 0xbf, 0x61, 0xe2,
-		0xc1, 0x37, 0x7c, 0x09, 0x85, 0x27, 0xe0, 0xee, 0x95, 0x07, 0x5a, 0x74,
-		0x25, 0xde, 0x2b, 0xfb, 0xd6, 0xec, 0xb5, 0x47, 0xcd, 0xd7, 0x50, 0x75,
-		0xd3, 0xc5, 0xc1, 0xba, 0xbb, 0xf7, 0x22, 0xb5, 0x4f, 0xd6, 0x99, 0x1c,
-		0x89, 0x16, 0xbd, 0xc8, 0xb4, 0xbc, 0x86, 0xb3, 0x24, 0xcd, 0xf7, 0x98,
-		0x1f, 0x32, 0xd3, 0x7a, 0xdb, 0xfa, 0xe5, 0x68, 0xff, 0x79, 0x10, 0x4f,
-		0x92, 0xf6, 0xbc, 0x71, 0xaf, 0xeb, 0x9c, 0xff, 0x2a, 0xe5, 0x43, 0x8a,
-		0x60, 0xbd, 0xd6, 0xb2, 0xc1, 0xb5, 0xd1, 0xf5, 0x0b, 0xfc, 0x07, 0x3e,
-		0xe7, 0xb0, 0xae, 0x79, 0x77, 0xe4, 0xb5, 0xed, 0x7e, 0x77, 0x83, 0x27,
-		0xc9, 0x78, 0x17, 0xe1, 0xa1, 0x61, 0x07, 0x4a, 0xfb, 0x25, 0xfb, 0xc1,
-		0x01, 0x7b, 0x33, 0xbe, 0x17, 0x68, 0x78, 0x07, 0x3f, 0xc7, 0xdc, 0x53,
-		0x35, 0x2a, 0xae, 0x71, 0x3f, 0x8a, 0xc9, 0x5e, 0x19, 0x1e, 0xd7, 0x63,
-		0xf7, 0x79, 0x84, 0xe4, 0x17, 0x6d, 0x63, 0x69, 0x2e, 0xe3, 0xf1, 0xe5,
-		0xe2, 0x2c, 0x0f, 0x31, 0x7a, 0x9f, 0x8b, 0x2d, 0x88, 0xdf, 0xf7, 0x8a,
-		0x40, 0xf1, 0x03, 0xbe, 0x96, 0xae, 0x8a, 0x8d, 0xfe, 0x96, 0x0f, 0x63,
-		0x52, 0x05, 0x3a, 0xf8, 0xc4, 0x89, 0x46, 0x07, 0xa1, 0x69, 0x3f, 0xc1,
-		0x92, 0x72, 0xd3, 0xfa, 0x04, 0x9e, 0xb0, 0x34, 0x0e, 0x7b, 0xbf, 0xc3,
-		0x86, 0xf5, 0x29, 0x76, 0xd5, 0x59, 0x59, 0x32, 0x6a, 0xa9, 0xb4, 0xac,
-		0x41, 0xb6, 0x85, 0xf2, 0x5d, 0xa5, 0x4f, 0xc7, 0xd7, 0xd9, 0xec, 0xf8,
-		0x60, 0x19, 0x7f, 0x0d, 0x4b, 0x04, 0x5e, 0x7f, 0xa0, 0x85, 0xaf, 0x58,
-		0x1d, 0x5f, 0x66, 0x8b, 0x6f, 0xa8, 0x8a, 0xe4, 0x31, 0xce, 0x83, 0xb0,
-		0xf2, 0x22, 0x23, 0xfe, 0xa8, 0x24, 0x17, 0xaa, 0x84, 0x2c, 0xe3, 0x2a,
-		0xc9, 0xb2, 0x70, 0xb9, 0xc8, 0x32, 0xee, 0xd2, 0x2c, 0xeb, 0x1e, 0xb4,
-		0xb1, 0x65, 0x17, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07,
-		0x08, 0x7a, 0xbe, 0x52, 0xce, 0x88, 0x05, 0x00, 0x00, 0xa6, 0x11, 0x00,
+		0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+		0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x2e, 0x70, 0x79, 0x55,
+		0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0xac, 0x58, 0x5f, 0x6f,
+		0xdb, 0xba, 0x15, 0x7f, 0xd7, 0xa7, 0x38, 0xe5, 0x80, 0x41, 0x02, 0x6c,
+		0x39, 0x77, 0x79, 0x19, 0x52, 0xb8, 0x58, 0xd1, 0x06, 0xf7, 0x76, 0xbb,
+		0x4b, 0x8b, 0x9b, 0xec, 0x29, 0x08, 0x64, 0x5a, 0xa2, 0x64, 0x36, 0x12,
+		0xc9, 0x91, 0x54, 0x62, 0x2d, 0xcd, 0x77, 0x1f, 0x0e, 0x49, 0xfd, 0x71,
+		0x64, 0xa7, 0xed, 0x30, 0xbe, 0xd8, 0x3a, 0x3c, 0x7f, 0x7e, 0xe7, 0xf0,
+		0x47, 0x1e, 0x4a, 0x7f, 0x7a, 0xb3, 0x6a, 0x8d, 0x5e, 0x6d, 0xb9, 0x58,
+		0x31, 0xf1, 0x00, 0xaa, 0xb3, 0x3b, 0x29, 0xce, 0x23, 0xde, 0x28, 0xa9,
+		0x2d, 0x7c, 0x35, 0x52, 0xf4, 0xff, 0xcd, 0xae, 0xb5, 0xbc, 0x1e, 0x9e,
+		0xda, 0xad, 0xd2, 0x32, 0x67, 0xc6, 0x0c, 0x92, 0xce, 0x44, 0xa5, 0x96,
+		0x0d, 0x28, 0x6a, 0x77, 0x35, 0xdf, 0x42, 0x90, 0x7f, 0xa1, 0x76, 0x17,
+		0x45, 0x97, 0xb0, 0x76, 0xde, 0xd2, 0x5a, 0xd2, 0x22, 0x36, 0x9d, 0x49,
+		0x8d, 0x2d, 0xb8, 0x48, 0xa2, 0x9b, 0xcf, 0x9f, 0x7f, 0xcf, 0x3e, 0x5d,
+		0x7d, 0xf9, 0xd7, 0x0d, 0xac, 0xe1, 0x32, 0xad, 0x98, 0x8d, 0x89, 0x95,
+		0xb2, 0xce, 0xb8, 0x50, 0xad, 0x25, 0x09, 0x48, 0x0d, 0x4f, 0xcf, 0xd1,
+		0xcd, 0xfb, 0x3f, 0x7e, 0xbd, 0x44, 0x95, 0x51, 0xdf, 0xeb, 0x96, 0xbc,
+		0x66, 0x19, 0x86, 0xf4, 0xaa, 0x84, 0x44, 0xd1, 0xf5, 0xcd, 0xfb, 0x9b,
+		0xcb, 0xec, 0xe3, 0xa7, 0x3f, 0x60, 0xed, 0xa2, 0xc7, 0x24, 0xcd, 0x6b,
+		0xda, 0x16, 0x8c, 0x24, 0xb0, 0x02, 0x62, 0x98, 0x31, 0x5c, 0x0a, 0x12,
+		0xfd, 0xf6, 0xe9, 0xea, 0x26, 0xfb, 0xf0, 0xfe, 0xc3, 0x6f, 0x08, 0x6e,
+		0x34, 0x5a, 0x01, 0x29, 0xa5, 0x6e, 0xa8, 0xcd, 0x76, 0x5c, 0x58, 0x93,
+		0x22, 0x6c, 0x12, 0xb9, 0x87, 0xcc, 0x58, 0x6a, 0x19, 0xac, 0xe1, 0x4a,
+		0x0a, 0x16, 0x45, 0x51, 0xc1, 0x4a, 0x70, 0x72, 0x29, 0x72, 0x16, 0xdf,
+		0xb3, 0xee, 0x02, 0x8c, 0xd5, 0x0b, 0x68, 0x98, 0x31, 0xb4, 0x62, 0xee,
+		0x29, 0x81, 0xe5, 0x3b, 0xa7, 0x7f, 0x11, 0x01, 0x00, 0x54, 0xb5, 0xdc,
+		0xd2, 0x1a, 0x26, 0xee, 0x9c, 0x78, 0x08, 0x9f, 0x36, 0xf7, 0x05, 0xd7,
+		0xb1, 0xa2, 0x9a, 0x09, 0x6b, 0xd6, 0x37, 0xba, 0x65, 0x0b, 0x60, 0x7b,
+		0x6e, 0x6c, 0x26, 0xef, 0xdd, 0x63, 0xe2, 0x0c, 0x78, 0x39, 0xf5, 0x01,
+		0xdc, 0x4c, 0x62, 0x84, 0xf9, 0x31, 0xbf, 0xd4, 0x39, 0x30, 0x71, 0x32,
+		0xce, 0xe3, 0xb0, 0xba, 0x3b, 0x14, 0xe0, 0x98, 0x7a, 0x9d, 0xac, 0x99,
+		0x89, 0x27, 0xee, 0x34, 0xa3, 0x45, 0x66, 0xd9, 0xde, 0xc6, 0x4c, 0xe4,
+		0xb2, 0xe0, 0xa2, 0x5a, 0x93, 0xd6, 0x96, 0xcb, 0xbf, 0x92, 0x24, 0x39,
+		0x70, 0xc8, 0xf6, 0x39, 0x53, 0x16, 0x2e, 0xdd, 0x0f, 0x97, 0xe2, 0x7b,
+		0xe1, 0x9e, 0x9e, 0x07, 0x05, 0x56, 0x9b, 0x50, 0xb2, 0xe3, 0xc8, 0x9e,
+		0x9e, 0x8f, 0xd4, 0xc1, 0x71, 0xe2, 0x9e, 0x75, 0x93, 0x44, 0x35, 0xb3,
+		0xad, 0x16, 0xd1, 0x8b, 0x58, 0xb7, 0xf7, 0xac, 0xbb, 0x43, 0x3a, 0xe9,
+		0x96, 0x45, 0xb3, 0x5a, 0x4c, 0x52, 0x7d, 0xd4, 0xdc, 0x32, 0x9f, 0x2b,
+		0x12, 0x21, 0x2d, 0xda, 0x46, 0x99, 0x78, 0x12, 0x32, 0x59, 0xc0, 0xac,
+		0x08, 0xd1, 0xeb, 0xb9, 0x2b, 0x6a, 0x8c, 0x7b, 0x50, 0x9a, 0x8b, 0x03,
+		0xbf, 0x4f, 0xc4, 0x74, 0xc6, 0xb2, 0xe6, 0x9f, 0x9e, 0x40, 0xe4, 0xa2,
+		0xa7, 0xd2, 0x73, 0x92, 0x04, 0xbe, 0xe9, 0x56, 0xc4, 0x79, 0x53, 0x2c,
+		0x20, 0x7f, 0x2c, 0xd6, 0xb8, 0xe6, 0x21, 0x57, 0xcd, 0x4c, 0x5b, 0x5b,
+		0x58, 0x4f, 0xb6, 0x66, 0x8a, 0xba, 0x43, 0x52, 0x68, 0x34, 0x3e, 0x3c,
+		0x16, 0xeb, 0xfc, 0x71, 0x22, 0xc0, 0x0c, 0x1d, 0xbd, 0x46, 0x51, 0x4e,
+		0x95, 0x6d, 0x35, 0xcb, 0x64, 0x6b, 0x55, 0x3b, 0x9d, 0x1c, 0x18, 0xe8,
+		0x63, 0xe2, 0x56, 0x96, 0xad, 0x1d, 0xf3, 0x0b, 0xdb, 0x5b, 0xb6, 0xd6,
+		0x97, 0x2f, 0x3e, 0xd0, 0x3b, 0x62, 0xcd, 0xb4, 0x9e, 0x59, 0x33, 0xad,
+		0x67, 0xd6, 0x4c, 0xeb, 0x24, 0x24, 0x8b, 0x8b, 0x1a, 0x3c, 0x84, 0xc2,
+		0x94, 0x5c, 0x14, 0x59, 0xab, 0x62, 0x63, 0xa9, 0xb6, 0x17, 0x6e, 0xe7,
+		0x2f, 0xc0, 0x52, 0x5d, 0x31, 0xeb, 0x37, 0xa2, 0x0f, 0x91, 0xb7, 0x1a,
+		0x8b, 0x84, 0x4a, 0xee, 0xb9, 0x94, 0x1a, 0x32, 0xe0, 0x02, 0x34, 0x15,
+		0x15, 0x8b, 0x7f, 0x39, 0xcb, 0xce, 0xce, 0xce, 0x82, 0x2e, 0x8e, 0x9c,
+		0x8a, 0x82, 0x17, 0xb8, 0xc7, 0xd6, 0xce, 0x76, 0x15, 0x7c, 0x0e, 0x0a,
+		0xbc, 0x1c, 0x75, 0x4e, 0x6c, 0xb4, 0x00, 0x77, 0x50, 0x3b, 0xb0, 0x6d,
+		0x75, 0xea, 0x77, 0x3b, 0xac, 0x5d, 0x84, 0x31, 0x34, 0x8e, 0xad, 0x66,
+		0xf4, 0x7e, 0x90, 0x20, 0x80, 0xf5, 0xc4, 0x64, 0x5a, 0x8c, 0xe9, 0x99,
+		0x44, 0x45, 0x51, 0xb3, 0xac, 0x92, 0x31, 0x9e, 0x8c, 0xbe, 0x16, 0x01,
+		0x52, 0x25, 0x31, 0x7b, 0x77, 0x96, 0xa7, 0x8f, 0x3b, 0x9e, 0xef, 0x62,
+		0x52, 0x49, 0x32, 0xac, 0x89, 0x90, 0x16, 0x2a, 0x39, 0x42, 0x18, 0x4f,
+		0x37, 0x54, 0x5b, 0x00, 0xf9, 0x24, 0x8c, 0xa5, 0x75, 0x0d, 0xbf, 0x4a,
+		0xb0, 0x12, 0x98, 0xa0, 0xdb, 0x9a, 0xa1, 0xd3, 0xb2, 0xb1, 0xab, 0x4a,
+		0xc2, 0xb6, 0xe5, 0x75, 0x01, 0xf9, 0x8e, 0xe5, 0xf7, 0x26, 0x0d, 0x6e,
+		0x47, 0x8c, 0x01, 0x81, 0xef, 0x0c, 0xe6, 0x08, 0x90, 0x30, 0x33, 0xe2,
+		0x19, 0x44, 0x23, 0xa4, 0x81, 0xe8, 0xc8, 0xee, 0xdb, 0x41, 0x61, 0x01,
+		0x64, 0xf9, 0x48, 0x16, 0xb8, 0xd2, 0x2e, 0xeb, 0xe4, 0x6e, 0x8c, 0x3e,
+		0x92, 0xcd, 0xaf, 0x44, 0x2e, 0x0b, 0x06, 0x6f, 0xd6, 0x70, 0x36, 0x7a,
+		0x9d, 0x25, 0x1b, 0xdc, 0x2e, 0x99, 0xd6, 0x52, 0x63, 0xe6, 0x43, 0x24,
+		0x28, 0x29, 0xaf, 0x59, 0xf1, 0x16, 0xb8, 0x30, 0x8a, 0xe5, 0x16, 0xfc,
+		0x06, 0x01, 0xba, 0x95, 0x0f, 0xac, 0x4f, 0xfa, 0xf0, 0xf8, 0x1a, 0xcb,
+		0x78, 0x10, 0x70, 0xf4, 0x49, 0xc6, 0x6d, 0x87, 0x83, 0x7c, 0x76, 0x47,
+		0x06, 0xad, 0x2f, 0x30, 0x88, 0x2b, 0xf8, 0xa0, 0x0a, 0xf1, 0xa6, 0x92,
+		0x13, 0x71, 0x4d, 0x45, 0x95, 0x4a, 0x5d, 0xad, 0xf6, 0x2b, 0x6c, 0x9c,
+		0x66, 0x95, 0x37, 0xc5, 0x6a, 0xd0, 0xfe, 0x5b, 0x4d, 0x2d, 0x33, 0x76,
+		0x93, 0xa4, 0x93, 0x10, 0x93, 0x75, 0xf1, 0x35, 0x5c, 0x00, 0x29, 0x1b,
+		0x7b, 0xa4, 0x7a, 0xea, 0xbe, 0xca, 0x0a, 0x8e, 0x3b, 0x06, 0x6b, 0xda,
+		0x13, 0x95, 0x97, 0x07, 0x8f, 0x6f, 0xfa, 0x3e, 0x4b, 0x12, 0x97, 0x77,
+		0x78, 0xea, 0x4b, 0x31, 0xc6, 0x70, 0xec, 0x20, 0x77, 0xfe, 0xec, 0x72,
+		0xa1, 0xbc, 0xfb, 0x24, 0x39, 0x24, 0xae, 0x35, 0x73, 0xe2, 0x0a, 0xb5,
+		0x9f, 0x11, 0x46, 0xa8, 0x7d, 0x88, 0x11, 0xa8, 0x2b, 0xd4, 0xfe, 0x58,
+		0xd1, 0x9d, 0xe2, 0x84, 0xbc, 0x57, 0xb2, 0x60, 0xe9, 0x57, 0x03, 0xb1,
+		0xd2, 0xf2, 0x81, 0x17, 0xcc, 0xc0, 0x46, 0xa8, 0xfd, 0x26, 0x41, 0x4a,
+		0xfb, 0xde, 0x0f, 0x37, 0xd7, 0xab, 0xbf, 0x5f, 0x43, 0xc9, 0x6b, 0x76,
+		0x8a, 0xc7, 0x4a, 0x33, 0x6b, 0x39, 0xc3, 0xda, 0xb8, 0x0c, 0x85, 0xda,
+		0x23, 0x07, 0x97, 0x42, 0x2e, 0xc3, 0xe2, 0x60, 0xc8, 0x5e, 0x0b, 0xff,
+		0x2f, 0x97, 0xee, 0x40, 0x3b, 0x52, 0x66, 0x5e, 0xc2, 0xc8, 0x8c, 0xde,
+		0xe4, 0x25, 0x59, 0x07, 0x05, 0x2a, 0x0a, 0x20, 0x1f, 0xa8, 0xc0, 0x8c,
+		0xf1, 0xd4, 0x83, 0x46, 0x16, 0x6d, 0xcd, 0x08, 0x9e, 0x62, 0xf1, 0x60,
+		0xed, 0xcf, 0x4b, 0xbc, 0x3f, 0x91, 0x49, 0x02, 0xce, 0xb6, 0xd7, 0xf9,
+		0xae, 0x45, 0xa8, 0xfd, 0xe1, 0xe6, 0x18, 0x44, 0x38, 0x26, 0x09, 0x1e,
+		0xca, 0xbf, 0x04, 0x39, 0x5e, 0x4d, 0x1a, 0x6e, 0x0c, 0x17, 0xd5, 0x5b,
+		0xa0, 0x45, 0x01, 0xdc, 0x62, 0x99, 0x0b, 0xf6, 0xf0, 0x91, 0x29, 0x26,
+		0x0a, 0x26, 0x72, 0xce, 0x8c, 0xc3, 0xa5, 0x5b, 0x01, 0x1b, 0x25, 0x54,
+		0xf3, 0x4d, 0xa8, 0xa6, 0x67, 0xf8, 0x66, 0x4e, 0x5c, 0x66, 0x6a, 0x2e,
+		0xec, 0xeb, 0x75, 0xf7, 0x3a, 0xbe, 0xea, 0x25, 0xdf, 0x7f, 0xaf, 0xe6,
+		0x5e, 0xfd, 0x7f, 0xab, 0x78, 0xb0, 0x7d, 0xa5, 0xde, 0x01, 0xcc, 0xeb,
+		0xda, 0x3f, 0x50, 0xeb, 0x3e, 0xa9, 0x41, 0x11, 0x07, 0xb9, 0xbc, 0xfe,
+		0x1d, 0xcb, 0xf1, 0xff, 0xaf, 0x73, 0xbf, 0xcd, 0x4d, 0x5b, 0x96, 0x7c,
+		0x8f, 0xe8, 0x9f, 0x48, 0x6a, 0x0d, 0x16, 0x35, 0xb5, 0x66, 0x4f, 0x9e,
+		0x47, 0xc4, 0xd6, 0xe4, 0x52, 0x94, 0xbc, 0x82, 0xf5, 0xd0, 0x87, 0x27,
+		0x27, 0xc4, 0x02, 0x48, 0xaf, 0xe0, 0xaf, 0xd3, 0x23, 0x1f, 0x79, 0x39,
+		0xd8, 0x8e, 0xde, 0x70, 0x58, 0x93, 0x87, 0x15, 0x3e, 0x10, 0xe3, 0x38,
+		0xb1, 0xd5, 0xac, 0xc9, 0x49, 0x60, 0xc2, 0x65, 0xc3, 0xfd, 0xda, 0xab,
+		0xb0, 0xf0, 0x7d, 0x8c, 0x70, 0x64, 0x25, 0x77, 0x87, 0x35, 0xec, 0xaf,
+		0x44, 0xc7, 0x54, 0x0f, 0x35, 0x47, 0xe4, 0xa1, 0x63, 0xce, 0xe1, 0x59,
+		0x93, 0x9f, 0x24, 0xd2, 0x0f, 0x6d, 0x61, 0x74, 0x70, 0x9c, 0x4d, 0xfd,
+		0xc0, 0xdd, 0x42, 0x6c, 0xa7, 0x98, 0xc9, 0x35, 0x57, 0xf6, 0x47, 0xcc,
+		0x26, 0xf4, 0xfa, 0x0e, 0xcd, 0xfa, 0xe1, 0x0b, 0x3a, 0xb3, 0xc2, 0x41,
+		0x6e, 0x3a, 0xc5, 0xae, 0x5d, 0xec, 0x03, 0xde, 0x05, 0x2e, 0xc1, 0x66,
+		0xc4, 0xb6, 0x41, 0x6c, 0x2f, 0x76, 0xfb, 0x74, 0x4b, 0xf7, 0xe3, 0x45,
+		0x0b, 0x50, 0xdd, 0xbc, 0x05, 0xe8, 0xb6, 0x2c, 0x67, 0x3d, 0x00, 0x85,
+		0xa1, 0x42, 0xd8, 0xe6, 0xdb, 0xb2, 0x1c, 0x13, 0x45, 0xf6, 0xdc, 0xa2,
+		0x68, 0x01, 0xc4, 0xdd, 0x49, 0x5e, 0x3b, 0x0f, 0x4e, 0x75, 0x6c, 0x1f,
+		0x61, 0x71, 0xac, 0x27, 0x6f, 0x70, 0x6a, 0x83, 0x2d, 0x03, 0x4a, 0x6a,
+		0x2c, 0xe0, 0xb1, 0xc4, 0x45, 0x05, 0xf1, 0x46, 0x71, 0x35, 0x68, 0x39,
+		0xa5, 0xa4, 0xef, 0x22, 0xdb, 0x9a, 0xe6, 0xf7, 0xb3, 0x2c, 0x9c, 0x34,
+		0x68, 0xf0, 0xd2, 0x2b, 0xbd, 0xc8, 0xc3, 0xc9, 0x10, 0xff, 0xbf, 0x7f,
+		0x06, 0xbc, 0xb3, 0x3a, 0x81, 0xde, 0xcd, 0x05, 0xf8, 0xae, 0xeb, 0x1d,
+		0x01, 0xef, 0x75, 0x06, 0xf4, 0xaa, 0x3b, 0x9f, 0x61, 0x0f, 0x1f, 0x0d,
+		0x46, 0xf4, 0xaa, 0x3b, 0x7f, 0x81, 0x5d, 0x75, 0xe7, 0x88, 0xbc, 0x41,
+		0x20, 0xaa, 0xcb, 0x72, 0xd9, 0x28, 0x5e, 0xb3, 0x9f, 0x59, 0x84, 0x3e,
+		0xc8, 0xa4, 0x8b, 0x7f, 0x71, 0x22, 0x38, 0x9f, 0x5c, 0x44, 0x4d, 0x27,
+		0x2c, 0xdd, 0x87, 0xfb, 0x27, 0x2e, 0x83, 0x53, 0x39, 0x87, 0x65, 0x03,
+		0x63, 0x58, 0x9f, 0xcd, 0x01, 0xdb, 0x0a, 0xaa, 0xed, 0x9c, 0x6f, 0x28,
+		0x9d, 0x65, 0x8b, 0xc2, 0x31, 0x55, 0x6c, 0x08, 0x28, 0x39, 0x8a, 0x19,
+		0x27, 0xa6, 0x80, 0x3f, 0xa2, 0xbf, 0xeb, 0x8f, 0xff, 0x98, 0x00, 0xf6,
+		0x75, 0x5f, 0x51, 0x41, 0xeb, 0xee, 0x3f, 0xec, 0xc4, 0x8d, 0xd9, 0xd1,
+		0xb8, 0xa0, 0x1a, 0xcf, 0x51, 0x6f, 0x70, 0xa4, 0x72, 0xaa, 0xdd, 0xe2,
+		0x6d, 0xf4, 0xe4, 0x01, 0x1c, 0xe6, 0xd3, 0x8e, 0x36, 0xf5, 0x88, 0x3f,
+		0x48, 0x47, 0xf8, 0xd3, 0x58, 0x01, 0xd5, 0xc1, 0x85, 0x2d, 0x78, 0x09,
+		0xc7, 0x68, 0xbf, 0x69, 0x1b, 0xca, 0x45, 0xff, 0x75, 0xc1, 0xbf, 0x1e,
+		0xf5, 0xdf, 0x5e, 0xfc, 0x87, 0x9b, 0x21, 0x1e, 0xd6, 0xcb, 0x2b, 0x1c,
+		0x79, 0x55, 0x9a, 0xbc, 0xaa, 0x87, 0x96, 0xb3, 0xee, 0x95, 0xfd, 0x73,
+		0x5a, 0xcb, 0x47, 0xa6, 0xe3, 0xc1, 0x5b, 0xaf, 0xb5, 0x06, 0x92, 0x56,
+		0x92, 0x4c, 0x16, 0x61, 0x78, 0x01, 0xf2, 0xf6, 0xfd, 0x9d, 0x7c, 0xb4,
+		0x99, 0x35, 0x33, 0xfc, 0xfd, 0x6a, 0xc2, 0xcf, 0x41, 0x6f, 0x0b, 0xce,
+		0xac, 0x39, 0xe9, 0xcc, 0x01, 0x50, 0xdd, 0x1c, 0x80, 0xea, 0x5e, 0xb7,
+		0x71, 0x0c, 0x99, 0x59, 0xa1, 0x74, 0xb0, 0x73, 0x93, 0x93, 0x77, 0xfa,
+		0x9c, 0xd6, 0xf5, 0x78, 0x5e, 0xdf, 0x92, 0x2d, 0x35, 0x3b, 0x44, 0xbd,
+		0xac, 0x5d, 0xf7, 0xab, 0xb8, 0x75, 0xb7, 0xac, 0xe5, 0x15, 0xa4, 0xf0,
+		0x6e, 0x55, 0xb0, 0x87, 0x95, 0x68, 0xeb, 0x1a, 0xfe, 0xf2, 0xee, 0xcf,
+		0xbf, 0xc0, 0xb7, 0x6f, 0x60, 0x75, 0xcb, 0xde, 0x02, 0x6a, 0x15, 0xbc,
+		0x2c, 0x61, 0xb9, 0x34, 0x3b, 0x7c, 0xff, 0xb0, 0xd4, 0xf6, 0xb3, 0xe4,
+		0x2e, 0x1a, 0xaf, 0x5a, 0xfe, 0x0b, 0x05, 0xb9, 0xf5, 0xb4, 0xbb, 0x83,
+		0x42, 0x0a, 0x76, 0x41, 0xfa, 0xf7, 0x6a, 0x5c, 0x7e, 0xfc, 0x10, 0x95,
+		0x09, 0xda, 0xb0, 0x2c, 0x73, 0x39, 0x65, 0x19, 0x92, 0x21, 0xcb, 0x42,
+		0x5a, 0x0d, 0xe5, 0x22, 0x4e, 0xa2, 0xff, 0x0e, 0x00, 0x50, 0x4b, 0x07,
+		0x08, 0x54, 0xbb, 0xce, 0x8d, 0xcd, 0x06, 0x00, 0x00, 0x68, 0x14, 0x00,
 		0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00,
 		0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x20, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c,
+		0x00, 0x00, 0x00, 0x1f, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c,
 		0x61, 0x75, 0x64, 0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x70,
-		0x6f, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x73, 0x65,
-		0x2e, 0x70, 0x79, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
-		0xac, 0x58, 0xdd, 0x8f, 0xdb, 0xb8, 0x11, 0x7f, 0xf7, 0x5f, 0x31, 0x61,
-		0x81, 0x42, 0x02, 0x6c, 0x79, 0xaf, 0xfb, 0x52, 0x6c, 0xe0, 0xa0, 0x41,
-		0xb2, 0xb8, 0x4b, 0x7b, 0xdd, 0x04, 0xb7, 0xee, 0x53, 0xb0, 0x90, 0x69,
-		0x89, 0x92, 0x19, 0x4b, 0x24, 0x4b, 0x52, 0xbb, 0x56, 0x93, 0xfd, 0xdf,
-		0x0b, 0x7e, 0xe8, 0x83, 0x96, 0xbc, 0x7b, 0x2d, 0x4e, 0x2f, 0x36, 0x87,
-		0xf3, 0xcd, 0x1f, 0x67, 0x46, 0xfa, 0xd3, 0x9b, 0x75, 0xa3, 0xe4, 0x7a,
-		0x4f, 0xd9, 0x9a, 0xb0, 0x47, 0x10, 0xad, 0x3e, 0x70, 0x76, 0xbd, 0xa0,
-		0xb5, 0xe0, 0x52, 0xc3, 0x37, 0xc5, 0x59, 0xf7, 0x5f, 0x1d, 0x1a, 0x4d,
-		0xab, 0x7e, 0xd5, 0xec, 0x85, 0xe4, 0x19, 0x51, 0xaa, 0xa7, 0xb4, 0x6a,
-		0x51, 0x48, 0x5e, 0x83, 0xc0, 0xfa, 0x50, 0xd1, 0x3d, 0x78, 0xfa, 0x17,
-		0xac, 0x0f, 0x8b, 0xc5, 0x2d, 0x6c, 0xac, 0xb6, 0xa4, 0xe2, 0x38, 0x8f,
-		0x54, 0xab, 0x12, 0xa5, 0x73, 0xca, 0xe2, 0xc5, 0xf6, 0xf3, 0xe7, 0x5f,
-		0xd3, 0x4f, 0x77, 0x5f, 0xfe, 0xb5, 0x85, 0x0d, 0xdc, 0x26, 0x25, 0xd1,
-		0x11, 0xd2, 0x9c, 0x57, 0x29, 0x65, 0xa2, 0xd1, 0x28, 0x06, 0x2e, 0xe1,
-		0xfb, 0xf3, 0x62, 0xfb, 0xfe, 0xb7, 0x9f, 0x6f, 0x0d, 0xcb, 0xc0, 0xef,
-		0x78, 0x0b, 0x5a, 0x91, 0xd4, 0x98, 0x74, 0xac, 0x08, 0x2d, 0x16, 0xf7,
-		0xdb, 0xf7, 0xdb, 0xdb, 0xf4, 0xe3, 0xa7, 0xdf, 0x60, 0x63, 0xad, 0x47,
-		0x28, 0xc9, 0x2a, 0xdc, 0xe4, 0x04, 0xc5, 0xb0, 0x06, 0xa4, 0x88, 0x52,
-		0x94, 0x33, 0xb4, 0xf8, 0xe5, 0xd3, 0xdd, 0x36, 0xfd, 0xf0, 0xfe, 0xc3,
-		0x2f, 0xc6, 0xb9, 0x41, 0x68, 0x0d, 0xa8, 0xe0, 0xb2, 0xc6, 0x3a, 0x3d,
-		0x50, 0xa6, 0x55, 0x62, 0xdc, 0x46, 0x0b, 0xbb, 0x48, 0x95, 0xc6, 0x9a,
-		0xc0, 0x06, 0xee, 0x38, 0x23, 0x8b, 0xc5, 0x22, 0x27, 0x05, 0x58, 0x3a,
-		0x67, 0x19, 0x89, 0x8e, 0xa4, 0xbd, 0x01, 0xa5, 0xe5, 0x12, 0x6a, 0xa2,
-		0x14, 0x2e, 0x89, 0x5d, 0xc5, 0xb0, 0x7a, 0x67, 0xf9, 0x6f, 0x16, 0x00,
-		0x00, 0x65, 0xc5, 0xf7, 0xb8, 0x82, 0x91, 0x3a, 0x4b, 0xee, 0xcd, 0x27,
-		0xf5, 0x31, 0xa7, 0x32, 0x12, 0x58, 0x12, 0xa6, 0xd5, 0x66, 0x2b, 0x1b,
-		0xb2, 0x04, 0x72, 0xa2, 0x4a, 0xa7, 0xfc, 0x68, 0x97, 0xb1, 0x15, 0xa0,
-		0xc5, 0x58, 0x07, 0x50, 0x35, 0xb2, 0xe1, 0xf7, 0x87, 0xf8, 0x12, 0xab,
-		0x40, 0x45, 0xf1, 0xb0, 0x6f, 0x1e, 0x2d, 0xdb, 0x90, 0x60, 0x9e, 0x30,
-		0xd0, 0xfe, 0xcc, 0x54, 0x34, 0x52, 0x27, 0x09, 0xce, 0x53, 0x4d, 0x4e,
-		0x3a, 0x22, 0x2c, 0xe3, 0x39, 0x65, 0xe5, 0x06, 0x35, 0xba, 0x58, 0xfd,
-		0x15, 0xc5, 0x71, 0xa0, 0x90, 0x9c, 0x32, 0x22, 0x34, 0xdc, 0xda, 0x1f,
-		0xca, 0xd9, 0x6b, 0xe6, 0xbe, 0x3f, 0xf7, 0x0c, 0xa4, 0x52, 0x24, 0x64,
-		0x9f, 0x65, 0x0d, 0xf3, 0x60, 0x31, 0x71, 0x24, 0xed, 0x28, 0x50, 0x49,
-		0x74, 0x23, 0xd9, 0xe2, 0x4c, 0xc1, 0xd7, 0x23, 0x69, 0x1f, 0x0c, 0x9c,
-		0x64, 0xe3, 0x0e, 0x20, 0xc8, 0xc5, 0x28, 0xd4, 0x27, 0x49, 0x35, 0x71,
-		0xb1, 0xda, 0x5c, 0xe4, 0x4d, 0x2d, 0x54, 0x34, 0xd2, 0x14, 0x2f, 0x61,
-		0x92, 0x04, 0xab, 0xe8, 0x72, 0xec, 0x02, 0x2b, 0x65, 0x17, 0x42, 0x52,
-		0x16, 0xe8, 0xfd, 0x8e, 0x54, 0xab, 0x34, 0xa9, 0xff, 0xe9, 0x00, 0x84,
-		0x6e, 0x3a, 0x28, 0x3d, 0xc7, 0xb1, 0xc7, 0x9b, 0x6c, 0x58, 0x94, 0xd5,
-		0xf9, 0x12, 0xb2, 0xa7, 0x7c, 0x63, 0xce, 0xdc, 0xc7, 0x2a, 0x89, 0x6a,
-		0x2a, 0x0d, 0x9b, 0xd1, 0xd5, 0x4c, 0x0c, 0x6f, 0x6f, 0xd5, 0x08, 0x0d,
-		0x8b, 0xa7, 0x7c, 0x93, 0x3d, 0x8d, 0x08, 0x26, 0x42, 0x87, 0xb6, 0x81,
-		0x07, 0x0b, 0xdd, 0x48, 0x92, 0xf2, 0x46, 0x8b, 0x66, 0xbc, 0xd9, 0x23,
-		0xd0, 0xd9, 0x34, 0x57, 0x99, 0x37, 0x7a, 0x88, 0xcf, 0x5f, 0x6f, 0xde,
-		0x68, 0x97, 0xbe, 0x28, 0xe0, 0x9b, 0x91, 0x26, 0x52, 0x4e, 0xa4, 0x89,
-		0x94, 0x13, 0x69, 0x22, 0x65, 0xec, 0x83, 0x35, 0x87, 0xea, 0x35, 0xf8,
-		0xc4, 0x14, 0x94, 0xe5, 0x69, 0x23, 0x22, 0xa5, 0xb1, 0xd4, 0x37, 0xf6,
-		0xe6, 0x2f, 0x41, 0x63, 0x59, 0x12, 0xed, 0x2e, 0xa2, 0x33, 0x91, 0x35,
-		0xd2, 0x24, 0xc9, 0x30, 0xd9, 0x75, 0xc1, 0x25, 0xa4, 0x40, 0x19, 0x48,
-		0xcc, 0x4a, 0x12, 0xfd, 0x74, 0x95, 0x5e, 0x5d, 0x5d, 0x8d, 0xf0, 0x93,
-		0x61, 0x96, 0xd3, 0xdc, 0x61, 0xce, 0xc8, 0xae, 0xbd, 0xce, 0xf1, 0x4d,
-		0xeb, 0x79, 0x2e, 0x5c, 0x34, 0xef, 0x6e, 0xcf, 0x16, 0xc8, 0x36, 0x32,
-		0x71, 0xb7, 0x1d, 0x36, 0xd6, 0x42, 0x28, 0xba, 0x97, 0x04, 0x1f, 0x07,
-		0x67, 0xac, 0xf3, 0x83, 0xc8, 0x38, 0x19, 0xe3, 0x9a, 0x84, 0x59, 0x5e,
-		0x91, 0xb4, 0xe4, 0x91, 0xa9, 0x8c, 0x2e, 0x17, 0xde, 0xa5, 0x92, 0x9b,
-		0xe8, 0x6d, 0x2d, 0x4f, 0x9e, 0x0e, 0x34, 0x3b, 0x44, 0xa8, 0xe4, 0xa8,
-		0x3f, 0x13, 0xc6, 0x35, 0x94, 0x7c, 0x70, 0x61, 0xa8, 0x6e, 0x86, 0x6d,
-		0x09, 0xe8, 0x13, 0x53, 0x1a, 0x57, 0x15, 0xfc, 0xcc, 0x41, 0x73, 0x20,
-		0x0c, 0xef, 0x2b, 0x62, 0x94, 0x16, 0xb5, 0x5e, 0x97, 0x1c, 0xf6, 0x0d,
-		0xad, 0x72, 0xc8, 0x0e, 0x24, 0x3b, 0xaa, 0x04, 0xc5, 0x73, 0xb7, 0xb0,
-		0xe4, 0xae, 0x33, 0xa8, 0x19, 0x47, 0xfc, 0xce, 0xe0, 0x4f, 0x4f, 0x1a,
-		0x5f, 0x68, 0x0f, 0x74, 0x83, 0xee, 0xaf, 0x3d, 0xc3, 0x12, 0xd0, 0xea,
-		0x09, 0x2d, 0xcd, 0x49, 0xdb, 0xa8, 0xe3, 0x87, 0x78, 0x9c, 0x66, 0x0f,
-		0x22, 0xe7, 0x47, 0xc6, 0x73, 0x02, 0x6f, 0x36, 0x70, 0x15, 0xe6, 0x3a,
-		0x08, 0xd6, 0xab, 0x5d, 0x11, 0x29, 0xb9, 0x34, 0x91, 0x0f, 0x7e, 0x17,
-		0x98, 0x56, 0x24, 0x7f, 0x0b, 0x94, 0x29, 0x41, 0x32, 0x0d, 0xee, 0x82,
-		0x00, 0xde, 0xf3, 0x47, 0xd2, 0x05, 0x1d, 0x96, 0xaf, 0x41, 0x73, 0x60,
-		0x70, 0x14, 0xf1, 0x32, 0xdc, 0xf8, 0x6c, 0x4b, 0x06, 0xae, 0x6e, 0x8c,
-		0x11, 0x9b, 0xf0, 0xc1, 0x7c, 0xb4, 0x2b, 0xf9, 0x88, 0x5c, 0x61, 0x56,
-		0x26, 0x5c, 0x96, 0xeb, 0xd3, 0xda, 0x34, 0x4e, 0xb5, 0xce, 0xea, 0x7c,
-		0xdd, 0x73, 0xff, 0xad, 0xc2, 0x9a, 0x28, 0xbd, 0x8b, 0x93, 0x91, 0x89,
-		0xd1, 0xb9, 0xb8, 0x1c, 0x2e, 0x01, 0x15, 0xb5, 0x9e, 0xc9, 0x9e, 0x38,
-		0x96, 0x69, 0x4e, 0x0d, 0xe8, 0x0c, 0xb5, 0x03, 0x2a, 0x2d, 0x82, 0xe5,
-		0x9b, 0xae, 0xcf, 0xa2, 0xd8, 0xc6, 0xdd, 0x75, 0x5d, 0x9f, 0x8a, 0xc1,
-		0x86, 0x45, 0x07, 0x7a, 0x70, 0xb5, 0xcb, 0x9a, 0x72, 0xea, 0xfb, 0xe2,
-		0xe6, 0x81, 0xab, 0xd5, 0x14, 0xb8, 0x4c, 0x9c, 0x26, 0x80, 0x61, 0xe2,
-		0x14, 0x42, 0x97, 0x89, 0xd3, 0x2c, 0x76, 0x0d, 0xe3, 0x08, 0xbc, 0x77,
-		0x3c, 0x27, 0xc9, 0x37, 0x05, 0x91, 0x90, 0xfc, 0x91, 0xe6, 0x44, 0xc1,
-		0x8e, 0x89, 0xd3, 0x2e, 0x36, 0x90, 0x76, 0xbd, 0x1f, 0xb6, 0xf7, 0xeb,
-		0xbf, 0xdf, 0x83, 0x99, 0x2e, 0x2e, 0xe1, 0x58, 0x48, 0xa2, 0x35, 0x25,
-		0xb2, 0x43, 0x22, 0x13, 0x27, 0x83, 0xc1, 0x15, 0xe3, 0x2b, 0x7f, 0x38,
-		0xc6, 0x64, 0xc7, 0x85, 0xec, 0x9e, 0x2d, 0x68, 0x33, 0x69, 0xa6, 0x05,
-		0x0c, 0xc8, 0xe8, 0x44, 0xce, 0xc1, 0xda, 0x33, 0x60, 0x96, 0x03, 0xfa,
-		0x80, 0x99, 0x89, 0xd8, 0x54, 0x3d, 0xa8, 0x79, 0xde, 0x54, 0x04, 0x99,
-		0x2a, 0x16, 0xf5, 0xd2, 0xae, 0x5e, 0xba, 0xa1, 0x28, 0x0e, 0x65, 0x7b,
-		0xa7, 0x5e, 0x93, 0x88, 0x5f, 0x87, 0xf0, 0x10, 0x60, 0x48, 0xff, 0xd2,
-		0xa5, 0x87, 0x2a, 0xa8, 0xa9, 0x52, 0x94, 0x95, 0x6f, 0x01, 0xe7, 0x39,
-		0x50, 0x6d, 0xd2, 0x9c, 0x93, 0xc7, 0x8f, 0x44, 0x10, 0x96, 0x13, 0x96,
-		0x51, 0xa2, 0xac, 0x5f, 0xb2, 0x61, 0xb0, 0x13, 0x4c, 0xd4, 0x3f, 0x98,
-		0xa8, 0x3b, 0x84, 0xef, 0xa6, 0xc0, 0x25, 0xaa, 0xa2, 0x4c, 0xbf, 0x9c,
-		0x77, 0xc7, 0xe3, 0xb2, 0x5e, 0xd0, 0xd3, 0x6b, 0x39, 0x77, 0xec, 0xff,
-		0x5f, 0xc6, 0xbd, 0xec, 0x0b, 0xf9, 0xf6, 0xce, 0xbc, 0xcc, 0xfd, 0x3b,
-		0x72, 0xdd, 0x05, 0x15, 0x52, 0x6f, 0xef, 0x7f, 0x35, 0xe9, 0xf8, 0xe3,
-		0xf3, 0xdc, 0x5d, 0x73, 0xd5, 0x14, 0x05, 0x3d, 0x19, 0xef, 0xbf, 0xa3,
-		0xc4, 0xd4, 0x2a, 0x30, 0x3f, 0x27, 0xf4, 0x3c, 0x78, 0xac, 0x55, 0xc6,
-		0x59, 0x41, 0x4b, 0xd8, 0xf4, 0x7d, 0x78, 0x54, 0x21, 0x96, 0x80, 0x3a,
-		0x06, 0x37, 0x4e, 0x07, 0xa5, 0xb9, 0xdb, 0x3a, 0x1b, 0x4e, 0x55, 0xe6,
-		0x4f, 0x38, 0x20, 0x9b, 0xe7, 0xc2, 0x91, 0x6b, 0x95, 0x21, 0x4f, 0xbe,
-		0xad, 0xa9, 0x3b, 0x7b, 0xe1, 0x0f, 0xbe, 0x37, 0xef, 0x1c, 0x8a, 0x1f,
-		0x96, 0x13, 0xad, 0x5d, 0x4d, 0x3a, 0x67, 0x0d, 0x39, 0xc3, 0xf9, 0x36,
-		0xc0, 0x50, 0xf7, 0x68, 0x95, 0x5d, 0x04, 0x52, 0xf7, 0xbc, 0x08, 0x28,
-		0xa3, 0x60, 0x1e, 0x4d, 0x81, 0xb8, 0x6e, 0x05, 0x51, 0x99, 0xa4, 0x42,
-		0xff, 0x1e, 0xb1, 0x78, 0x3a, 0x7b, 0x5f, 0x80, 0x59, 0xf7, 0xb8, 0x84,
-		0xce, 0x6f, 0x6d, 0x5b, 0x41, 0xee, 0xad, 0xed, 0x00, 0x77, 0x5d, 0x57,
-		0xda, 0x0d, 0xbe, 0xed, 0x8c, 0x6f, 0x67, 0x28, 0x4c, 0x66, 0xd4, 0x9e,
-		0xb5, 0x00, 0xd1, 0x4e, 0x5b, 0x80, 0x6c, 0x8a, 0x62, 0xd2, 0x03, 0x0c,
-		0x71, 0x68, 0x02, 0x66, 0x35, 0x1a, 0x15, 0x4c, 0x7d, 0x30, 0xa4, 0x25,
-		0x20, 0x3b, 0x93, 0xbc, 0x54, 0x0f, 0x2e, 0x75, 0x6c, 0x67, 0x61, 0x39,
-		0xd7, 0x93, 0x77, 0x66, 0x6b, 0x67, 0x47, 0xc8, 0x02, 0x2b, 0x0d, 0xe6,
-		0x76, 0x52, 0x56, 0x42, 0xb4, 0x13, 0x54, 0xf4, 0x5c, 0x96, 0x29, 0xee,
-		0xba, 0xc8, 0xbe, 0xc2, 0xd9, 0x71, 0x12, 0x85, 0xa5, 0x0e, 0x61, 0xd8,
-		0xe5, 0x59, 0x1c, 0x96, 0x66, 0xfc, 0xff, 0xf7, 0xff, 0xe2, 0xbc, 0x53,
-		0x3c, 0xef, 0xbd, 0xdd, 0xf3, 0xee, 0xdb, 0xae, 0x37, 0xe3, 0xbc, 0xe3,
-		0xe9, 0xbd, 0x17, 0xed, 0xf5, 0xc4, 0x77, 0xff, 0xd1, 0x60, 0xf0, 0x5e,
-		0xb4, 0xd7, 0x67, 0xbe, 0x8b, 0xf6, 0xda, 0x78, 0x5e, 0xdb, 0x8e, 0xd8,
-		0xa6, 0x19, 0xaf, 0x05, 0xad, 0xe6, 0x1a, 0xe1, 0xc5, 0x38, 0x3a, 0x23,
-		0xa3, 0x2e, 0xfe, 0xc5, 0x92, 0xe0, 0x7a, 0x34, 0x88, 0xaa, 0x96, 0x69,
-		0x7c, 0xf2, 0xf3, 0xa7, 0x89, 0xc4, 0x49, 0xc1, 0xaa, 0x86, 0xc1, 0xac,
-		0x8b, 0x26, 0x40, 0x5b, 0x8e, 0xa5, 0x9e, 0xe2, 0xcd, 0x50, 0x27, 0xd1,
-		0x1a, 0x62, 0x38, 0x74, 0x18, 0xca, 0xac, 0xcf, 0x96, 0x75, 0xe4, 0xf0,
-		0x47, 0xa3, 0xef, 0xfe, 0xe3, 0x3f, 0x46, 0x0e, 0xbb, 0xbc, 0xaf, 0x31,
-		0xc3, 0x55, 0xfb, 0x1f, 0x72, 0x61, 0xd2, 0xb0, 0x29, 0x34, 0xca, 0x96,
-		0xdd, 0xa7, 0x89, 0xb9, 0x49, 0xad, 0xd9, 0x9b, 0x69, 0xf4, 0x62, 0x01,
-		0xf6, 0xfb, 0x49, 0x8b, 0xeb, 0x6a, 0x74, 0x54, 0x8e, 0x7a, 0x76, 0x5c,
-		0xde, 0x96, 0xf7, 0x2a, 0x18, 0xd8, 0xbc, 0x16, 0x5f, 0x1b, 0xbb, 0x34,
-		0xd6, 0x98, 0xb2, 0xee, 0xa5, 0xc7, 0xbd, 0x1e, 0x75, 0xdf, 0x5e, 0xdc,
-		0x87, 0x9b, 0x20, 0x5f, 0x8e, 0x61, 0xe6, 0x55, 0x69, 0x14, 0xb2, 0x6f,
-		0x39, 0x9b, 0x8e, 0xd9, 0xad, 0x93, 0x8a, 0x3f, 0x11, 0x19, 0xf5, 0xda,
-		0x3a, 0xae, 0x0d, 0xa0, 0xa4, 0xe4, 0x68, 0x74, 0x08, 0xfd, 0x0b, 0x90,
-		0x93, 0xef, 0xc0, 0x35, 0xc8, 0x4c, 0x9a, 0x99, 0xf9, 0xfd, 0xa6, 0xfc,
-		0x4f, 0xd0, 0xdb, 0x86, 0xa1, 0xf4, 0x92, 0x32, 0xeb, 0x80, 0x68, 0xa7,
-		0x0e, 0x88, 0xf6, 0x65, 0x19, 0x8b, 0x90, 0x89, 0x94, 0x45, 0x63, 0x27,
-		0xe7, 0xd3, 0xd1, 0xbf, 0xd3, 0x67, 0xb8, 0xaa, 0x86, 0x7a, 0xfd, 0x15,
-		0xed, 0xb1, 0x3a, 0xd8, 0xb2, 0x56, 0xd9, 0xee, 0x57, 0x52, 0x6d, 0xbb,
-		0xff, 0xea, 0x0e, 0x12, 0x78, 0xb7, 0xce, 0xc9, 0xe3, 0x9a, 0x35, 0x55,
-		0x05, 0x7f, 0x79, 0xf7, 0xe7, 0x9f, 0xe0, 0xc7, 0x0f, 0xd0, 0xb2, 0x21,
-		0x6f, 0xc1, 0x70, 0xe5, 0xb4, 0x28, 0x60, 0xb5, 0x52, 0x07, 0xf3, 0x9a,
-		0xa0, 0xb1, 0xee, 0x76, 0xd1, 0x83, 0xd5, 0xee, 0x51, 0x65, 0xbf, 0x50,
-		0xa0, 0xaf, 0x0e, 0x76, 0x0f, 0x90, 0x73, 0x46, 0x6e, 0x50, 0xf7, 0x5e,
-		0x6d, 0x8e, 0x9f, 0x16, 0x90, 0xa6, 0x0c, 0xd7, 0x24, 0x4d, 0x6d, 0x4c,
-		0x69, 0x6a, 0xc0, 0x90, 0xa6, 0x3e, 0x2c, 0x87, 0x8c, 0xc5, 0x7f, 0x03,
-		0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0x54, 0xbb, 0xce, 0x8d,
-		0xac, 0x06, 0x00, 0x00, 0x68, 0x14, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04,
-		0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1f, 0x00,
-		0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f,
-		0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x70, 0x72, 0x65, 0x5f, 0x74, 0x6f,
-		0x6f, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x2e, 0x70, 0x79, 0x55, 0x54, 0x05,
-		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0xc4, 0x56, 0xdd, 0x6e, 0xdb, 0xca,
-		0x11, 0xbe, 0xd7, 0x53, 0xcc, 0xd9, 0x73, 0x43, 0x1e, 0x48, 0x54, 0x7b,
-		0x9a, 0x00, 0xa9, 0x00, 0xa5, 0x70, 0x10, 0xb5, 0x30, 0xea, 0xd8, 0x81,
-		0xe5, 0x34, 0x17, 0x71, 0x2a, 0xac, 0xc8, 0xa1, 0xb8, 0x31, 0xb9, 0x4b,
-		0xec, 0x0c, 0x25, 0x0b, 0xae, 0x1f, 0xa0, 0xe8, 0x2b, 0xf4, 0xb2, 0x4f,
-		0x96, 0x27, 0x29, 0x66, 0x97, 0x92, 0x25, 0xdb, 0x41, 0x13, 0xa0, 0xc0,
-		0xd1, 0x85, 0xb8, 0xf3, 0xb3, 0xdf, 0xfc, 0x0f, 0xf9, 0xf3, 0x4f, 0xe3,
-		0x8e, 0xfc, 0x78, 0x69, 0xec, 0x18, 0xed, 0x1a, 0xda, 0x2d, 0x57, 0xce,
-		0xfe, 0x61, 0x60, 0x9a, 0xd6, 0x79, 0x86, 0x2f, 0xe4, 0xec, 0x10, 0x68,
-		0x4b, 0x43, 0x70, 0x34, 0x04, 0xaa, 0x6a, 0xbc, 0x1d, 0x82, 0xc7, 0x41,
-		0xe9, 0x5d, 0x03, 0x85, 0x66, 0x64, 0xd3, 0x20, 0xf4, 0xda, 0x3b, 0x7a,
-		0x08, 0xf2, 0x5f, 0x60, 0xcd, 0x7a, 0x30, 0x98, 0xc1, 0x34, 0xc0, 0x64,
-		0xb5, 0xd3, 0x45, 0x42, 0x5b, 0xca, 0x88, 0x0b, 0x63, 0xd3, 0x01, 0x3b,
-		0x57, 0xc3, 0x14, 0x66, 0xd9, 0x0a, 0x39, 0x51, 0x42, 0x2d, 0xac, 0x6e,
-		0x50, 0xa5, 0xe0, 0x3c, 0x28, 0x35, 0x60, 0xf3, 0x48, 0x6a, 0x6c, 0xdb,
-		0x71, 0x14, 0xdf, 0xdd, 0x0f, 0xf2, 0xa6, 0x80, 0x29, 0xb0, 0x89, 0x0a,
-		0xb9, 0x6b, 0x1a, 0x6d, 0x0b, 0x35, 0x04, 0xa5, 0xd2, 0x41, 0xab, 0xb9,
-		0x3a, 0x10, 0x96, 0xa6, 0xc6, 0x85, 0xf0, 0xf6, 0xd8, 0x83, 0xf9, 0xec,
-		0x7c, 0x7e, 0x7a, 0x75, 0xfa, 0xb7, 0xd9, 0xe2, 0xfc, 0xe4, 0xdd, 0x6c,
-		0x0e, 0x53, 0xb8, 0x1b, 0x00, 0x00, 0xa8, 0x0c, 0xed, 0x5a, 0x0d, 0x1f,
-		0xce, 0x59, 0xed, 0x72, 0x5d, 0x1f, 0x71, 0x5a, 0xef, 0x8a, 0x2e, 0x67,
-		0xe3, 0xec, 0x11, 0xbb, 0xc0, 0x35, 0xd6, 0xae, 0x6d, 0xd0, 0xf2, 0x8e,
-		0x6f, 0x8a, 0x85, 0x27, 0x7d, 0x40, 0x61, 0xf1, 0xeb, 0xcb, 0x97, 0xbf,
-		0xff, 0xe3, 0x8e, 0x73, 0x63, 0xdd, 0xc6, 0x2e, 0x2a, 0x47, 0x4c, 0x3b,
-		0x16, 0xa1, 0x5f, 0x9b, 0x1c, 0x4f, 0xf2, 0xdc, 0x75, 0x96, 0xff, 0x8a,
-		0xdb, 0x4c, 0x92, 0xb7, 0x93, 0xfe, 0xc5, 0xb9, 0x55, 0x8d, 0xf3, 0xa8,
-		0x33, 0x3a, 0xb5, 0xa5, 0xcb, 0xda, 0xda, 0xd0, 0xde, 0xe0, 0x2a, 0xc8,
-		0x47, 0x3d, 0x08, 0xed, 0xee, 0xde, 0x1f, 0xc4, 0xfb, 0xf6, 0xf4, 0x72,
-		0x31, 0xff, 0xf0, 0x66, 0x7e, 0x75, 0x09, 0x53, 0x48, 0xd4, 0x38, 0x5b,
-		0x19, 0x1e, 0x4b, 0xde, 0xc6, 0xb9, 0xb3, 0xa5, 0x59, 0x8d, 0x09, 0x73,
-		0x8f, 0x4c, 0x63, 0x95, 0x0e, 0x06, 0x83, 0x02, 0x4b, 0xa8, 0x34, 0x2d,
-		0x4a, 0x8f, 0x54, 0x2d, 0xda, 0x5a, 0xdb, 0xa4, 0x72, 0x9d, 0xa7, 0x09,
-		0x18, 0xcb, 0x30, 0x85, 0x17, 0xaf, 0x52, 0x18, 0xbd, 0x86, 0xa5, 0x73,
-		0xf5, 0x24, 0xb8, 0x40, 0x2d, 0xe6, 0xb4, 0x28, 0x8c, 0x87, 0x29, 0x38,
-		0xca, 0x24, 0xeb, 0xd9, 0x17, 0x67, 0x6c, 0xa2, 0x0a, 0x97, 0x93, 0xd8,
-		0x09, 0x1a, 0x2a, 0x0d, 0xda, 0xa6, 0x04, 0xeb, 0x78, 0xaf, 0x68, 0xa8,
-		0x30, 0x3e, 0xd9, 0x43, 0xa4, 0x11, 0x52, 0x7e, 0x1e, 0xb9, 0xf3, 0x16,
-		0xfe, 0xac, 0x6b, 0xc2, 0xc0, 0xcc, 0x3b, 0x76, 0x65, 0x09, 0xd3, 0x7d,
-		0xd3, 0x65, 0x1d, 0xe7, 0xd6, 0x6d, 0x92, 0x14, 0x46, 0x0f, 0xed, 0x17,
-		0x9d, 0x9d, 0x86, 0xff, 0x68, 0xb1, 0x74, 0x1e, 0xa4, 0xcd, 0xc0, 0x58,
-		0x31, 0x2b, 0xc9, 0xfb, 0x96, 0xcd, 0xde, 0x39, 0xd1, 0xce, 0xd0, 0x16,
-		0xb4, 0x31, 0x5c, 0x25, 0x6a, 0x24, 0x49, 0xc8, 0x9a, 0x42, 0x1d, 0x68,
-		0x06, 0x87, 0x9c, 0x65, 0x63, 0x3b, 0xdc, 0x33, 0xd9, 0x6f, 0x8f, 0x35,
-		0x9a, 0x30, 0x2b, 0x0f, 0x69, 0x59, 0x21, 0x07, 0x56, 0x72, 0x94, 0xa7,
-		0xbd, 0x27, 0xc3, 0x60, 0x39, 0x4d, 0xf7, 0x18, 0x78, 0x9b, 0x63, 0xcb,
-		0x70, 0x31, 0x9f, 0x79, 0xef, 0xfc, 0xff, 0xb0, 0x6e, 0xca, 0xa3, 0xcc,
-		0xc8, 0xbc, 0xca, 0x99, 0x58, 0x37, 0x6d, 0x12, 0x8e, 0x29, 0xbc, 0x9e,
-		0xf6, 0x69, 0x3c, 0xc6, 0xea, 0x73, 0x7d, 0xe5, 0x7b, 0xb8, 0xa3, 0xdc,
-		0xc7, 0x96, 0xd0, 0x74, 0x93, 0x78, 0xd4, 0xe4, 0xec, 0x04, 0x88, 0x7d,
-		0xe8, 0x81, 0x73, 0x67, 0x31, 0x02, 0xb5, 0xde, 0x58, 0x4e, 0xf6, 0x98,
-		0x61, 0xfa, 0x8b, 0xae, 0x69, 0x29, 0x39, 0xb2, 0x73, 0x77, 0x44, 0xc9,
-		0x4f, 0x55, 0xce, 0xdd, 0xcc, 0x5b, 0xcc, 0x4d, 0x69, 0xf2, 0x8b, 0x8e,
-		0x65, 0xde, 0x27, 0xcf, 0xe8, 0xed, 0x75, 0x67, 0x6b, 0xb4, 0x7c, 0x2e,
-		0x5b, 0x63, 0x02, 0xea, 0xbd, 0xc7, 0x2b, 0xe7, 0xea, 0x0f, 0x84, 0xfd,
-		0x38, 0x3c, 0xb9, 0xd2, 0xa2, 0x6f, 0x0c, 0x91, 0x71, 0xf6, 0x2d, 0xe6,
-		0x46, 0x9e, 0x72, 0x4f, 0xd3, 0xcd, 0xf7, 0x5f, 0xb8, 0x0c, 0x51, 0xab,
-		0x09, 0xc4, 0xf0, 0x9f, 0xde, 0xbb, 0x1f, 0x3c, 0x4f, 0xc5, 0x3a, 0xc6,
-		0x7f, 0xd9, 0x82, 0x78, 0x6b, 0x38, 0xf9, 0xdd, 0x6e, 0xc6, 0x0a, 0xb4,
-		0xdb, 0xa4, 0x41, 0x22, 0xbd, 0xc2, 0x6f, 0xa7, 0xb4, 0x57, 0x18, 0x82,
-		0x6c, 0xb4, 0x69, 0xbf, 0x4b, 0xd1, 0xfb, 0x47, 0xa8, 0xbf, 0x0a, 0xaa,
-		0x29, 0x21, 0x6e, 0xd8, 0x29, 0xa8, 0x4b, 0xd4, 0x85, 0x8a, 0x40, 0x4b,
-		0x4d, 0x87, 0x3d, 0x28, 0xa4, 0xb4, 0x59, 0x22, 0xd4, 0x7e, 0x26, 0x83,
-		0x92, 0xb1, 0xf0, 0x78, 0x4b, 0x3a, 0x0f, 0xda, 0x6e, 0x13, 0xea, 0x96,
-		0x22, 0x0d, 0x5b, 0x56, 0xe6, 0xa9, 0xa7, 0x9f, 0xdb, 0x31, 0x07, 0x43,
-		0x22, 0x3d, 0x53, 0x06, 0x57, 0xc0, 0x95, 0x40, 0x68, 0xc9, 0xb0, 0x59,
-		0x63, 0x80, 0x99, 0xc0, 0x9d, 0x3c, 0xee, 0x65, 0xe7, 0xfc, 0x0c, 0x27,
-		0xf5, 0x46, 0x6f, 0x09, 0x74, 0x5d, 0xbb, 0x0d, 0xc8, 0xda, 0x18, 0x67,
-		0x79, 0xad, 0xbb, 0x02, 0x01, 0x0b, 0xc3, 0xb4, 0x0f, 0xcd, 0x58, 0x48,
-		0xd4, 0xac, 0x30, 0x2c, 0x6b, 0xe5, 0xa3, 0x37, 0x8c, 0x72, 0x78, 0xd7,
-		0xd5, 0x6c, 0x02, 0x37, 0x05, 0x6d, 0x0b, 0x88, 0x4d, 0x17, 0xa2, 0x25,
-		0xd6, 0x9e, 0xfb, 0x39, 0x0e, 0xb8, 0xf1, 0x85, 0xf0, 0x44, 0xd6, 0x9b,
-		0x93, 0x15, 0xd8, 0x07, 0x70, 0x54, 0xb1, 0x1f, 0xb3, 0x2f, 0x3b, 0xe4,
-		0xff, 0xe2, 0x43, 0xbf, 0x8f, 0x1e, 0xad, 0xe3, 0x47, 0x19, 0x56, 0xe7,
-		0x0e, 0x3c, 0xe6, 0x68, 0x19, 0x44, 0x2c, 0x3e, 0x06, 0x2b, 0x61, 0xad,
-		0x8c, 0x7f, 0xd9, 0x6d, 0x2f, 0x48, 0xbe, 0xfe, 0xf3, 0x3f, 0x2f, 0x5e,
-		0x55, 0x69, 0x06, 0xef, 0xbd, 0xcb, 0x11, 0x0b, 0x70, 0xb6, 0xde, 0x8a,
-		0x0d, 0xae, 0x0c, 0x81, 0x21, 0xd0, 0xc0, 0xde, 0xac, 0x8d, 0xae, 0xa1,
-		0x34, 0xb7, 0x99, 0xfa, 0x91, 0xb8, 0x7f, 0xeb, 0x56, 0x0b, 0xd3, 0x54,
-		0xaa, 0xaf, 0xff, 0xfe, 0x17, 0x2c, 0x6b, 0x97, 0xdf, 0x60, 0x01, 0x1b,
-		0x71, 0x13, 0xd8, 0x3d, 0x34, 0xde, 0x61, 0xcf, 0xf5, 0x91, 0x1d, 0x15,
-		0xe0, 0x8d, 0xa6, 0x2a, 0xe9, 0x6b, 0xe8, 0x31, 0x23, 0xd4, 0x3e, 0xaf,
-		0x12, 0xaf, 0xae, 0x97, 0xbe, 0xb9, 0x5e, 0xaa, 0x21, 0xe4, 0x4d, 0xf1,
-		0x58, 0x1a, 0xf7, 0xa5, 0xba, 0x1e, 0x25, 0x7f, 0x9a, 0x7c, 0xfa, 0xfb,
-		0x35, 0x7d, 0xfe, 0xc5, 0xc7, 0x47, 0xf9, 0x8f, 0xfe, 0xd9, 0x73, 0xd3,
-		0x78, 0x7f, 0x57, 0xda, 0x56, 0xcc, 0xc2, 0x34, 0x7e, 0x60, 0x65, 0xd4,
-		0xd6, 0x86, 0x13, 0x81, 0x0f, 0x42, 0xd6, 0x7e, 0x85, 0x41, 0xfc, 0xa9,
-		0x0d, 0x49, 0x68, 0x63, 0x4a, 0xe4, 0x4a, 0xdf, 0x13, 0xed, 0x91, 0xe7,
-		0xa3, 0xde, 0xeb, 0x16, 0x7e, 0x9a, 0x82, 0xf2, 0x8d, 0xfa, 0x7c, 0xd8,
-		0x3f, 0x3b, 0x38, 0x79, 0x11, 0x3a, 0x96, 0x29, 0x4b, 0xf8, 0xe8, 0x3a,
-		0x7b, 0xc4, 0xd0, 0x96, 0x82, 0xa1, 0xb2, 0x4c, 0x05, 0x3d, 0x63, 0x81,
-		0x83, 0xf5, 0x78, 0x8c, 0x20, 0x8f, 0x73, 0x1e, 0x52, 0x5e, 0x20, 0xb1,
-		0x97, 0x0f, 0xa4, 0x35, 0x82, 0x6f, 0xf6, 0x15, 0x48, 0xbc, 0xf0, 0x4d,
-		0xce, 0x52, 0x85, 0x68, 0x43, 0x7c, 0xe8, 0x08, 0x61, 0x65, 0x18, 0x36,
-		0xce, 0xdf, 0x08, 0x17, 0x3c, 0x36, 0x6e, 0x8d, 0xa9, 0x94, 0xa5, 0x76,
-		0x1b, 0x94, 0x0f, 0x89, 0xbc, 0x29, 0xb2, 0x70, 0x4e, 0x52, 0x29, 0x95,
-		0x34, 0xc6, 0xad, 0x78, 0x11, 0xe5, 0xe2, 0x54, 0x20, 0x3f, 0x29, 0x01,
-		0x92, 0x4f, 0xc1, 0xd8, 0x9a, 0x42, 0xb1, 0x5e, 0xed, 0x8e, 0x6d, 0x47,
-		0x55, 0x38, 0x57, 0xd0, 0x7a, 0xc8, 0x3d, 0xea, 0xd8, 0xb8, 0x91, 0x6e,
-		0xd0, 0xaf, 0x50, 0x7d, 0xee, 0x23, 0x7a, 0x88, 0x26, 0xc2, 0xd1, 0x98,
-		0xf5, 0x8a, 0xc6, 0x02, 0x81, 0x34, 0x7e, 0x7f, 0x19, 0xd5, 0x09, 0xb4,
-		0x47, 0xa8, 0xba, 0x46, 0xdb, 0x91, 0x8c, 0x4f, 0x06, 0x1f, 0x08, 0x61,
-		0xdc, 0x7a, 0x6c, 0xb5, 0xc7, 0x51, 0xbc, 0xba, 0x1b, 0x9d, 0xd8, 0x1c,
-		0x12, 0xca, 0x61, 0xb2, 0x4b, 0x4d, 0x5c, 0x6b, 0x8b, 0xfd, 0x07, 0x91,
-		0xf3, 0x4f, 0x35, 0xea, 0x8e, 0x19, 0x3d, 0x2c, 0x3b, 0x53, 0x17, 0x60,
-		0x5a, 0xfd, 0xbd, 0xaa, 0xba, 0x6d, 0x97, 0x9d, 0x2d, 0x6a, 0xc1, 0x7e,
-		0x58, 0x45, 0xbb, 0x71, 0xc4, 0x5b, 0x43, 0x4c, 0x0f, 0x7b, 0x86, 0x30,
-		0xbc, 0xe7, 0xc6, 0x27, 0x67, 0x67, 0x17, 0x1f, 0x17, 0xef, 0x2e, 0xde,
-		0x9c, 0x9e, 0xcd, 0x16, 0x97, 0xb3, 0xb3, 0xd9, 0xc9, 0x7c, 0xa6, 0x9e,
-		0x64, 0xa5, 0x71, 0x4b, 0x53, 0x4b, 0xad, 0x6a, 0x94, 0x01, 0xde, 0xd7,
-		0x98, 0x90, 0xe1, 0x39, 0x84, 0x50, 0xce, 0xc3, 0x25, 0xfa, 0xdf, 0x00,
-		0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0x7c, 0x7e, 0xa0, 0xa9,
-		0x26, 0x05, 0x00, 0x00, 0x78, 0x0c, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04,
-		0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1d, 0x00,
-		0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f,
-		0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-		0x70, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x79, 0x55, 0x54, 0x05, 0x00, 0x01,
-		0x00, 0xa6, 0xce, 0x12, 0x2c, 0x90, 0x41, 0x8a, 0xdb, 0x40, 0x10, 0x45,
-		0xf7, 0x3a, 0xc5, 0x4f, 0x87, 0x80, 0x14, 0x14, 0x29, 0x90, 0x45, 0xc0,
-		0x90, 0x45, 0x30, 0xd9, 0x05, 0x3b, 0xe0, 0x45, 0xc8, 0xb2, 0xd3, 0x5d,
-		0x92, 0x7a, 0xac, 0xae, 0x6a, 0xba, 0x4b, 0x66, 0x3c, 0x83, 0x0f, 0x30,
-		0x07, 0x99, 0x8b, 0xcd, 0x49, 0x06, 0xd9, 0x5e, 0xd6, 0x87, 0xf7, 0xa8,
-		0xff, 0x3f, 0x7e, 0xe8, 0x97, 0x92, 0xfb, 0xff, 0x81, 0x7b, 0xe2, 0x13,
-		0xd2, 0x59, 0x27, 0xe1, 0x6f, 0x55, 0x88, 0x49, 0xb2, 0xe2, 0xa1, 0x08,
-		0xb7, 0x28, 0xe7, 0x52, 0x0d, 0xe2, 0x96, 0x82, 0x1f, 0xa8, 0x2b, 0xc0,
-		0xfc, 0x9d, 0x88, 0xe1, 0x24, 0x26, 0xeb, 0x34, 0xf0, 0xd8, 0xe2, 0x48,
-		0x94, 0xb0, 0xdf, 0xfd, 0xfe, 0xb7, 0x81, 0x4e, 0x04, 0xb7, 0xe4, 0x4c,
-		0xac, 0x48, 0xb3, 0x65, 0xd4, 0x5e, 0x5c, 0xe9, 0x4b, 0x22, 0x57, 0xfa,
-		0xcf, 0x5f, 0xd6, 0xa8, 0x8b, 0xbe, 0x69, 0x61, 0x56, 0xd3, 0x2a, 0x38,
-		0x11, 0x86, 0x30, 0x53, 0x69, 0x61, 0xd9, 0x5f, 0xf9, 0xd9, 0x2a, 0x15,
-		0xc5, 0x60, 0xc3, 0x1c, 0x78, 0xc4, 0xf5, 0x90, 0x45, 0xd3, 0xa2, 0xa8,
-		0xc3, 0x00, 0xcb, 0xe7, 0xa6, 0xbb, 0xf1, 0x87, 0x25, 0x46, 0x9b, 0xc3,
-		0x13, 0x41, 0x32, 0x7c, 0x96, 0x84, 0x59, 0x78, 0x84, 0x9b, 0xac, 0xb6,
-		0xf0, 0x92, 0xa3, 0x65, 0x85, 0x1d, 0x89, 0xf5, 0xae, 0x97, 0xd9, 0xc3,
-		0x87, 0x61, 0x28, 0x1d, 0x7e, 0x86, 0x88, 0xb7, 0x97, 0xd7, 0xef, 0x5f,
-		0x3f, 0x61, 0x22, 0xeb, 0xb3, 0x48, 0xec, 0x4c, 0xd5, 0x54, 0x29, 0x07,
-		0xd6, 0x7a, 0xad, 0xde, 0xf9, 0x25, 0xa6, 0x52, 0x3f, 0xc3, 0x4c, 0x22,
-		0xc7, 0x43, 0x22, 0x17, 0x86, 0xe0, 0xf6, 0xd7, 0x4f, 0xcc, 0x06, 0xf7,
-		0xfc, 0xd7, 0x89, 0x58, 0x77, 0x36, 0x92, 0xd9, 0xc0, 0xfc, 0xc9, 0xb4,
-		0xbd, 0x0d, 0x63, 0x5a, 0x18, 0xeb, 0x7d, 0xd0, 0x20, 0x6c, 0xe7, 0xad,
-		0xb0, 0xd2, 0xe3, 0x4a, 0xdd, 0x96, 0xbc, 0x5c, 0x9a, 0xa6, 0x7a, 0x0f,
-		0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0xd7, 0x0a, 0x3b, 0x34,
-		0x20, 0x01, 0x00, 0x00, 0x7d, 0x01, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04,
-		0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x25, 0x00,
-		0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f,
-		0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70,
-		0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
-		0x2e, 0x70, 0x79, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
-		0x64, 0x52, 0x41, 0x6e, 0xdb, 0x3a, 0x10, 0xdd, 0xeb, 0x14, 0xef, 0x33,
-		0x8b, 0x48, 0x80, 0x23, 0xff, 0x26, 0x8b, 0x02, 0x0e, 0xb4, 0x28, 0x12,
-		0x23, 0x4d, 0x1b, 0x04, 0x45, 0xec, 0x2e, 0x8a, 0xa2, 0x08, 0x18, 0x71,
-		0x64, 0xb1, 0xa6, 0x38, 0x02, 0x87, 0x72, 0x2a, 0xa4, 0x39, 0x41, 0xaf,
-		0xd0, 0x7b, 0x74, 0xdf, 0xa3, 0xf4, 0x24, 0x85, 0x64, 0x27, 0x31, 0xd0,
-		0x1d, 0xf9, 0xe6, 0xcd, 0x3c, 0xbe, 0x37, 0x3c, 0xf8, 0x6f, 0xda, 0x49,
-		0x98, 0xde, 0x59, 0x3f, 0x25, 0xbf, 0x41, 0xdb, 0xc7, 0x9a, 0xfd, 0x49,
-		0x62, 0x9b, 0x96, 0x43, 0xc4, 0x57, 0x61, 0x3f, 0x81, 0xf4, 0x32, 0x01,
-		0xcb, 0x04, 0x46, 0x47, 0x8a, 0xb6, 0xa1, 0x24, 0x99, 0xa3, 0x18, 0x8b,
-		0xb9, 0x63, 0x6d, 0x52, 0xe9, 0x25, 0x97, 0x68, 0xac, 0xcf, 0x92, 0x36,
-		0x70, 0xd3, 0x46, 0x14, 0x48, 0xe7, 0xf9, 0x8a, 0x62, 0xaa, 0xb6, 0x80,
-		0xca, 0xc0, 0x01, 0x4a, 0x65, 0x49, 0x72, 0x80, 0x57, 0x19, 0xe6, 0x3a,
-		0xb8, 0x1e, 0x77, 0x8e, 0xcb, 0x35, 0x2a, 0x0e, 0xe0, 0xbb, 0x8d, 0xe5,
-		0x4e, 0x5c, 0x0f, 0xa3, 0xfd, 0x8a, 0x02, 0x77, 0x82, 0x56, 0xc7, 0x48,
-		0xc1, 0x0b, 0xac, 0x47, 0xac, 0x09, 0xbb, 0xd1, 0x36, 0x0a, 0xb9, 0x2a,
-		0xb1, 0x15, 0x54, 0x68, 0x70, 0x14, 0x2a, 0x4c, 0xd5, 0x40, 0xd9, 0x95,
-		0x39, 0x20, 0x55, 0x65, 0x17, 0x1c, 0xf6, 0x51, 0xed, 0x0d, 0xd4, 0x77,
-		0x48, 0xbd, 0x07, 0x66, 0xb3, 0x04, 0x00, 0xda, 0x60, 0x7d, 0x4c, 0xd5,
-		0x9f, 0x9f, 0x3f, 0xb6, 0xef, 0x21, 0x33, 0xfb, 0xf7, 0x11, 0x2f, 0x5d,
-		0x6a, 0x82, 0xca, 0x3a, 0x2a, 0x76, 0x9e, 0x29, 0x84, 0x6c, 0x1c, 0x33,
-		0xdc, 0xe9, 0x9b, 0x8d, 0xe9, 0xf1, 0xe8, 0xf1, 0x38, 0xc3, 0x87, 0x40,
-		0x2d, 0x79, 0x03, 0x8d, 0x68, 0x7d, 0x8f, 0x0b, 0x76, 0x86, 0x3c, 0x6e,
-		0x3a, 0x47, 0x82, 0xe5, 0xd5, 0xe9, 0xf9, 0x0d, 0xd8, 0x97, 0x84, 0x96,
-		0x02, 0x84, 0x44, 0x2c, 0xfb, 0x44, 0xc8, 0x47, 0xeb, 0xc9, 0xa1, 0x80,
-		0xca, 0x4b, 0xa7, 0x3b, 0x43, 0xd3, 0x5d, 0x6d, 0x7a, 0xf3, 0xf1, 0x6a,
-		0xbe, 0xb8, 0xbd, 0xbc, 0x7e, 0x37, 0x3f, 0x5b, 0xce, 0xcf, 0xd5, 0x10,
-		0x80, 0xe7, 0x08, 0x96, 0xbc, 0xd5, 0xb1, 0x1e, 0xb4, 0x25, 0x4a, 0xfa,
-		0x34, 0x61, 0x67, 0x2e, 0x8c, 0x6a, 0x05, 0xd4, 0xfb, 0xcb, 0xc5, 0x02,
-		0xbf, 0x7f, 0xe1, 0xd3, 0x9b, 0x8b, 0xeb, 0xcb, 0xe1, 0xb0, 0x68, 0xb4,
-		0x73, 0x30, 0xb6, 0xaa, 0x64, 0xb8, 0x9e, 0xb1, 0x8f, 0x41, 0x97, 0x11,
-		0x91, 0x24, 0x0a, 0xd8, 0xbb, 0x7e, 0x80, 0x97, 0x8b, 0xb7, 0x33, 0x9c,
-		0x1c, 0xbd, 0x46, 0x6d, 0x57, 0xf5, 0x91, 0xd8, 0x95, 0xd7, 0x6e, 0x4b,
-		0x39, 0x85, 0xde, 0xb0, 0x35, 0x88, 0xc1, 0x6e, 0xac, 0x76, 0x68, 0x9d,
-		0x8e, 0x15, 0x87, 0x06, 0x65, 0x4d, 0xe5, 0x5a, 0x72, 0x35, 0xea, 0xc7,
-		0x41, 0xfc, 0xe9, 0xdb, 0xe4, 0xcf, 0x07, 0xcf, 0xf7, 0x69, 0x96, 0x5b,
-		0xe1, 0xa1, 0x43, 0xc7, 0x74, 0x00, 0xa5, 0xa5, 0xb2, 0x38, 0x14, 0x2a,
-		0xd9, 0x1b, 0x39, 0xcc, 0xf6, 0x76, 0x53, 0xa9, 0xcf, 0x0f, 0x51, 0x1e,
-		0xbf, 0x6c, 0xb3, 0x9b, 0xe1, 0x61, 0x74, 0xf5, 0xa8, 0xb6, 0x1c, 0x96,
-		0xbc, 0xd1, 0x6b, 0x32, 0x36, 0x48, 0xfa, 0x14, 0x86, 0xb1, 0xc1, 0xeb,
-		0x86, 0x5e, 0xd2, 0x98, 0x60, 0xcc, 0xe7, 0x96, 0xd7, 0xc5, 0x32, 0x74,
-		0xb4, 0xeb, 0x6c, 0xc9, 0x3f, 0x53, 0x26, 0x50, 0xf7, 0x2a, 0xcb, 0x4b,
-		0xc7, 0x42, 0x69, 0x96, 0x24, 0xcf, 0xfb, 0xfc, 0x3f, 0x4b, 0xfe, 0x06,
-		0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0xb0, 0x87, 0x23, 0x92,
-		0xfc, 0x01, 0x00, 0x00, 0x25, 0x03, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04,
-		0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x00,
-		0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f,
-		0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2d, 0x73, 0x74, 0x79, 0x6c, 0x65,
-		0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50,
-		0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x2e, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
-		0x64, 0x65, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2d, 0x73, 0x74,
-		0x79, 0x6c, 0x65, 0x73, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
-		0x67, 0x2d, 0x6f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
-		0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
-		0x24, 0xce, 0x31, 0x4e, 0x03, 0x41, 0x0c, 0x85, 0xe1, 0x7e, 0x4e, 0xf1,
-		0x14, 0xea, 0xe4, 0x16, 0x14, 0x48, 0x08, 0x0a, 0x0a, 0x6a, 0x67, 0xc6,
-		0xcb, 0x58, 0x9a, 0xb5, 0x57, 0xb6, 0x03, 0xbb, 0x1d, 0x57, 0xe0, 0x8a,
-		0x9c, 0x04, 0x0d, 0xe9, 0x5e, 0xf3, 0xe9, 0xfd, 0x0f, 0x78, 0x66, 0x72,
-		0x15, 0xfd, 0xc0, 0xab, 0x5e, 0x8d, 0xbc, 0xcd, 0xf9, 0x96, 0xc7, 0xe0,
-		0x52, 0xce, 0x78, 0x21, 0x77, 0x4a, 0x46, 0xe3, 0x2a, 0x21, 0xa6, 0x01,
-		0xd2, 0x86, 0x74, 0x6a, 0xfc, 0xfb, 0xfd, 0x63, 0xcb, 0x12, 0x88, 0x5b,
-		0xad, 0xa2, 0x35, 0xc7, 0x71, 0x29, 0x67, 0x3c, 0x69, 0xb0, 0x27, 0xa2,
-		0x9b, 0x27, 0xaa, 0xad, 0x2b, 0x6b, 0x06, 0x78, 0xdf, 0x06, 0xc9, 0xff,
-		0x8b, 0x9a, 0x4e, 0x79, 0xfd, 0x14, 0xbb, 0x05, 0xaa, 0x35, 0xc6, 0x46,
-		0xd9, 0x63, 0xe2, 0xc7, 0x55, 0x12, 0x84, 0x45, 0x94, 0x06, 0x4e, 0xef,
-		0x9d, 0x12, 0x69, 0x70, 0xa6, 0x06, 0xe5, 0x3d, 0x4f, 0x18, 0x12, 0x89,
-		0x2f, 0xc9, 0x0e, 0xe7, 0xcd, 0xb0, 0xc8, 0xe0, 0x7b, 0x11, 0xef, 0xc9,
-		0x3e, 0x55, 0xb3, 0x1a, 0x97, 0x52, 0xfe, 0x02, 0x00, 0x00, 0xff, 0xff,
-		0x50, 0x4b, 0x07, 0x08, 0x2a, 0xd5, 0x94, 0xb6, 0xa8, 0x00, 0x00, 0x00,
-		0xd7, 0x00, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00,
+		0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x2e,
+		0x70, 0x79, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0xc4,
+		0x56, 0x5d, 0x6e, 0xdb, 0xce, 0x11, 0x7f, 0xd7, 0x29, 0x26, 0x9b, 0x17,
+		0x32, 0x90, 0xa8, 0x36, 0x4d, 0x80, 0x54, 0x80, 0x52, 0x38, 0x88, 0x5a,
+		0x18, 0x75, 0xec, 0xc0, 0x72, 0x9a, 0x87, 0x38, 0x15, 0x56, 0xe4, 0x50,
+		0xdc, 0x98, 0xdc, 0x25, 0x76, 0x86, 0x92, 0x05, 0xd7, 0x07, 0x28, 0x7a,
+		0x85, 0x3e, 0xf6, 0x64, 0x39, 0x49, 0x31, 0x4b, 0x52, 0x1f, 0xb6, 0x83,
+		0x26, 0x40, 0x81, 0x3f, 0x1f, 0xc8, 0xdd, 0xd9, 0xd9, 0xdf, 0x7c, 0xec,
+		0xcc, 0x8f, 0xfb, 0xfc, 0xd9, 0xb8, 0x21, 0x3f, 0x5e, 0x1a, 0x3b, 0x46,
+		0xbb, 0x86, 0x7a, 0xcb, 0x85, 0xb3, 0x7f, 0x18, 0x98, 0xaa, 0x76, 0x9e,
+		0xe1, 0x1b, 0x39, 0x3b, 0x04, 0xda, 0xd2, 0x10, 0x1c, 0x0d, 0x81, 0x8a,
+		0x12, 0x6f, 0x87, 0xe0, 0x71, 0x90, 0x7b, 0x57, 0x41, 0xa6, 0x19, 0xd9,
+		0x54, 0x08, 0x9d, 0x76, 0x3f, 0x1f, 0x82, 0x48, 0x33, 0x2c, 0x59, 0x0f,
+		0x06, 0x33, 0x98, 0x06, 0x98, 0xa4, 0x74, 0x3a, 0x8b, 0x68, 0x4b, 0x09,
+		0x71, 0x66, 0x6c, 0x3c, 0x60, 0xe7, 0x4a, 0x98, 0xc2, 0x2c, 0x59, 0x21,
+		0x47, 0x4a, 0x66, 0x0b, 0xab, 0x2b, 0x54, 0x31, 0x38, 0x0f, 0x4a, 0x0d,
+		0xd8, 0x3c, 0x58, 0x35, 0xb6, 0x6e, 0xb8, 0x5d, 0xbe, 0xbb, 0x1f, 0xa4,
+		0x55, 0x06, 0x53, 0x60, 0xd3, 0x6e, 0x4f, 0x5d, 0x55, 0x69, 0x9b, 0xa9,
+		0x21, 0x28, 0x15, 0x0f, 0x6a, 0xcd, 0xc5, 0xc1, 0x62, 0x6e, 0x4a, 0x5c,
+		0x88, 0x6c, 0x87, 0x3d, 0x98, 0xcf, 0xce, 0xe7, 0xa7, 0x57, 0xa7, 0x7f,
+		0x9b, 0x2d, 0xce, 0x4f, 0x3e, 0xcc, 0xe6, 0x30, 0x85, 0xbb, 0x01, 0x00,
+		0x80, 0x4a, 0xd0, 0xae, 0xd5, 0x70, 0x3f, 0x4e, 0x4a, 0x97, 0xea, 0xf2,
+		0x48, 0x52, 0x7b, 0x97, 0x35, 0x29, 0x1b, 0x67, 0x8f, 0xc4, 0x19, 0xae,
+		0xb1, 0x74, 0x75, 0x85, 0x96, 0x7b, 0xb9, 0xc9, 0x16, 0x9e, 0xf4, 0xc1,
+		0x0c, 0xb3, 0x97, 0xaf, 0x5f, 0xff, 0xfe, 0x8f, 0xbd, 0xe4, 0xc6, 0xba,
+		0x8d, 0x5d, 0x14, 0x8e, 0x98, 0x7a, 0x11, 0xa1, 0x5f, 0x9b, 0x14, 0x4f,
+		0xd2, 0xd4, 0x35, 0x96, 0xff, 0x8a, 0xdb, 0x44, 0x92, 0xd7, 0xaf, 0xfe,
+		0xc5, 0xb9, 0x55, 0x89, 0xf3, 0x56, 0x67, 0x74, 0x6a, 0x73, 0x97, 0xd4,
+		0xa5, 0xa1, 0x9d, 0xc1, 0x55, 0x58, 0x1f, 0x75, 0x20, 0xd4, 0xef, 0xbd,
+		0x3f, 0x88, 0xf7, 0xfd, 0xe9, 0xe5, 0x62, 0xfe, 0xe9, 0xdd, 0xfc, 0xea,
+		0x12, 0xa6, 0x10, 0xa9, 0x71, 0xb2, 0x32, 0x3c, 0x96, 0xbc, 0x8d, 0x53,
+		0x67, 0x73, 0xb3, 0x1a, 0x13, 0xa6, 0x1e, 0x99, 0xc6, 0x2a, 0x1e, 0x0c,
+		0x06, 0x19, 0xe6, 0x50, 0x68, 0x5a, 0xe4, 0x1e, 0xa9, 0x58, 0xd4, 0xa5,
+		0xb6, 0x51, 0xe1, 0x1a, 0x4f, 0x13, 0x30, 0x96, 0x61, 0x0a, 0xaf, 0xde,
+		0xc4, 0x30, 0x7a, 0x0b, 0x4b, 0xe7, 0xca, 0x49, 0x70, 0x91, 0x6a, 0x4c,
+		0x69, 0x91, 0x19, 0x0f, 0x53, 0x70, 0x94, 0x48, 0xd6, 0x93, 0x6f, 0xce,
+		0xd8, 0x48, 0x65, 0x2e, 0x25, 0xb1, 0x13, 0x34, 0x54, 0x1c, 0x1c, 0x36,
+		0x39, 0x58, 0xc7, 0x3b, 0x45, 0x43, 0x99, 0xf1, 0xd1, 0x0e, 0x22, 0x6e,
+		0x21, 0xe5, 0xf1, 0xc8, 0x8d, 0xb7, 0xf0, 0x67, 0x5d, 0x12, 0x06, 0x61,
+		0xda, 0xb0, 0xcb, 0x73, 0x98, 0xee, 0x8a, 0x2e, 0x69, 0x38, 0xb5, 0x6e,
+		0x13, 0xc5, 0x30, 0xda, 0x97, 0x5f, 0xeb, 0xec, 0x34, 0xbc, 0x5b, 0x8b,
+		0xb9, 0xf3, 0x20, 0x65, 0x06, 0xc6, 0x8a, 0x59, 0x49, 0xde, 0x8f, 0x6c,
+		0x76, 0xce, 0x89, 0x76, 0x82, 0x36, 0xa3, 0x8d, 0xe1, 0x22, 0x52, 0x23,
+		0x49, 0x42, 0x52, 0x65, 0xea, 0xc0, 0x3b, 0x79, 0x52, 0x67, 0xd9, 0xd8,
+		0x06, 0x77, 0x42, 0xf6, 0xdb, 0x63, 0x8d, 0x4a, 0xdc, 0x3a, 0x48, 0xcb,
+		0x0a, 0x39, 0x88, 0xa2, 0x3e, 0xfc, 0x90, 0xa7, 0x5d, 0xf4, 0xc3, 0x60,
+		0x39, 0x8e, 0x77, 0x18, 0x78, 0x9b, 0x62, 0xcd, 0x70, 0x31, 0x9f, 0x79,
+		0xef, 0xfc, 0xff, 0xb0, 0x6e, 0xf2, 0xa3, 0xcc, 0x48, 0xbf, 0x8a, 0x2d,
+		0x62, 0x5d, 0xd5, 0x51, 0x18, 0xc6, 0xf0, 0x76, 0xda, 0xa5, 0xf1, 0x18,
+		0xab, 0xcb, 0xf5, 0x95, 0xef, 0xe0, 0x8e, 0x72, 0xdf, 0x96, 0x84, 0xa6,
+		0x9b, 0xc8, 0xa3, 0x26, 0x67, 0x27, 0x40, 0xec, 0x43, 0x0d, 0x9c, 0x3b,
+		0x8b, 0x2d, 0x50, 0xed, 0x8d, 0xe5, 0x68, 0x87, 0x29, 0x45, 0x98, 0x64,
+		0x4d, 0x55, 0xd3, 0x5e, 0x26, 0xcf, 0xdd, 0xd1, 0x4c, 0x1e, 0x55, 0x38,
+		0x77, 0x33, 0xaf, 0x31, 0x35, 0xb9, 0x49, 0x2f, 0x1a, 0x96, 0x7e, 0x9f,
+		0x3c, 0xa1, 0xb7, 0xd3, 0x9d, 0xad, 0xd1, 0xf2, 0xb9, 0xb0, 0xc6, 0x04,
+		0xd4, 0x47, 0x8f, 0x57, 0xce, 0x95, 0x9f, 0x08, 0xbb, 0x76, 0x78, 0xf8,
+		0xa8, 0x1a, 0x7d, 0x65, 0x88, 0x8c, 0xb3, 0xef, 0x31, 0x35, 0xf2, 0x95,
+		0x7d, 0x9a, 0x6e, 0x7e, 0x7e, 0xc3, 0x65, 0x88, 0x5a, 0x4d, 0xa0, 0x0d,
+		0xff, 0xf1, 0xbe, 0xfb, 0xc1, 0xd3, 0xb3, 0xf6, 0x1c, 0xdb, 0xb7, 0xb0,
+		0x20, 0xde, 0x1a, 0x8e, 0x7e, 0xd7, 0xf7, 0x58, 0x86, 0x76, 0x1b, 0x55,
+		0x48, 0xa4, 0x57, 0xf8, 0xe3, 0x94, 0x76, 0x0a, 0x43, 0x10, 0x46, 0x9b,
+		0x76, 0x5c, 0x8a, 0xde, 0x3f, 0x40, 0x7d, 0x29, 0xa8, 0x26, 0x07, 0xe1,
+		0x54, 0x98, 0x4e, 0x41, 0x5d, 0xa2, 0xce, 0x54, 0x7b, 0x36, 0x4b, 0x4d,
+		0x87, 0x35, 0x28, 0x53, 0x29, 0xb3, 0x48, 0x0a, 0x70, 0xd7, 0x93, 0x22,
+		0x95, 0x06, 0xd9, 0xb3, 0x46, 0xcb, 0x92, 0xce, 0x83, 0xb6, 0xdb, 0x88,
+		0x9a, 0xa5, 0xac, 0xca, 0x96, 0xd0, 0x4f, 0xdd, 0xfc, 0x29, 0x8e, 0x39,
+		0x68, 0x12, 0xa9, 0x99, 0x3c, 0xb8, 0x02, 0x2e, 0x07, 0x42, 0x4b, 0x86,
+		0xcd, 0x1a, 0x03, 0xcc, 0x04, 0xee, 0xe4, 0x73, 0x2f, 0x9c, 0xf3, 0x1c,
+		0x4e, 0xca, 0x8d, 0xde, 0x12, 0xe8, 0xb2, 0x74, 0x1b, 0x10, 0xda, 0x18,
+		0x27, 0x69, 0xa9, 0x9b, 0x0c, 0x01, 0x33, 0xc3, 0xb4, 0x0b, 0xcd, 0x58,
+		0x88, 0xd4, 0x2c, 0x33, 0x2c, 0xb4, 0xf2, 0xd9, 0x1b, 0x46, 0x19, 0x7c,
+		0x68, 0x4a, 0x36, 0x41, 0x1a, 0x83, 0xb6, 0x19, 0xb4, 0x45, 0x27, 0xf0,
+		0x09, 0xb1, 0xf6, 0xdc, 0xf5, 0x71, 0xc0, 0x6d, 0x7f, 0x08, 0x8f, 0xd6,
+		0x3a, 0x73, 0x42, 0x81, 0xf1, 0xe4, 0xf1, 0x89, 0xfd, 0x9a, 0x7d, 0xe1,
+		0x90, 0xff, 0x8b, 0x0f, 0x1d, 0x1f, 0x3d, 0xa0, 0xe3, 0x6e, 0xb1, 0xcf,
+		0xb0, 0x3a, 0x77, 0xe0, 0x31, 0x45, 0xcb, 0x20, 0x44, 0x25, 0x39, 0x0a,
+		0x91, 0x06, 0x5a, 0x19, 0xbf, 0xe8, 0xd9, 0x0b, 0xa2, 0xef, 0xff, 0xfc,
+		0xcf, 0xab, 0x37, 0x45, 0x9c, 0xc0, 0x47, 0xef, 0x52, 0xc4, 0x0c, 0x9c,
+		0x2d, 0xb7, 0x62, 0x83, 0x0b, 0x43, 0x60, 0x08, 0x34, 0xb0, 0x37, 0x6b,
+		0xa3, 0x4b, 0xc8, 0xcd, 0x6d, 0xa2, 0x7e, 0x25, 0xee, 0xdf, 0xba, 0xd4,
+		0x42, 0x37, 0xe5, 0xea, 0xfb, 0xbf, 0xff, 0x05, 0xcb, 0xd2, 0xa5, 0x37,
+		0x98, 0xc1, 0x46, 0xca, 0x03, 0xd8, 0xed, 0x0b, 0xef, 0xb0, 0xe6, 0xba,
+		0x13, 0x3d, 0x3a, 0x80, 0x77, 0x9a, 0x8a, 0xa8, 0xab, 0x21, 0x8f, 0x09,
+		0xa1, 0xf6, 0x69, 0x11, 0x79, 0x75, 0xbd, 0xf4, 0xd5, 0xf5, 0x52, 0x0d,
+		0x21, 0xad, 0xb2, 0x87, 0xab, 0x21, 0x6e, 0xaf, 0xae, 0x47, 0xd1, 0x9f,
+		0x26, 0x5f, 0xfe, 0x7e, 0x4d, 0x5f, 0x5f, 0xf8, 0xf6, 0x93, 0xff, 0xa3,
+		0xfb, 0x76, 0xd2, 0xb8, 0xdd, 0xdf, 0x97, 0x57, 0x2d, 0x66, 0x61, 0xda,
+		0x5e, 0xb0, 0x12, 0xaa, 0x4b, 0xc3, 0x91, 0xc0, 0x87, 0x45, 0xd6, 0x7e,
+		0x85, 0x61, 0xf9, 0x4b, 0x1d, 0xfa, 0xad, 0x96, 0x14, 0xb4, 0x5b, 0xba,
+		0x9a, 0xa8, 0x8f, 0x3c, 0x1f, 0x75, 0x5e, 0xd7, 0xf0, 0x6c, 0x0a, 0xca,
+		0x57, 0xea, 0x6b, 0x9f, 0x6d, 0xd1, 0xed, 0xe1, 0xe4, 0x47, 0xe8, 0x58,
+		0xba, 0x2c, 0xe2, 0xa3, 0xed, 0xec, 0x11, 0x43, 0x6b, 0x08, 0x86, 0x4a,
+		0x12, 0x15, 0xb6, 0x19, 0x0b, 0x1c, 0xac, 0xb7, 0xc3, 0x16, 0xa4, 0x73,
+		0x7f, 0x97, 0xf3, 0x90, 0xf2, 0x0c, 0x89, 0xbd, 0x5c, 0x90, 0xd6, 0x08,
+		0xbe, 0xda, 0x9d, 0x40, 0xe4, 0x45, 0x6e, 0x52, 0x96, 0x53, 0x68, 0x6d,
+		0x88, 0x0f, 0x0d, 0x21, 0xac, 0x0c, 0xc3, 0xc6, 0xf9, 0x1b, 0x91, 0x82,
+		0xc7, 0xca, 0xad, 0x31, 0x96, 0x82, 0x2b, 0xdd, 0x06, 0xe5, 0x22, 0x91,
+		0x56, 0x59, 0x12, 0xc6, 0x51, 0x2c, 0x47, 0x25, 0x1c, 0x74, 0x2b, 0x5e,
+		0x04, 0x59, 0x70, 0x2a, 0x4c, 0xbf, 0x28, 0x01, 0x92, 0xab, 0x60, 0x5b,
+		0x9a, 0x32, 0x63, 0xbd, 0xea, 0x87, 0x75, 0x43, 0x45, 0x18, 0x17, 0x50,
+		0x7b, 0x48, 0x3d, 0xea, 0xb6, 0x70, 0xdb, 0x79, 0x85, 0x7e, 0x85, 0xea,
+		0x6b, 0x17, 0xd1, 0x3e, 0x9a, 0x16, 0x8e, 0xc6, 0xac, 0x57, 0x34, 0x16,
+		0x08, 0xa4, 0xf1, 0xc7, 0xcb, 0x56, 0x9d, 0x40, 0x7b, 0x84, 0xa2, 0xa9,
+		0xb4, 0x1d, 0x49, 0xfb, 0x24, 0xf0, 0x89, 0x10, 0xc6, 0xb5, 0xc7, 0x5a,
+		0x7b, 0x1c, 0xb5, 0x5b, 0xfb, 0xd6, 0x69, 0x8b, 0x43, 0x42, 0x39, 0x4c,
+		0x76, 0xae, 0x89, 0x4b, 0x6d, 0xb1, 0xbb, 0x10, 0x39, 0xff, 0x58, 0xa3,
+		0x6c, 0x98, 0xd1, 0xc3, 0xb2, 0x31, 0x65, 0x06, 0xa6, 0xd6, 0x3f, 0xab,
+		0xaa, 0xeb, 0x7a, 0xd9, 0xd8, 0xac, 0x14, 0xec, 0x3d, 0x15, 0xf5, 0x97,
+		0x0d, 0xbc, 0x35, 0xc4, 0xb4, 0xe7, 0x3a, 0xc2, 0xf0, 0x63, 0x1c, 0x9f,
+		0x9c, 0x9d, 0x5d, 0x7c, 0x5e, 0x7c, 0xb8, 0x78, 0x77, 0x7a, 0x36, 0x5b,
+		0x5c, 0xce, 0xce, 0x66, 0x27, 0xf3, 0x99, 0x7a, 0x94, 0x95, 0xca, 0x2d,
+		0x4d, 0x29, 0x67, 0x55, 0xa2, 0xfc, 0x2b, 0x76, 0x67, 0x4c, 0xc8, 0xf0,
+		0x14, 0x42, 0x38, 0xce, 0x43, 0x12, 0xfd, 0xef, 0x00, 0x50, 0x4b, 0x07,
+		0x08, 0x7c, 0x7e, 0xa0, 0xa9, 0x32, 0x05, 0x00, 0x00, 0x78, 0x0c, 0x00,
+		0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00,
+		0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x1d, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c,
+		0x61, 0x75, 0x64, 0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x70,
+		0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x79,
+		0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x04, 0xc0, 0x41,
+		0x8a, 0xd5, 0x40, 0x10, 0x06, 0xe0, 0x7d, 0x4e, 0xf1, 0xdb, 0x22, 0x24,
+		0x12, 0x13, 0xc1, 0x85, 0xf0, 0xc0, 0x85, 0x0c, 0xee, 0x64, 0x46, 0x98,
+		0x85, 0xb8, 0x2c, 0xbb, 0x2b, 0x2f, 0x3d, 0x2f, 0x5d, 0xd5, 0x74, 0x55,
+		0x3f, 0x7c, 0x0e, 0x73, 0x00, 0x0f, 0xe2, 0xc5, 0x3c, 0x89, 0xdf, 0xeb,
+		0x57, 0x6b, 0xb7, 0xb6, 0xfe, 0xcc, 0xb2, 0xb2, 0x5c, 0x51, 0x6f, 0xbe,
+		0xab, 0x7c, 0x18, 0x72, 0xa9, 0xda, 0x1c, 0x4f, 0xa6, 0x32, 0xc3, 0x6e,
+		0x36, 0x6c, 0x1a, 0xbb, 0xe1, 0x13, 0xc6, 0x01, 0x08, 0xdf, 0x77, 0x16,
+		0x44, 0x2d, 0x95, 0xa2, 0x67, 0x39, 0xcf, 0xb8, 0x30, 0x57, 0x3c, 0xdc,
+		0x7f, 0xfd, 0x71, 0x82, 0xef, 0x8c, 0xd8, 0x5b, 0x63, 0x71, 0xd4, 0x83,
+		0x04, 0x63, 0xd2, 0x68, 0xab, 0x55, 0x8e, 0xb6, 0xbe, 0x7d, 0x57, 0x0f,
+		0x92, 0xa5, 0xa4, 0x69, 0x46, 0x18, 0x80, 0x40, 0xd1, 0xf3, 0x95, 0xb1,
+		0xe5, 0x83, 0x6d, 0x06, 0x49, 0x82, 0xef, 0x8c, 0x83, 0x9c, 0xcd, 0xb1,
+		0x51, 0x3e, 0xb2, 0x9c, 0xe1, 0x6c, 0x0e, 0xed, 0x5e, 0xbb, 0x63, 0xcc,
+		0x1b, 0x48, 0x6e, 0xd3, 0x82, 0x30, 0x00, 0xe1, 0xb1, 0x97, 0x42, 0x2d,
+		0xff, 0x66, 0x68, 0x43, 0x6a, 0x5a, 0x71, 0xa8, 0x9c, 0x11, 0x77, 0xf2,
+		0x19, 0x49, 0x5b, 0x21, 0x71, 0xd0, 0x99, 0xc5, 0x6d, 0x06, 0x49, 0x82,
+		0x1e, 0x09, 0x29, 0x6f, 0x9b, 0x2d, 0xf8, 0x9c, 0x0b, 0xfe, 0xfd, 0xf9,
+		0xfb, 0xf1, 0xfd, 0x1b, 0xec, 0x4c, 0xa9, 0xa9, 0x96, 0x25, 0x0c, 0xd3,
+		0x50, 0x5b, 0x16, 0x1f, 0x9f, 0x4c, 0x65, 0x49, 0xbd, 0x54, 0x1b, 0x9f,
+		0x11, 0x76, 0xd5, 0xcb, 0x63, 0xe5, 0x98, 0xb7, 0x1c, 0x1f, 0xba, 0xd7,
+		0xee, 0xe1, 0x84, 0x67, 0x84, 0x5d, 0xf5, 0xf2, 0xe5, 0xca, 0xe2, 0xf7,
+		0x54, 0x38, 0x9c, 0x10, 0xbe, 0x35, 0xbe, 0xd3, 0x52, 0x29, 0x7a, 0x98,
+		0x11, 0x28, 0xa5, 0xec, 0x59, 0x85, 0x8e, 0x3b, 0x15, 0xe7, 0x5f, 0x1e,
+		0x4e, 0xd8, 0x34, 0x76, 0xc3, 0xcb, 0xcb, 0x34, 0x0d, 0xff, 0x07, 0x00,
+		0x50, 0x4b, 0x07, 0x08, 0xd7, 0x0a, 0x3b, 0x34, 0x23, 0x01, 0x00, 0x00,
+		0x7d, 0x01, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00,
 		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x22, 0x00, 0x09, 0x00, 0x64, 0x6f,
-		0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x6f, 0x75, 0x74, 0x70,
-		0x75, 0x74, 0x2d, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x2f, 0x70, 0x6c,
-		0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00,
-		0x01, 0x00, 0xa6, 0xce, 0x12, 0x24, 0xcd, 0x31, 0x6a, 0x03, 0x31, 0x10,
-		0x05, 0xd0, 0x7e, 0x4f, 0xf1, 0x21, 0x4d, 0x02, 0x61, 0xef, 0x90, 0x3e,
-		0x24, 0xdb, 0xba, 0x9c, 0x95, 0xbe, 0xd8, 0x01, 0x69, 0xb4, 0x68, 0x46,
-		0x18, 0x77, 0xbe, 0x82, 0xaf, 0xe8, 0x93, 0xb8, 0xf0, 0x09, 0xde, 0x07,
-		0xb6, 0x2a, 0x66, 0x1c, 0xcb, 0xa5, 0x4f, 0xc8, 0x20, 0x92, 0xcc, 0xd0,
-		0x3e, 0x1d, 0x62, 0x19, 0x7e, 0x32, 0x3d, 0xef, 0x8f, 0xa2, 0xc3, 0x63,
-		0xc5, 0x36, 0x7a, 0x9e, 0x89, 0x10, 0xd8, 0x6c, 0x3b, 0x07, 0x33, 0xb6,
-		0xdf, 0x9f, 0x3f, 0x7c, 0x5a, 0x47, 0xea, 0x99, 0x5f, 0xd8, 0x59, 0xfa,
-		0x20, 0xc4, 0x6e, 0x60, 0xd6, 0xf0, 0x15, 0xff, 0x96, 0x88, 0x38, 0x88,
-		0x63, 0x36, 0x31, 0xa4, 0x6e, 0x45, 0x47, 0xf3, 0x6f, 0x68, 0x3b, 0x2b,
-		0x1b, 0x2d, 0xa0, 0x06, 0x6f, 0x52, 0x2b, 0xb2, 0x96, 0xe2, 0xb8, 0x6a,
-		0x1c, 0x08, 0x7a, 0x38, 0xde, 0xee, 0xf2, 0x0a, 0x00, 0x00, 0xff, 0xff,
-		0x50, 0x4b, 0x07, 0x08, 0x86, 0x8d, 0x65, 0x63, 0x8b, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x25, 0x00, 0x09, 0x00, 0x64, 0x6f,
+		0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b,
+		0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+		0x74, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x2e, 0x70, 0x79, 0x55,
+		0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x64, 0x52, 0x4d, 0x6e,
+		0xdb, 0x3c, 0x10, 0xdd, 0xeb, 0x14, 0xef, 0x63, 0x16, 0x91, 0x00, 0x47,
+		0xfe, 0x9a, 0x2c, 0x0a, 0x38, 0xd0, 0xa2, 0x48, 0x8c, 0x34, 0x6d, 0x10,
+		0x14, 0xb1, 0xbb, 0x28, 0x8a, 0x22, 0x60, 0xc4, 0x91, 0xc5, 0x9a, 0xe2,
+		0x08, 0x1c, 0xca, 0xa9, 0x90, 0xe6, 0x04, 0xbd, 0x42, 0xef, 0xd1, 0x7d,
+		0x8f, 0xd2, 0x93, 0x14, 0x92, 0x9d, 0xc4, 0x40, 0x77, 0xe4, 0x9b, 0x37,
+		0x3f, 0xef, 0xcd, 0x1c, 0xfc, 0x37, 0xed, 0x24, 0x4c, 0xef, 0xac, 0x9f,
+		0x92, 0xdf, 0xa0, 0xed, 0x63, 0xcd, 0xfe, 0x24, 0xb1, 0x4d, 0xcb, 0x21,
+		0xe2, 0xab, 0xb0, 0x9f, 0x40, 0x7a, 0x99, 0x80, 0x65, 0x02, 0xa3, 0x23,
+		0x45, 0xdb, 0x50, 0x92, 0xcc, 0x51, 0x8c, 0xc1, 0xdc, 0xb1, 0x36, 0xa9,
+		0xf4, 0x92, 0x4b, 0x34, 0xd6, 0x67, 0x49, 0x1b, 0xb8, 0x69, 0x23, 0x0a,
+		0xa4, 0xf3, 0x7c, 0x45, 0x31, 0x55, 0x5b, 0x40, 0x65, 0xe0, 0x00, 0xa5,
+		0xb2, 0x24, 0x39, 0xc0, 0xab, 0x0c, 0x73, 0x1d, 0x5c, 0x8f, 0x3b, 0xc7,
+		0xe5, 0x1a, 0x15, 0x07, 0xf0, 0xdd, 0xc6, 0x72, 0x27, 0xae, 0x87, 0xd1,
+		0x7e, 0x45, 0x81, 0x3b, 0x41, 0xab, 0x63, 0xa4, 0xe0, 0x05, 0xd6, 0x23,
+		0xd6, 0x84, 0x5d, 0x69, 0x1b, 0x85, 0x5c, 0x95, 0xd8, 0x0a, 0x2a, 0x34,
+		0x38, 0x0a, 0x15, 0xa6, 0x6a, 0xa0, 0xec, 0xc2, 0x1c, 0x90, 0xaa, 0xb2,
+		0x0b, 0x0e, 0xfb, 0xa8, 0xf6, 0x06, 0xea, 0x3b, 0xa4, 0xde, 0x03, 0xb3,
+		0x59, 0x02, 0x00, 0x6d, 0xb0, 0x3e, 0xa6, 0xea, 0xcf, 0xcf, 0x1f, 0xdb,
+		0x79, 0xc8, 0xcc, 0xfe, 0x1d, 0xe2, 0x25, 0x4b, 0x4d, 0x50, 0x59, 0x47,
+		0xc5, 0x4e, 0x33, 0x85, 0x90, 0x8d, 0x65, 0x86, 0x3f, 0x7d, 0xb3, 0x31,
+		0x3d, 0x1e, 0x35, 0x1e, 0x67, 0xf8, 0x10, 0xa8, 0x25, 0x6f, 0xa0, 0x11,
+		0xad, 0xef, 0x71, 0xc1, 0xce, 0x90, 0xc7, 0x4d, 0xe7, 0x48, 0xb0, 0xbc,
+		0x3a, 0x3d, 0xbf, 0x01, 0xfb, 0x92, 0xd0, 0x52, 0x80, 0x90, 0x88, 0x65,
+		0x9f, 0x08, 0xf9, 0x68, 0x3d, 0x39, 0x14, 0x50, 0x79, 0xe9, 0x74, 0x67,
+		0x68, 0xba, 0x8b, 0x4d, 0x6f, 0x3e, 0x5e, 0xcd, 0x17, 0xb7, 0x97, 0xd7,
+		0xef, 0xe6, 0x67, 0xcb, 0xf9, 0xb9, 0x1a, 0x0c, 0xf0, 0x1c, 0xc1, 0x92,
+		0xb7, 0x3a, 0xd6, 0x43, 0x6f, 0x89, 0x92, 0x3e, 0x55, 0xd8, 0x89, 0x0b,
+		0x63, 0xb7, 0x02, 0xea, 0xfd, 0xe5, 0x62, 0x81, 0xdf, 0xbf, 0xf0, 0xe9,
+		0xcd, 0xc5, 0xf5, 0xe5, 0xf0, 0x58, 0x34, 0xda, 0x39, 0x18, 0x5b, 0x55,
+		0x32, 0x7c, 0xcf, 0xd8, 0xc7, 0xa0, 0xcb, 0x88, 0x48, 0x12, 0x05, 0xec,
+		0x5d, 0x3f, 0xc0, 0xcb, 0xc5, 0xdb, 0x19, 0x4e, 0x8e, 0x5e, 0xa3, 0xb6,
+		0xab, 0xfa, 0x48, 0xec, 0xca, 0x6b, 0xb7, 0xa5, 0x9c, 0x42, 0x6f, 0xd8,
+		0x1a, 0xc4, 0x60, 0x37, 0x56, 0x3b, 0xb4, 0x4e, 0xc7, 0x8a, 0x43, 0x83,
+		0xb2, 0xa6, 0x72, 0x2d, 0xb9, 0x1a, 0x5d, 0x89, 0x43, 0xf3, 0xa7, 0xb3,
+		0xc9, 0x9f, 0x1f, 0x9e, 0xef, 0xd3, 0x2c, 0xb7, 0xc2, 0x43, 0x86, 0x8e,
+		0xe9, 0x70, 0x54, 0xd2, 0x52, 0x59, 0x1c, 0x0a, 0x95, 0xec, 0x8d, 0x1c,
+		0x66, 0x7b, 0xbb, 0xa9, 0xd4, 0xe7, 0x87, 0x28, 0x8f, 0x5f, 0xb6, 0xde,
+		0xcd, 0xf0, 0x30, 0xaa, 0x7a, 0x54, 0x5b, 0x0e, 0x4b, 0xde, 0xe8, 0x35,
+		0x19, 0x1b, 0x24, 0x7d, 0x32, 0xc3, 0xd8, 0xe0, 0x75, 0x43, 0x2f, 0x6e,
+		0x4c, 0x30, 0xfa, 0x73, 0xcb, 0xeb, 0x62, 0x19, 0x3a, 0xda, 0x65, 0xb6,
+		0xe4, 0x9f, 0x29, 0x13, 0xa8, 0x7b, 0x95, 0xe5, 0xa5, 0x63, 0xa1, 0x34,
+		0x4b, 0x92, 0xe7, 0x7d, 0xfe, 0x9f, 0x25, 0x7f, 0x07, 0x00, 0x50, 0x4b,
+		0x07, 0x08, 0xb0, 0x87, 0x23, 0x92, 0xfa, 0x01, 0x00, 0x00, 0x25, 0x03,
+		0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x18, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63,
+		0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+		0x2d, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00,
+		0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08,
+		0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2e, 0x00, 0x09, 0x00, 0x64,
+		0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x6f, 0x75, 0x74,
+		0x70, 0x75, 0x74, 0x2d, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x2f, 0x6c,
+		0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x6f, 0x6e, 0x62, 0x6f,
+		0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05,
+		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x24, 0xce, 0x31, 0x4e, 0x03, 0x41,
+		0x0c, 0x85, 0xe1, 0x7e, 0x4e, 0xf1, 0x14, 0xea, 0xe4, 0x16, 0x14, 0x48,
+		0x08, 0x0a, 0x0a, 0x6a, 0x67, 0xc6, 0xc3, 0x58, 0x9a, 0xb5, 0x57, 0xb6,
+		0x03, 0xbb, 0x1d, 0x57, 0xe0, 0x8a, 0x9c, 0x04, 0x2d, 0xe9, 0x5e, 0xf3,
+		0xe9, 0xfd, 0x0f, 0x78, 0x66, 0x72, 0x15, 0xfd, 0xc0, 0xab, 0x5e, 0x8d,
+		0xbc, 0x1d, 0xf3, 0x2d, 0xf7, 0xc9, 0xa5, 0x9c, 0xf1, 0x42, 0xee, 0x94,
+		0x8c, 0xc6, 0x55, 0x42, 0x4c, 0x03, 0xa4, 0x0d, 0xe9, 0xd4, 0xf8, 0xf7,
+		0xfb, 0xc7, 0x7a, 0x0f, 0xc4, 0xad, 0x56, 0xd1, 0x9a, 0x73, 0xbf, 0x94,
+		0x33, 0x9e, 0x34, 0xd8, 0x13, 0x31, 0xcc, 0x13, 0xd5, 0x96, 0x85, 0x35,
+		0x03, 0xbc, 0xad, 0x93, 0xe4, 0xff, 0x45, 0x4d, 0x0f, 0x79, 0xfd, 0x14,
+		0xbb, 0x05, 0xaa, 0x35, 0xc6, 0x4a, 0x39, 0xe2, 0xc0, 0x8f, 0x8b, 0x24,
+		0x08, 0x5d, 0x94, 0x26, 0x4e, 0xef, 0x83, 0x12, 0x69, 0x70, 0xa6, 0x06,
+		0xe5, 0x2d, 0x4f, 0x98, 0x12, 0x89, 0x2f, 0xc9, 0x01, 0xe7, 0xd5, 0xd0,
+		0x65, 0xf2, 0xbd, 0x88, 0xb7, 0x64, 0x57, 0x9a, 0x68, 0x56, 0xe3, 0x52,
+		0xca, 0xdf, 0x00, 0x50, 0x4b, 0x07, 0x08, 0x2a, 0xd5, 0x94, 0xb6, 0xa5,
+		0x00, 0x00, 0x00, 0xd7, 0x00, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14,
+		0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x22, 0x00, 0x09,
+		0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x6f,
+		0x75, 0x74, 0x70, 0x75, 0x74, 0x2d, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73,
+		0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x64, 0x55,
+		0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x00, 0xa5, 0x00, 0x5a,
+		0xff, 0x23, 0x20, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x0a, 0x59,
+		0x6f, 0x75, 0x20, 0x61, 0x72, 0x65, 0x20, 0x63, 0x61, 0x75, 0x74, 0x69,
+		0x6f, 0x75, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x70, 0x65, 0x63,
+		0xe2, 0x80, 0x91, 0x66, 0x69, 0x72, 0x73, 0x74, 0x2e, 0x20, 0x50, 0x72,
+		0x6f, 0x64, 0x75, 0x63, 0x65, 0x20, 0x61, 0x20, 0x6e, 0x75, 0x6d, 0x62,
+		0x65, 0x72, 0x65, 0x64, 0x20, 0x50, 0x4c, 0x41, 0x4e, 0x20, 0x28, 0x6e,
+		0x6f, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x29, 0x20, 0x62, 0x65, 0x66, 0x6f,
+		0x72, 0x65, 0x20, 0x61, 0x6e, 0x79, 0x20, 0x65, 0x64, 0x69, 0x74, 0x73,
+		0x2e, 0x20, 0x4f, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68,
+		0x75, 0x6d, 0x61, 0x6e, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+		0x73, 0x2c, 0x20, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+		0x20, 0x69, 0x6e, 0x20, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x20, 0x64, 0x69,
+		0x66, 0x66, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x65, 0x73,
+		0x74, 0x73, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x2e, 0x0a, 0x03, 0x00,
+		0x50, 0x4b, 0x07, 0x08, 0x86, 0x8d, 0x65, 0x63, 0xac, 0x00, 0x00, 0x00,
 		0xa5, 0x00, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00,
 		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x23, 0x00, 0x09, 0x00, 0x64, 0x6f,
 		0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x6f, 0x75, 0x74, 0x70,
 		0x75, 0x74, 0x2d, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x2f, 0x72, 0x65,
 		0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05,
-		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x24, 0xca, 0xc1, 0x8d, 0xc2, 0x40,
-		0x0c, 0x05, 0xd0, 0x7b, 0xaa, 0xf8, 0xd2, 0x5e, 0xa3, 0x6c, 0x0d, 0x88,
-		0x0e, 0x42, 0x05, 0xc6, 0xb2, 0x26, 0x56, 0x26, 0xce, 0x60, 0x7b, 0x92,
-		0x2b, 0x2d, 0xd0, 0x22, 0x95, 0x20, 0xc1, 0xf1, 0x49, 0xef, 0x0f, 0xb3,
-		0x1c, 0x2a, 0xa7, 0xf8, 0x70, 0x4b, 0x57, 0x4e, 0xf8, 0xd7, 0xa0, 0x42,
-		0x6a, 0x91, 0x20, 0x66, 0x69, 0x49, 0xc6, 0x02, 0x5e, 0x84, 0xd7, 0x98,
-		0x70, 0xa5, 0x5a, 0xb1, 0xf7, 0x84, 0x6b, 0xac, 0x31, 0x62, 0xd3, 0x08,
-		0xb5, 0x02, 0xde, 0x2d, 0x9d, 0x38, 0x91, 0x12, 0x19, 0x23, 0xba, 0x71,
-		0x15, 0x72, 0x18, 0x6d, 0x6a, 0x65, 0xc2, 0xa5, 0x35, 0xdf, 0x0f, 0xc1,
-		0x3f, 0x66, 0x79, 0x74, 0x89, 0x7c, 0x3f, 0x5f, 0xbc, 0x90, 0x15, 0x09,
-		0x9c, 0x9a, 0x0b, 0x7e, 0xfd, 0xde, 0x6b, 0x95, 0x8c, 0x69, 0x18, 0x3e,
-		0x01, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0xbf, 0xef, 0xd9,
-		0x4f, 0x83, 0x00, 0x00, 0x00, 0x9d, 0x00, 0x00, 0x00, 0x50, 0x4b, 0x03,
-		0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x23,
-		0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65,
-		0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2d, 0x73, 0x74, 0x79, 0x6c,
-		0x65, 0x73, 0x2f, 0x73, 0x75, 0x72, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x2e,
-		0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x2c,
-		0xd0, 0x41, 0x8a, 0xdb, 0x40, 0x10, 0x05, 0xd0, 0xbd, 0x4f, 0xf1, 0x21,
-		0x1b, 0x9b, 0x78, 0xe4, 0x7d, 0xb2, 0x1a, 0xb2, 0x0a, 0x61, 0x20, 0xe0,
-		0x39, 0xc0, 0x94, 0x5b, 0x25, 0xa9, 0x70, 0xb9, 0xba, 0xe9, 0xaa, 0x76,
-		0xe2, 0xac, 0x74, 0x05, 0x43, 0x4e, 0xa8, 0x93, 0x04, 0x89, 0xac, 0x8b,
-		0xff, 0xea, 0xf3, 0x3f, 0xe1, 0xdc, 0xea, 0x28, 0x89, 0x14, 0xe7, 0x78,
-		0x28, 0x63, 0x99, 0xff, 0xe2, 0x8d, 0xc4, 0xf0, 0x3e, 0x55, 0xa6, 0x7e,
-		0xf7, 0x82, 0xf7, 0x49, 0xec, 0x8a, 0x41, 0xaa, 0xc7, 0x11, 0x31, 0xb1,
-		0xa1, 0xd4, 0x5c, 0xb2, 0x33, 0x08, 0xc5, 0xb9, 0xf5, 0x79, 0x99, 0x9f,
-		0x29, 0xf7, 0x8c, 0xa2, 0x64, 0xf8, 0x0c, 0xfe, 0x5d, 0x54, 0x92, 0x04,
-		0x06, 0x51, 0x46, 0xe4, 0x96, 0x26, 0xf6, 0x6e, 0xf7, 0x82, 0x1f, 0xcc,
-		0x05, 0xb7, 0x15, 0x8f, 0x0d, 0x47, 0xb3, 0x8b, 0xe6, 0x74, 0xe5, 0xfe,
-		0x0b, 0xbc, 0x8d, 0x23, 0x7b, 0xa0, 0x50, 0x25, 0x55, 0x56, 0xf9, 0x43,
-		0x17, 0x65, 0x78, 0xbb, 0x2c, 0xf3, 0x33, 0xc8, 0xaf, 0x8e, 0x7d, 0x65,
-		0x67, 0xaa, 0x69, 0x3a, 0x05, 0x7b, 0xf8, 0x61, 0x25, 0xdf, 0xc4, 0xe4,
-		0x26, 0xce, 0x6b, 0x31, 0xf4, 0x32, 0x0c, 0x5f, 0xe1, 0x62, 0xa3, 0xf2,
-		0x32, 0x3f, 0x4b, 0xab, 0x5b, 0xcd, 0x34, 0x91, 0x8d, 0xec, 0xc8, 0xa6,
-		0x8f, 0x35, 0xf3, 0x2d, 0x5b, 0x54, 0x4a, 0xb1, 0xcc, 0x4f, 0xe5, 0x3b,
-		0x2b, 0x36, 0x6e, 0x3b, 0x63, 0x5f, 0xda, 0x45, 0x25, 0xe1, 0xf5, 0xe7,
-		0x77, 0x3f, 0x42, 0xec, 0x4e, 0x55, 0xc8, 0xc2, 0x8f, 0x48, 0x55, 0x62,
-		0xdb, 0x89, 0xfb, 0x91, 0xfd, 0xd0, 0xe1, 0x7c, 0x95, 0x82, 0xa8, 0x72,
-		0x17, 0xd2, 0x93, 0x58, 0x70, 0x35, 0xfa, 0x6f, 0xad, 0x5f, 0x5e, 0xf5,
-		0x17, 0x3d, 0x1c, 0x62, 0x49, 0x5b, 0xcf, 0xb8, 0x73, 0x95, 0x41, 0x12,
-		0x85, 0x64, 0x43, 0xca, 0xb7, 0x1b, 0x59, 0xef, 0xd8, 0x73, 0x37, 0x76,
-		0x47, 0x7c, 0x8c, 0x79, 0x0b, 0xa2, 0x3b, 0x75, 0x5d, 0xf7, 0x71, 0xe8,
-		0x76, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0xda,
-		0x03, 0xde, 0x04, 0x28, 0x01, 0x00, 0x00, 0x98, 0x01, 0x00, 0x00, 0x50,
-		0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21,
+		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x04, 0xc0, 0xd1, 0x4d, 0x86, 0x50,
+		0x0c, 0x05, 0xe0, 0x77, 0xa6, 0x38, 0x89, 0xaf, 0x04, 0x67, 0x30, 0x6e,
+		0x80, 0x13, 0xd4, 0xe6, 0xe4, 0xd2, 0x70, 0x29, 0xd8, 0xf6, 0xc2, 0xab,
+		0x2b, 0xb8, 0xa2, 0x93, 0xfc, 0xdf, 0x1b, 0x56, 0xde, 0xc6, 0x87, 0x31,
+		0x7d, 0x55, 0x98, 0x16, 0x82, 0xb7, 0xf1, 0x81, 0x34, 0x31, 0xcf, 0x82,
+		0xa8, 0xf2, 0x2a, 0x71, 0x25, 0x74, 0xa3, 0xee, 0xb9, 0xe0, 0x53, 0x7a,
+		0xc7, 0x39, 0x0a, 0x61, 0xb9, 0xe7, 0x8c, 0xc3, 0x32, 0xcd, 0x1b, 0xf4,
+		0xf4, 0x0a, 0xd1, 0x42, 0x31, 0x2b, 0x67, 0x0c, 0xd7, 0x4e, 0x09, 0xb8,
+		0x1c, 0xe6, 0x6d, 0xc1, 0xc7, 0x75, 0xc5, 0x79, 0x13, 0xef, 0x58, 0xf9,
+		0x33, 0x98, 0xf5, 0xff, 0xfb, 0xa7, 0x9b, 0x78, 0x63, 0xe2, 0xb1, 0xda,
+		0xa0, 0x9d, 0x12, 0xf8, 0x1e, 0xbd, 0xb3, 0x72, 0x99, 0xa6, 0xd7, 0x00,
+		0x50, 0x4b, 0x07, 0x08, 0xbf, 0xef, 0xd9, 0x4f, 0x7e, 0x00, 0x00, 0x00,
+		0x9d, 0x00, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00,
+		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x23, 0x00, 0x09, 0x00, 0x64, 0x6f,
+		0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x6f, 0x75, 0x74, 0x70,
+		0x75, 0x74, 0x2d, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x2f, 0x73, 0x75,
+		0x72, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05,
+		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x2c, 0x90, 0x31, 0x8a, 0x1b, 0x41,
+		0x10, 0x45, 0x73, 0x9d, 0xe2, 0x83, 0x13, 0x09, 0x4b, 0xad, 0xdc, 0x8e,
+		0x16, 0x47, 0xc6, 0x2c, 0x18, 0xb4, 0x07, 0xd8, 0x52, 0x4f, 0x69, 0xa6,
+		0x50, 0xa9, 0xba, 0xe9, 0xaa, 0x96, 0x2d, 0x47, 0x73, 0x05, 0x81, 0x4f,
+		0x38, 0x27, 0x31, 0x3b, 0x38, 0xfe, 0xbc, 0xf7, 0x3f, 0xff, 0x13, 0x4e,
+		0xbd, 0x8d, 0x92, 0x49, 0x71, 0x8a, 0x87, 0x32, 0x96, 0xf9, 0x2f, 0x5e,
+		0x49, 0x0c, 0x6f, 0x53, 0x63, 0x1a, 0x36, 0x07, 0xbc, 0x4d, 0x62, 0x57,
+		0x5c, 0xa4, 0x79, 0xec, 0x11, 0x13, 0x1b, 0x6a, 0x2b, 0xb5, 0x38, 0x83,
+		0x50, 0x9d, 0xfb, 0x50, 0x96, 0xf9, 0x99, 0xcb, 0xc0, 0xa8, 0x4a, 0x86,
+		0xcf, 0xe0, 0xdf, 0x55, 0x25, 0x4b, 0xe0, 0x22, 0xca, 0x88, 0xd2, 0xf3,
+		0xc4, 0x9e, 0x36, 0x07, 0xfc, 0x60, 0xae, 0xb8, 0x91, 0x18, 0x62, 0x95,
+		0xa3, 0xdb, 0x59, 0x4b, 0xbe, 0xf2, 0xf0, 0x05, 0xde, 0xc7, 0x91, 0x3d,
+		0x50, 0xa9, 0x91, 0x2a, 0xab, 0xfc, 0xa1, 0xb3, 0x32, 0xbc, 0x9f, 0x97,
+		0xf9, 0x19, 0xe4, 0x57, 0xc7, 0xb6, 0xb1, 0x33, 0xb5, 0x3c, 0x1d, 0x83,
+		0x3d, 0x7c, 0x97, 0x36, 0x07, 0xbc, 0x8a, 0xc9, 0x4d, 0x9c, 0x11, 0x13,
+		0x63, 0x90, 0xcb, 0xe5, 0x2b, 0x5c, 0x6c, 0x54, 0x5e, 0xe6, 0x67, 0xed,
+		0x6d, 0x9d, 0x99, 0x27, 0xb2, 0x91, 0x1d, 0xc5, 0xf4, 0xf1, 0xc1, 0x7c,
+		0x2b, 0x16, 0x8d, 0x72, 0x2c, 0xf3, 0x53, 0xf9, 0xce, 0x8a, 0x55, 0xb7,
+		0xc6, 0xd8, 0xd6, 0x7e, 0x56, 0xc9, 0x78, 0xf9, 0xf9, 0xdd, 0xf7, 0x10,
+		0xbb, 0x53, 0x13, 0xb2, 0xf0, 0x3d, 0x72, 0x93, 0x58, 0x7f, 0xe2, 0x61,
+		0x64, 0xdf, 0x25, 0x9c, 0xae, 0x52, 0x11, 0x4d, 0xee, 0x42, 0x7a, 0x14,
+		0x0b, 0x6e, 0x46, 0xff, 0x5d, 0x1f, 0x2d, 0x2f, 0xfa, 0x8b, 0x1e, 0x0e,
+		0xb1, 0xac, 0x7d, 0x60, 0xdc, 0xb9, 0xc9, 0x45, 0x32, 0x85, 0x14, 0x43,
+		0x2e, 0xb7, 0x1b, 0xd9, 0xe0, 0xd8, 0x72, 0x1a, 0xd3, 0x1e, 0xef, 0x63,
+		0x59, 0x41, 0xa4, 0x63, 0x4a, 0xe9, 0x7d, 0x97, 0x36, 0xff, 0x06, 0x00,
+		0x50, 0x4b, 0x07, 0x08, 0xda, 0x03, 0xde, 0x04, 0x26, 0x01, 0x00, 0x00,
+		0x98, 0x01, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00,
+		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x17, 0x00, 0x09, 0x00, 0x64, 0x6f,
+		0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x73, 0x65, 0x74, 0x74,
+		0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x54, 0x05,
+		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x7c, 0x93, 0x3f, 0x8f, 0xdb, 0x3c,
+		0x0c, 0xc6, 0xf7, 0x7c, 0x0a, 0x42, 0x53, 0x5e, 0xe3, 0x62, 0xef, 0xd9,
+		0xde, 0xeb, 0x9f, 0xad, 0x4b, 0x3b, 0xdc, 0x50, 0x14, 0x85, 0x22, 0xd1,
+		0xb2, 0x1a, 0x59, 0x12, 0x28, 0x2a, 0x07, 0xa3, 0xb8, 0xef, 0x5e, 0x28,
+		0xb9, 0xde, 0x25, 0x08, 0xdd, 0x2d, 0x79, 0x7e, 0xcf, 0x23, 0xd2, 0x20,
+		0xf9, 0x7b, 0x03, 0xa0, 0x7c, 0x34, 0xa1, 0x5a, 0xfc, 0x90, 0xfe, 0xaf,
+		0x3c, 0x25, 0x42, 0xfb, 0xb8, 0xa8, 0x3d, 0x8c, 0x3a, 0x14, 0x7c, 0x68,
+		0x3c, 0x23, 0xcd, 0xbe, 0x14, 0x9f, 0x62, 0x51, 0x7b, 0x68, 0x11, 0x00,
+		0x65, 0x71, 0xd4, 0x35, 0xf0, 0x97, 0x64, 0x51, 0xed, 0x41, 0xe5, 0xa0,
+		0xa3, 0x6a, 0x6e, 0x00, 0xa5, 0xad, 0xf5, 0xec, 0x53, 0xd4, 0xe1, 0xa3,
+		0x27, 0x34, 0x9c, 0xc8, 0x63, 0x4b, 0x7e, 0x57, 0x36, 0x99, 0xa2, 0x1e,
+		0x40, 0xf5, 0x26, 0xe8, 0x6a, 0xb1, 0xfd, 0x2c, 0x86, 0x7c, 0xe6, 0x8b,
+		0xea, 0x3c, 0x4f, 0xf5, 0xa0, 0x7e, 0xfc, 0x7d, 0x27, 0x84, 0xf4, 0xdc,
+		0x72, 0xe7, 0xbf, 0x00, 0xea, 0x2b, 0x6a, 0xbb, 0xed, 0xba, 0xff, 0x5e,
+		0x0b, 0x01, 0xa8, 0x4f, 0xd6, 0xf3, 0x76, 0x68, 0xcf, 0x0e, 0x82, 0xfe,
+		0x5a, 0xe6, 0x16, 0x3d, 0xea, 0x32, 0x6d, 0x9d, 0x67, 0x28, 0xac, 0xb9,
+		0x96, 0xbd, 0xc8, 0xac, 0x1f, 0x47, 0x99, 0x38, 0xc2, 0x7c, 0x4f, 0x46,
+		0x1f, 0xad, 0xe0, 0x4f, 0xc0, 0x58, 0x18, 0xfa, 0xa1, 0xef, 0xfb, 0x75,
+		0xbc, 0xa3, 0x1a, 0xef, 0x69, 0x8e, 0x79, 0x3e, 0xc7, 0xef, 0xd1, 0x3a,
+		0x59, 0x34, 0xc5, 0x95, 0xd0, 0xa1, 0xae, 0x91, 0x93, 0x97, 0xf5, 0x7a,
+		0x02, 0xaa, 0x11, 0xf2, 0x22, 0xe3, 0x35, 0x7d, 0x3e, 0xad, 0x15, 0x72,
+		0xa4, 0x6d, 0xc0, 0x15, 0x38, 0x86, 0xca, 0x8c, 0xf4, 0x46, 0xcf, 0xb5,
+		0xde, 0x56, 0xa1, 0x1c, 0xaf, 0x17, 0xe1, 0x32, 0xf6, 0x42, 0x46, 0x9a,
+		0xba, 0x8f, 0x8c, 0x14, 0x75, 0x90, 0x98, 0x99, 0xad, 0x24, 0xe7, 0xa3,
+		0xbb, 0x95, 0x9f, 0xf0, 0xf0, 0x19, 0xd9, 0x4c, 0xef, 0x3d, 0x3e, 0xe1,
+		0xe1, 0x1b, 0x6a, 0x32, 0xd3, 0x6d, 0x63, 0x16, 0xe3, 0x72, 0xdf, 0x59,
+		0xd7, 0x0d, 0x3d, 0xc6, 0xd3, 0xd5, 0xf7, 0xbf, 0xeb, 0xce, 0xb3, 0xd4,
+		0x42, 0xd7, 0x0d, 0x59, 0x9b, 0xa3, 0x76, 0xb8, 0x0b, 0xc9, 0x1c, 0xfb,
+		0x5f, 0x25, 0x45, 0x29, 0xdf, 0xd6, 0xe2, 0xe2, 0x58, 0xf4, 0x1c, 0x24,
+		0x47, 0xdb, 0x81, 0xbe, 0x39, 0x24, 0x18, 0x93, 0xc5, 0x9f, 0x73, 0xb2,
+		0x35, 0xa0, 0x78, 0x32, 0x5d, 0x37, 0x58, 0xcc, 0x21, 0x2d, 0x2b, 0x47,
+		0x63, 0xd2, 0x3c, 0x7b, 0x69, 0xb4, 0x9e, 0x81, 0xb5, 0x93, 0x41, 0xae,
+		0x65, 0x5a, 0x27, 0xb0, 0xdb, 0x8d, 0x89, 0x0c, 0xfe, 0xd3, 0xc1, 0xda,
+		0x49, 0xa7, 0x3a, 0x41, 0x26, 0x30, 0x84, 0x9a, 0xa5, 0xf8, 0x99, 0xce,
+		0x48, 0x4e, 0x80, 0xa6, 0x52, 0xb8, 0x57, 0x9f, 0x1d, 0x5e, 0x2d, 0xdf,
+		0x06, 0xe0, 0x65, 0xf3, 0xb2, 0xf9, 0x33, 0x00, 0x50, 0x4b, 0x07, 0x08,
+		0xa5, 0x5e, 0x20, 0x22, 0xa6, 0x01, 0x00, 0x00, 0x25, 0x05, 0x00, 0x00,
+		0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x13, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61,
+		0x75, 0x64, 0x65, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x73,
+		0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b,
+		0x03, 0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x17, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
-		0x64, 0x65, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e,
-		0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce,
-		0x12, 0x7c, 0xd3, 0xbd, 0x8e, 0xdb, 0x30, 0x0c, 0x07, 0xf0, 0x3d, 0x4f,
-		0x41, 0x68, 0xba, 0x1a, 0x89, 0xbd, 0x67, 0xeb, 0xf5, 0x63, 0xeb, 0xd2,
-		0x0e, 0x1d, 0x8a, 0xa2, 0x50, 0x24, 0x5a, 0x56, 0xa3, 0x2f, 0x50, 0x54,
-		0x0e, 0x46, 0x71, 0xef, 0x5e, 0x38, 0xb9, 0x1e, 0x2e, 0x30, 0xdd, 0x2d,
-		0xf9, 0xff, 0x48, 0x53, 0x80, 0xa8, 0x3f, 0x3b, 0x00, 0xe5, 0x93, 0x09,
-		0xcd, 0xe2, 0x87, 0xfc, 0xbe, 0xf1, 0x94, 0x09, 0xed, 0xe3, 0xac, 0x8e,
-		0x30, 0xea, 0x50, 0x71, 0xbf, 0x78, 0x41, 0x8a, 0xbe, 0x56, 0x9f, 0x53,
-		0x55, 0x47, 0x58, 0x5a, 0x00, 0x94, 0xc5, 0x51, 0xb7, 0xc0, 0x5f, 0xb2,
-		0x45, 0x75, 0x04, 0x55, 0x82, 0x4e, 0x6a, 0x7f, 0x23, 0x6d, 0xad, 0x67,
-		0x9f, 0x93, 0x0e, 0x1f, 0x3d, 0xa1, 0xe1, 0x4c, 0x1e, 0x97, 0xce, 0x1f,
-		0xca, 0x66, 0x53, 0xd5, 0x1e, 0x54, 0x6f, 0x82, 0x6e, 0x16, 0x97, 0x9f,
-		0xd5, 0x90, 0x2f, 0x7c, 0x4b, 0x9d, 0xe7, 0xa9, 0x9d, 0xd4, 0xcf, 0x7f,
-		0xdf, 0x09, 0x21, 0x3f, 0x2d, 0x7d, 0xd7, 0xbf, 0x00, 0xea, 0x2b, 0x6a,
-		0xfb, 0xd0, 0x75, 0xef, 0x5e, 0x06, 0x01, 0xa8, 0x4f, 0xd6, 0xf3, 0xc3,
-		0xb0, 0x7c, 0x76, 0x10, 0xf2, 0x97, 0x31, 0xf7, 0xf4, 0xa8, 0xeb, 0xf4,
-		0xe0, 0x3c, 0x43, 0x65, 0xcd, 0xad, 0x1e, 0x45, 0xb3, 0x7e, 0x1c, 0x65,
-		0x71, 0x84, 0x65, 0x2d, 0xa3, 0x4f, 0x56, 0xa8, 0xcf, 0xc0, 0x58, 0x19,
-		0xfa, 0xa1, 0xef, 0xfb, 0x6d, 0x3e, 0x50, 0x4b, 0x6b, 0x2d, 0xa9, 0xc4,
-		0xab, 0xaf, 0x69, 0x5b, 0x66, 0x4d, 0x69, 0x83, 0x4e, 0x6d, 0x4b, 0x2e,
-		0x5e, 0xce, 0xdb, 0x05, 0xa8, 0x25, 0x28, 0xb3, 0xcc, 0x5b, 0x79, 0xbc,
-		0x6c, 0x0d, 0x72, 0xa4, 0x6d, 0xc0, 0x0d, 0x1c, 0x43, 0x63, 0x46, 0x7a,
-		0xd5, 0x2b, 0xbe, 0xae, 0x42, 0x3d, 0xbf, 0x5d, 0x84, 0xdb, 0xf5, 0x56,
-		0x32, 0xd2, 0xad, 0xfb, 0xc4, 0x48, 0x49, 0x07, 0xc9, 0x4c, 0xb4, 0x52,
-		0x5c, 0xce, 0xee, 0x3e, 0xfe, 0x8e, 0xa7, 0xcf, 0xc8, 0x66, 0xba, 0x4b,
-		0xbe, 0xa1, 0x26, 0x33, 0xdd, 0x1f, 0xcc, 0x62, 0x9a, 0xd7, 0x27, 0xeb,
-		0xba, 0xa1, 0xc7, 0x74, 0x59, 0xcd, 0x59, 0x72, 0xe7, 0x59, 0x3a, 0x42,
-		0xd7, 0x0d, 0x45, 0x9b, 0xb3, 0x76, 0x78, 0x08, 0xd9, 0x9c, 0xfb, 0xdf,
-		0x35, 0x27, 0xb1, 0x28, 0x95, 0x78, 0xab, 0x98, 0x75, 0x0c, 0x52, 0xc5,
-		0xb2, 0x03, 0xfd, 0x52, 0x21, 0x61, 0xca, 0x16, 0x7f, 0xc5, 0x6c, 0x5b,
-		0x40, 0xf1, 0xc9, 0x74, 0xdd, 0x60, 0xb1, 0x84, 0x3c, 0x6f, 0x3c, 0x1a,
-		0x93, 0x63, 0xf4, 0xd2, 0xd5, 0x7a, 0x06, 0xd6, 0x4e, 0x86, 0xd2, 0xea,
-		0xb4, 0x2d, 0x70, 0x38, 0x8c, 0x99, 0x0c, 0xfe, 0xb7, 0x82, 0xb5, 0x93,
-		0x9e, 0xea, 0x04, 0x85, 0xc0, 0x10, 0x6a, 0x96, 0xda, 0xaf, 0x1a, 0x91,
-		0x9c, 0x80, 0xa6, 0x51, 0x58, 0xa7, 0x4f, 0x0e, 0xdf, 0x2c, 0xdf, 0x0e,
-		0xe0, 0x79, 0xf7, 0xbc, 0xfb, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4b,
-		0x07, 0x08, 0xa5, 0x5e, 0x20, 0x22, 0xa1, 0x01, 0x00, 0x00, 0x25, 0x05,
-		0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x19, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64,
+		0x65, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x73, 0x2f, 0x68,
+		0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
+		0xce, 0x12, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00,
 		0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x13, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63,
+		0x00, 0x00, 0x00, 0x00, 0x32, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63,
 		0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65,
-		0x74, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
+		0x74, 0x73, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x66, 0x6c, 0x75,
+		0x74, 0x74, 0x65, 0x72, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+		0x2d, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x54,
+		0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x8c, 0x91, 0x4d, 0x6a, 0xf3,
+		0x30, 0x10, 0x86, 0xf7, 0x3e, 0xc5, 0xfb, 0x69, 0x93, 0x1f, 0xbe, 0x34,
+		0xd0, 0x65, 0xb2, 0x4a, 0xc0, 0x8b, 0x82, 0x4b, 0x4a, 0x7f, 0xe8, 0x22,
+		0x31, 0x41, 0x96, 0xc7, 0x58, 0x44, 0x96, 0x8c, 0x47, 0x2e, 0x29, 0x6d,
+		0x4e, 0xd0, 0x2b, 0xf4, 0x74, 0x3d, 0x49, 0x51, 0x92, 0x9a, 0x42, 0xbc,
+		0xe8, 0xac, 0xa4, 0x79, 0x98, 0x57, 0x0f, 0x9a, 0xb7, 0x08, 0x10, 0x39,
+		0xb1, 0x6a, 0x74, 0xed, 0xb5, 0xb3, 0x62, 0x06, 0xb1, 0x34, 0x4e, 0xed,
+		0x50, 0xb9, 0x4c, 0x1b, 0x42, 0x43, 0x86, 0x24, 0x13, 0xb2, 0x56, 0x9b,
+		0x9c, 0xd1, 0x5a, 0x43, 0xcc, 0xb8, 0x52, 0x46, 0xb6, 0x39, 0x4d, 0x99,
+		0x98, 0xb5, 0xb3, 0xd3, 0x45, 0x92, 0xac, 0x9e, 0xb7, 0xb7, 0xab, 0xe5,
+		0x4d, 0x12, 0x6f, 0xef, 0xe3, 0x24, 0x5e, 0x3c, 0xc4, 0xa0, 0xbd, 0x66,
+		0xcf, 0xe2, 0x7f, 0x78, 0x82, 0x5e, 0xc8, 0xfa, 0x10, 0x7e, 0xd7, 0xd0,
+		0xa3, 0x73, 0xe6, 0x89, 0xe9, 0x04, 0xd8, 0x4b, 0xb5, 0x63, 0x31, 0xc3,
+		0x5a, 0x14, 0xa6, 0xf5, 0x9e, 0x1a, 0x91, 0x1e, 0x41, 0xe9, 0xdc, 0xa9,
+		0x1f, 0x01, 0x40, 0xf0, 0x0c, 0x25, 0x2a, 0xe9, 0x55, 0x49, 0x4d, 0x88,
+		0x5a, 0x4a, 0x2e, 0x87, 0xe7, 0xa1, 0x93, 0x1f, 0x64, 0xbd, 0x1b, 0x8f,
+		0xde, 0x7b, 0x80, 0x76, 0xdc, 0x0f, 0x64, 0x5d, 0x67, 0xad, 0xcd, 0x0d,
+		0x75, 0x58, 0xb2, 0x37, 0xd2, 0xd2, 0x78, 0x74, 0x14, 0x04, 0x2e, 0x5c,
+		0x7e, 0xfb, 0x84, 0x12, 0xfe, 0xb5, 0xa6, 0x20, 0xa4, 0x5c, 0x55, 0x49,
+		0x9b, 0x77, 0x73, 0xa1, 0xba, 0xe6, 0x0c, 0x22, 0x93, 0x5c, 0x62, 0x62,
+		0x14, 0x06, 0xba, 0xc0, 0x1a, 0xff, 0x30, 0x29, 0xfe, 0xf6, 0x93, 0xe9,
+		0x1c, 0xbe, 0x24, 0x0b, 0x52, 0xa5, 0xc3, 0x46, 0x7c, 0x7d, 0x7e, 0x5c,
+		0xac, 0x27, 0xec, 0x8c, 0x72, 0x0c, 0x99, 0x3c, 0xfa, 0x32, 0x46, 0x1b,
+		0x31, 0x07, 0xed, 0xb5, 0xc7, 0xf5, 0x1c, 0x85, 0x1e, 0x88, 0xce, 0xf1,
+		0x70, 0x3e, 0xa5, 0xd1, 0xcf, 0x2d, 0x8d, 0x0e, 0xd1, 0xf7, 0x00, 0x50,
+		0x4b, 0x07, 0x08, 0x5f, 0x0b, 0x05, 0xbb, 0x24, 0x01, 0x00, 0x00, 0x19,
+		0x02, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08,
+		0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x2e, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74,
+		0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70,
+		0x65, 0x74, 0x73, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x67, 0x6f,
+		0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x2d, 0x77, 0x61,
+		0x72, 0x6e, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x54, 0x05, 0x00, 0x01,
+		0x00, 0xa6, 0xce, 0x12, 0x64, 0x8f, 0x41, 0x4e, 0xc3, 0x30, 0x14, 0x44,
+		0xf7, 0x39, 0xc5, 0xe8, 0xaf, 0x00, 0xa1, 0x1e, 0x20, 0x4b, 0x4e, 0x80,
+		0x10, 0x88, 0x05, 0xed, 0xc2, 0x38, 0x93, 0xd8, 0x22, 0xf6, 0xaf, 0x6c,
+		0xd3, 0x2a, 0x42, 0x3d, 0x03, 0x7b, 0x56, 0x1c, 0x83, 0xf3, 0x70, 0x01,
+		0xae, 0x80, 0xdc, 0x40, 0x54, 0xc4, 0xac, 0xec, 0xf9, 0x9a, 0x37, 0x9a,
+		0x97, 0x06, 0x90, 0x8e, 0xd9, 0x26, 0xbf, 0x2d, 0x5e, 0xa3, 0xb4, 0x90,
+		0x7b, 0x93, 0x22, 0x1e, 0xd9, 0x6b, 0x22, 0x06, 0xc5, 0xc0, 0xc8, 0x64,
+		0x0a, 0x91, 0xb8, 0x4f, 0xbe, 0x30, 0xa3, 0xf7, 0x23, 0xb3, 0x5c, 0xd6,
+		0x28, 0x77, 0x8c, 0xa5, 0x86, 0xae, 0x13, 0x6f, 0x55, 0xc7, 0xbb, 0xcc,
+		0xf9, 0x90, 0x8b, 0xb1, 0x4f, 0x59, 0x5a, 0x3c, 0xc8, 0xa0, 0xb2, 0x39,
+		0x7a, 0x4e, 0x75, 0xb6, 0x1a, 0x00, 0xa8, 0xd5, 0x55, 0x12, 0x4c, 0xb1,
+		0x8e, 0xa9, 0x52, 0xae, 0x4c, 0x76, 0x67, 0x27, 0x9d, 0x17, 0xe7, 0x47,
+		0x1a, 0xf0, 0x2f, 0x7d, 0x4a, 0xa8, 0x92, 0x32, 0x6d, 0x59, 0x11, 0x56,
+		0x43, 0x30, 0xb1, 0x5b, 0x72, 0x55, 0x8b, 0xd9, 0x42, 0x68, 0x9d, 0x62,
+		0x2d, 0x9f, 0x6f, 0xef, 0x5f, 0x1f, 0xaf, 0x40, 0x1d, 0xeb, 0xe3, 0xd0,
+		0xfe, 0x59, 0x1a, 0xcc, 0x84, 0xa0, 0x9d, 0xef, 0xa7, 0x79, 0xeb, 0x0a,
+		0x37, 0xdc, 0x79, 0xee, 0x61, 0x9d, 0x89, 0x03, 0x33, 0xac, 0x49, 0xec,
+		0x9f, 0xc7, 0x71, 0x5a, 0xad, 0x45, 0x96, 0xa2, 0xc3, 0xcf, 0x6b, 0xd3,
+		0xfc, 0xfe, 0x36, 0xcd, 0xa1, 0xf9, 0x1e, 0x00, 0x50, 0x4b, 0x07, 0x08,
+		0xd2, 0x2a, 0x72, 0x76, 0xdc, 0x00, 0x00, 0x00, 0x63, 0x01, 0x00, 0x00,
 		0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x19, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61,
-		0x75, 0x64, 0x65, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x73,
-		0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01,
-		0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00,
-		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x32, 0x00, 0x09, 0x00, 0x64, 0x6f,
-		0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x73, 0x6e, 0x69, 0x70,
-		0x70, 0x65, 0x74, 0x73, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x66,
-		0x6c, 0x75, 0x74, 0x74, 0x65, 0x72, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61,
-		0x73, 0x65, 0x2d, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
-		0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x6c, 0x8e, 0xcd,
-		0x4a, 0xc3, 0x40, 0x10, 0xc7, 0xef, 0x79, 0x8a, 0xbf, 0x7b, 0xe9, 0x07,
-		0xd6, 0x82, 0xc7, 0xe4, 0xd4, 0x42, 0x0e, 0x42, 0xa4, 0xa0, 0x07, 0x0f,
-		0x69, 0x28, 0x93, 0xcd, 0x84, 0x5d, 0xb2, 0xc9, 0x86, 0xcc, 0x06, 0x14,
-		0xf5, 0x09, 0x7c, 0x05, 0x9f, 0xce, 0x27, 0x91, 0xc6, 0xd6, 0x53, 0x4e,
-		0xf3, 0xf1, 0x63, 0xe6, 0xff, 0xcb, 0x23, 0xe0, 0x3d, 0x02, 0x00, 0xd5,
-		0x52, 0xd0, 0x86, 0x07, 0x15, 0x43, 0xed, 0x49, 0xcc, 0xb2, 0x76, 0x63,
-		0x08, 0x3c, 0xa0, 0x1c, 0xad, 0xab, 0x40, 0x7d, 0xb3, 0x5e, 0x7d, 0xcc,
-		0x00, 0xeb, 0x65, 0x1e, 0x50, 0xdf, 0x97, 0x63, 0x57, 0x39, 0xfe, 0xc7,
-		0x24, 0xc1, 0x51, 0xc7, 0xeb, 0x95, 0xba, 0xfd, 0x8b, 0x34, 0xde, 0x37,
-		0xa2, 0x62, 0xe4, 0xd3, 0x78, 0x35, 0x99, 0x50, 0x78, 0xeb, 0xf9, 0xac,
-		0xa2, 0x7d, 0xdb, 0x52, 0x57, 0x5d, 0x2e, 0x26, 0x74, 0x5d, 0xc5, 0x50,
-		0x25, 0x89, 0xc1, 0xc6, 0x69, 0x2c, 0x6c, 0x8d, 0x1c, 0x37, 0xd8, 0xd4,
-		0xb8, 0xd3, 0x8e, 0xc6, 0x8a, 0xb7, 0xc2, 0x22, 0xd6, 0x77, 0xdb, 0x5d,
-		0x96, 0x1d, 0x5e, 0x4e, 0x8f, 0x87, 0xfd, 0x43, 0x96, 0x9e, 0x9e, 0xd2,
-		0x2c, 0xdd, 0x3d, 0xa7, 0x28, 0x12, 0x04, 0xc3, 0x1d, 0x58, 0x1b, 0x8f,
-		0xa3, 0xfa, 0xf9, 0xfe, 0x42, 0xeb, 0x4b, 0xeb, 0x18, 0x03, 0x3b, 0x26,
-		0x61, 0x94, 0xce, 0xeb, 0x86, 0x2b, 0x2c, 0x85, 0x03, 0xe6, 0x7e, 0xac,
-		0x8e, 0x2a, 0x01, 0xbf, 0xda, 0x80, 0xfb, 0x04, 0xb5, 0x5d, 0xa8, 0x8b,
-		0xe1, 0xe7, 0x54, 0x8b, 0xe8, 0xdc, 0x15, 0xd1, 0x6f, 0x00, 0x00, 0x00,
-		0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0x0e, 0x9e, 0x83, 0x0e, 0xe9, 0x00,
-		0x00, 0x00, 0x61, 0x01, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00,
-		0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2e, 0x00, 0x09, 0x00,
-		0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x73, 0x6e,
-		0x69, 0x70, 0x70, 0x65, 0x74, 0x73, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
-		0x2f, 0x67, 0x6f, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-		0x2d, 0x77, 0x61, 0x72, 0x6e, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x54,
-		0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x4c, 0x8d, 0x3d, 0x4e, 0xc4,
-		0x30, 0x10, 0x85, 0x7b, 0x9f, 0xe2, 0x69, 0x2a, 0x40, 0x28, 0x07, 0x70,
-		0xc9, 0x11, 0x68, 0x28, 0x92, 0x14, 0x23, 0x67, 0x62, 0x5b, 0xc4, 0x36,
-		0xb2, 0x03, 0xc8, 0x42, 0x9c, 0x81, 0x9e, 0x8a, 0x63, 0x70, 0x9e, 0xbd,
-		0xc0, 0x5e, 0x61, 0x15, 0x6f, 0x22, 0x6d, 0x35, 0x3f, 0xef, 0x7d, 0xfa,
-		0x7a, 0x05, 0x7c, 0x29, 0x00, 0xa0, 0xc0, 0xab, 0x71, 0x92, 0x49, 0x83,
-		0x9e, 0xb8, 0xb8, 0x3b, 0x9b, 0x60, 0x25, 0x4a, 0xe6, 0x55, 0x1e, 0xee,
-		0xe9, 0xf1, 0x5a, 0x72, 0x29, 0xbd, 0x16, 0xd2, 0xe8, 0xdb, 0x79, 0xb0,
-		0x2d, 0x5a, 0xeb, 0x9b, 0x6c, 0xb0, 0x49, 0x21, 0x70, 0x9c, 0x76, 0xa2,
-		0x45, 0xc7, 0x4b, 0x83, 0xc4, 0xb8, 0x84, 0x81, 0x4e, 0xbf, 0x7f, 0xe7,
-		0xff, 0x1f, 0xe0, 0x85, 0x73, 0xf4, 0xd1, 0x6a, 0xdc, 0xd8, 0x10, 0xb8,
-		0x22, 0xa4, 0xc9, 0xcf, 0x15, 0xb3, 0x5f, 0xa4, 0x74, 0x78, 0x96, 0x0f,
-		0x2f, 0x9f, 0x30, 0x8e, 0xa3, 0x95, 0x02, 0xc3, 0x59, 0xe6, 0xf7, 0x65,
-		0xa9, 0xdd, 0x40, 0xb4, 0x6b, 0xbe, 0xdb, 0x1c, 0xd5, 0xb6, 0x8d, 0xea,
-		0x12, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08, 0xe7, 0x7c, 0xff,
-		0x12, 0xa6, 0x00, 0x00, 0x00, 0xd8, 0x00, 0x00, 0x00, 0x50, 0x4b, 0x03,
+		0x00, 0x00, 0x14, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61,
+		0x75, 0x64, 0x65, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+		0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50,
+		0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x2f, 0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
+		0x64, 0x65, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+		0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+		0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+		0x2e, 0x74, 0x78, 0x74, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce,
+		0x12, 0x14, 0xca, 0xb1, 0x0a, 0xc2, 0x30, 0x10, 0x06, 0xe0, 0x3d, 0x4f,
+		0xf1, 0x8f, 0x0a, 0x16, 0x41, 0x70, 0x91, 0x12, 0xf0, 0x05, 0x9c, 0x04,
+		0xe7, 0x18, 0x2f, 0xcd, 0x41, 0x92, 0x0b, 0xb9, 0xab, 0xd2, 0xb7, 0x97,
+		0xee, 0xdf, 0x6c, 0x5b, 0x27, 0x7f, 0xd0, 0x28, 0x9d, 0x8e, 0x37, 0x68,
+		0x96, 0x61, 0x27, 0x68, 0xa7, 0xc8, 0x89, 0x23, 0x74, 0xad, 0x35, 0x8c,
+		0xcd, 0xb9, 0x57, 0xde, 0xdc, 0x84, 0x59, 0xb9, 0x2d, 0x85, 0x50, 0x45,
+		0x0d, 0x5c, 0xbb, 0x0c, 0x0b, 0xcd, 0x30, 0x28, 0xa8, 0x34, 0xbf, 0xab,
+		0x60, 0x3b, 0xbb, 0x4c, 0x57, 0xbc, 0xd7, 0x52, 0xc8, 0x14, 0x92, 0x50,
+		0x29, 0x34, 0x6e, 0x4b, 0x5a, 0x0b, 0x62, 0x0e, 0x6d, 0x21, 0xf5, 0xce,
+		0x3d, 0x49, 0x4d, 0x77, 0x9c, 0xe5, 0x87, 0x2f, 0x0d, 0x4e, 0x4c, 0x1f,
+		0xc8, 0xc0, 0xe3, 0x7c, 0xf7, 0xee, 0x3f, 0x00, 0x50, 0x4b, 0x07, 0x08,
+		0xc8, 0x42, 0x9d, 0x2a, 0x7f, 0x00, 0x00, 0x00, 0x98, 0x00, 0x00, 0x00,
+		0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00,
+		0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x09, 0x00, 0x09, 0x00, 0x70, 0x61, 0x63, 0x6b, 0x2e, 0x6a,
+		0x73, 0x6f, 0x6e, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
+		0x7c, 0x92, 0x4d, 0x6f, 0xdb, 0x3c, 0x0c, 0xc7, 0xef, 0xfe, 0x14, 0x84,
+		0x8e, 0x85, 0x23, 0xf7, 0xb9, 0xe6, 0x39, 0x0d, 0x7b, 0xe9, 0x65, 0x18,
+		0x0a, 0x6c, 0x3b, 0x0d, 0x41, 0xa1, 0xca, 0xb4, 0xad, 0x5a, 0x2f, 0x9c,
+		0x48, 0x27, 0xf3, 0x8a, 0x7e, 0xf7, 0x41, 0x4e, 0x8a, 0x05, 0x5e, 0x52,
+		0x9f, 0x0c, 0xfe, 0x4d, 0xfa, 0xf7, 0xa3, 0xf4, 0x5c, 0x01, 0xa8, 0x3d,
+		0x66, 0x76, 0x29, 0xaa, 0x2d, 0xa8, 0xff, 0xf4, 0xad, 0xbe, 0xdd, 0xb4,
+		0xb8, 0x57, 0x75, 0x49, 0x82, 0x8b, 0x0f, 0xd6, 0xbb, 0x87, 0xf5, 0x17,
+		0xc7, 0xb4, 0x73, 0x1e, 0x59, 0x6d, 0xe1, 0x47, 0x05, 0x00, 0xf0, 0x0c,
+		0x8a, 0x8c, 0x0c, 0x65, 0xca, 0xfb, 0xcf, 0xef, 0xbe, 0x7f, 0xf8, 0xa8,
+		0x43, 0xab, 0x6a, 0x50, 0x94, 0xbc, 0xb3, 0x73, 0x29, 0x33, 0x62, 0xbb,
+		0x49, 0xd1, 0xa2, 0x82, 0x97, 0x7a, 0xdd, 0xa3, 0xad, 0x37, 0x53, 0x8b,
+		0x8d, 0x60, 0x20, 0x6f, 0x04, 0xb9, 0xb9, 0xb9, 0xb9, 0xde, 0x5e, 0x01,
+		0xec, 0xca, 0x08, 0xc5, 0x62, 0xec, 0x78, 0x06, 0xb1, 0x8c, 0x05, 0x50,
+		0x23, 0x2e, 0x3d, 0x7d, 0x5a, 0x50, 0xcb, 0xa3, 0xbc, 0x79, 0x44, 0x5f,
+		0x8a, 0x77, 0x67, 0xc5, 0x16, 0xd9, 0x66, 0x47, 0x72, 0xf2, 0xbf, 0x4b,
+		0x10, 0x52, 0x3b, 0x79, 0xe4, 0x2d, 0xf4, 0xa9, 0x0b, 0xd2, 0xec, 0x51,
+		0x1a, 0x41, 0x16, 0xe8, 0x8d, 0x60, 0x0d, 0x7d, 0xda, 0xd8, 0x01, 0xed,
+		0x08, 0x36, 0x85, 0x60, 0x62, 0x7b, 0x3e, 0x49, 0xd0, 0x4a, 0x21, 0x51,
+		0x7d, 0xd2, 0x21, 0x2d, 0xf2, 0x7d, 0xd2, 0x87, 0x94, 0x47, 0xb5, 0x5b,
+		0xfe, 0xf7, 0x6a, 0xbd, 0x82, 0x94, 0x99, 0x4e, 0x14, 0x17, 0x60, 0xbf,
+		0xcd, 0x84, 0x5f, 0x17, 0x44, 0x68, 0xe0, 0x4b, 0x6a, 0xf1, 0x2a, 0x7b,
+		0x09, 0x81, 0x72, 0x7a, 0x42, 0x2b, 0xbc, 0x05, 0x61, 0x5b, 0x03, 0xb2,
+		0x77, 0x51, 0xc0, 0xc4, 0x16, 0x28, 0xa3, 0x88, 0xc3, 0x0c, 0x84, 0x39,
+		0x38, 0x2e, 0xe7, 0xc9, 0x17, 0xe9, 0xc9, 0xd8, 0xd1, 0xf4, 0xa8, 0x9f,
+		0x38, 0xc5, 0xe2, 0x20, 0x6c, 0x53, 0xec, 0x5c, 0x7f, 0x2c, 0xbc, 0x69,
+		0x42, 0xb3, 0x0c, 0xa5, 0xe9, 0x9f, 0x95, 0xdf, 0xaf, 0x82, 0xd5, 0xda,
+		0xf3, 0xd4, 0x75, 0x0d, 0xcd, 0x67, 0x7b, 0x9e, 0xf6, 0x47, 0x68, 0x47,
+		0x60, 0x0e, 0x26, 0x63, 0x44, 0xbe, 0x42, 0x3b, 0x9f, 0x94, 0xb5, 0xa4,
+		0xe0, 0x0b, 0x6f, 0xc6, 0x9f, 0x93, 0xcb, 0x18, 0x30, 0x0a, 0x6b, 0xf9,
+		0x25, 0xa5, 0xc6, 0x28, 0x13, 0x69, 0x9a, 0xcb, 0xfb, 0xbd, 0xa3, 0x72,
+		0x73, 0xdf, 0x36, 0xe9, 0xfc, 0x24, 0x82, 0xf9, 0x82, 0xca, 0xa7, 0x75,
+		0xb2, 0x72, 0x39, 0x75, 0x82, 0x89, 0xc6, 0xcf, 0xbf, 0xf1, 0xef, 0xe5,
+		0xf9, 0x1f, 0xc8, 0xb8, 0xcc, 0x70, 0x70, 0x32, 0x80, 0x0c, 0x08, 0x19,
+		0x3d, 0x1a, 0xc6, 0x4d, 0x09, 0x81, 0xa3, 0x23, 0x42, 0xb9, 0xec, 0x38,
+		0x3d, 0x32, 0xa1, 0xd5, 0xb3, 0x09, 0xfe, 0x15, 0xbb, 0x02, 0xd8, 0x55,
+		0x2f, 0xd5, 0x9f, 0x01, 0x00, 0x50, 0x4b, 0x07, 0x08, 0x08, 0xdc, 0x2c,
+		0x7a, 0xa9, 0x01, 0x00, 0x00, 0xc1, 0x03, 0x00, 0x00, 0x50, 0x4b, 0x03,
 		0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14,
-		0x00, 0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65,
-		0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x55,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07,
+		0x00, 0x09, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x55, 0x54,
+		0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x03, 0x04, 0x14,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x00, 0x09,
+		0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x66, 0x6c, 0x75, 0x74,
+		0x74, 0x65, 0x72, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce,
+		0x12, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00,
+		0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x18, 0x00, 0x09, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b,
+		0x73, 0x2f, 0x66, 0x6c, 0x75, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x43, 0x4c,
+		0x41, 0x55, 0x44, 0x45, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01,
+		0x00, 0xa6, 0xce, 0x12, 0x44, 0x8f, 0x31, 0x6e, 0xe3, 0x30, 0x10, 0x45,
+		0x7b, 0x9d, 0xe2, 0x03, 0x6e, 0x76, 0x81, 0xa5, 0x0e, 0xb0, 0xc6, 0xb6,
+		0x9b, 0x22, 0x5d, 0x4e, 0xa0, 0x91, 0xf8, 0x2d, 0x11, 0x1e, 0x93, 0x04,
+		0x67, 0x18, 0x3b, 0x81, 0x0f, 0x1f, 0x44, 0x06, 0x9c, 0xf6, 0x61, 0x30,
+		0xef, 0xfd, 0xc3, 0x01, 0xff, 0xb5, 0xbb, 0xb3, 0x0d, 0x43, 0xc0, 0x8b,
+		0x38, 0xff, 0x62, 0x8a, 0xd2, 0x1c, 0xa7, 0xd2, 0x2e, 0xe2, 0x08, 0xa1,
+		0x74, 0xaf, 0xdd, 0xff, 0xe5, 0x92, 0x89, 0x10, 0x8c, 0x1e, 0x78, 0x4b,
+		0x1e, 0xd2, 0x29, 0x2c, 0x9b, 0xe4, 0x95, 0x11, 0xe3, 0xf4, 0x07, 0xd3,
+		0xe9, 0xf1, 0x06, 0x92, 0x45, 0x3f, 0x3e, 0x39, 0x41, 0x72, 0xfc, 0xa1,
+		0x4e, 0xf3, 0x09, 0xd2, 0x88, 0xb5, 0x91, 0x79, 0x1c, 0x02, 0xde, 0xa8,
+		0x14, 0x23, 0xe6, 0x9e, 0x34, 0x1a, 0x7e, 0x3d, 0x6f, 0x77, 0x00, 0xa9,
+		0xe7, 0x7b, 0x2a, 0x76, 0x97, 0x5a, 0xe7, 0x9e, 0xa3, 0x72, 0x97, 0x88,
+		0xb9, 0x4a, 0xe6, 0xf4, 0x1b, 0x99, 0x8c, 0xe0, 0xad, 0x6a, 0x5a, 0x92,
+		0x63, 0xeb, 0x17, 0xc9, 0x90, 0x5a, 0x5b, 0x79, 0x17, 0x3d, 0xc2, 0x48,
+		0xf8, 0xc6, 0x67, 0xc0, 0xd8, 0x1e, 0xb6, 0xb0, 0x8a, 0x73, 0xc2, 0x56,
+		0xca, 0x19, 0x96, 0x53, 0xad, 0xf4, 0xef, 0x96, 0x57, 0xb2, 0xe2, 0x9a,
+		0xe2, 0x4a, 0x37, 0xd8, 0x45, 0x54, 0xf7, 0x7c, 0x73, 0x71, 0x2a, 0xcd,
+		0x70, 0xdd, 0xd8, 0x88, 0x5a, 0xcc, 0xd2, 0xac, 0x3c, 0xa2, 0x76, 0x87,
+		0x96, 0x35, 0x2d, 0x98, 0xb9, 0xa5, 0x1c, 0xf7, 0x81, 0x32, 0x2b, 0xb1,
+		0xa8, 0x98, 0xd1, 0xc6, 0xe1, 0x6b, 0x00, 0x50, 0x4b, 0x07, 0x08, 0x80,
+		0x43, 0x2b, 0x2f, 0xe7, 0x00, 0x00, 0x00, 0x5b, 0x01, 0x00, 0x00, 0x50,
+		0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x1c, 0x00, 0x09, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f,
+		0x66, 0x6c, 0x75, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x74, 0x74,
+		0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x54, 0x05,
+		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x74, 0x8e, 0x41, 0x0a, 0xc2, 0x30,
+		0x10, 0x45, 0xf7, 0x39, 0xc5, 0x67, 0x56, 0x2a, 0xed, 0x05, 0x02, 0x6e,
+		0xbc, 0x86, 0xb8, 0x18, 0x69, 0x5a, 0x8b, 0x69, 0x12, 0x92, 0x19, 0x44,
+		0xa5, 0x77, 0x97, 0x14, 0x45, 0x04, 0xbb, 0x9c, 0x99, 0xf7, 0xe6, 0xff,
+		0xa7, 0x01, 0x28, 0xb9, 0x3c, 0x8d, 0xa5, 0x8c, 0x31, 0x14, 0xb2, 0xa8,
+		0x2b, 0x80, 0xd8, 0xfb, 0x78, 0x23, 0x8b, 0xe3, 0x32, 0x02, 0x74, 0xe0,
+		0x72, 0xd9, 0xf4, 0x5e, 0x45, 0x5c, 0x06, 0x07, 0xf6, 0xf7, 0x87, 0xb3,
+		0xbb, 0x2d, 0x35, 0x3f, 0x40, 0xc7, 0x59, 0xd0, 0xc7, 0x3c, 0xb1, 0xa0,
+		0x6d, 0xa3, 0x4a, 0x52, 0xd9, 0x87, 0x18, 0xfe, 0xa0, 0x9f, 0x5f, 0x49,
+		0xcf, 0x18, 0x9c, 0x54, 0x60, 0xb9, 0x9f, 0x9a, 0x77, 0x83, 0x72, 0x5d,
+		0xcd, 0xaf, 0x8e, 0xa6, 0x21, 0x73, 0xe7, 0xbe, 0x9e, 0x01, 0x66, 0x33,
+		0x9b, 0xd7, 0x00, 0x50, 0x4b, 0x07, 0x08, 0x89, 0xf5, 0x31, 0x84, 0x81,
+		0x00, 0x00, 0x00, 0xd4, 0x00, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x00, 0x09,
+		0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x55,
 		0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x03, 0x04,
 		0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2f, 0x00,
-		0x09, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f,
-		0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x63, 0x6f,
-		0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-		0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x74, 0x78,
-		0x74, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x14, 0xca,
-		0xb1, 0x0a, 0xc2, 0x30, 0x10, 0x06, 0xe0, 0x3d, 0x4f, 0xf1, 0x8f, 0x0a,
-		0x16, 0x41, 0x70, 0x91, 0x12, 0xf0, 0x05, 0x9c, 0x04, 0xe7, 0x18, 0x2f,
-		0xcd, 0x41, 0x92, 0x0b, 0xb9, 0xab, 0xd2, 0xb7, 0x97, 0xee, 0xdf, 0x6c,
-		0x5b, 0x27, 0x7f, 0xd0, 0x28, 0x9d, 0x8e, 0x37, 0x68, 0x96, 0x61, 0x27,
-		0x68, 0xa7, 0xc8, 0x89, 0x23, 0x74, 0xad, 0x35, 0x8c, 0xcd, 0xb9, 0x57,
-		0xde, 0xdc, 0x84, 0x59, 0xb9, 0x2d, 0x85, 0x50, 0x45, 0x0d, 0x5c, 0xbb,
-		0x0c, 0x0b, 0xcd, 0x30, 0x28, 0xa8, 0x34, 0xbf, 0xab, 0x60, 0x3b, 0xbb,
-		0x4c, 0x57, 0xbc, 0xd7, 0x52, 0xc8, 0x14, 0x92, 0x50, 0x29, 0x34, 0x6e,
-		0x4b, 0x5a, 0x0b, 0x62, 0x0e, 0x6d, 0x21, 0xf5, 0xce, 0x3d, 0x49, 0x4d,
-		0x77, 0x9c, 0xe5, 0x87, 0x2f, 0x0d, 0x4e, 0x4c, 0x1f, 0xc8, 0xc0, 0xe3,
-		0x7c, 0xf7, 0xee, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4b, 0x07, 0x08,
-		0xc8, 0x42, 0x9d, 0x2a, 0x82, 0x00, 0x00, 0x00, 0x98, 0x00, 0x00, 0x00,
-		0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x13, 0x00,
+		0x09, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x67, 0x6f, 0x2f,
+		0x43, 0x4c, 0x41, 0x55, 0x44, 0x45, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05,
+		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x4c, 0x90, 0xc1, 0xca, 0xdb, 0x30,
+		0x10, 0x84, 0xef, 0x7e, 0x8a, 0xe1, 0xff, 0x29, 0x38, 0x10, 0x2b, 0xf7,
+		0xe4, 0xd8, 0x84, 0xd0, 0x4b, 0x72, 0x09, 0xf4, 0x58, 0xa9, 0xd2, 0xd8,
+		0x16, 0xb5, 0x25, 0xb3, 0x5a, 0x27, 0xcd, 0xdb, 0x17, 0x19, 0x4a, 0x7b,
+		0x9d, 0x6f, 0x46, 0xa3, 0xd9, 0xcf, 0x4f, 0x5c, 0x73, 0xd3, 0x74, 0xb8,
+		0x3a, 0xe5, 0x11, 0x76, 0xc8, 0xfd, 0xac, 0xe8, 0x26, 0x18, 0x8b, 0x58,
+		0xc0, 0x79, 0xd1, 0xf7, 0xbe, 0xca, 0x78, 0x52, 0x61, 0x0e, 0xc6, 0x18,
+		0x0b, 0x97, 0xc2, 0x26, 0x29, 0xcb, 0x3f, 0x4d, 0x88, 0x41, 0xc8, 0x84,
+		0xd6, 0x76, 0xe2, 0x3c, 0x2d, 0x5e, 0x23, 0x13, 0x34, 0xaf, 0x7e, 0x8c,
+		0x69, 0x80, 0xcf, 0xc9, 0xaf, 0x22, 0x4c, 0xfe, 0xbd, 0x33, 0x4d, 0x87,
+		0xef, 0xe2, 0x16, 0x50, 0x24, 0x4b, 0xc1, 0x2b, 0xea, 0x58, 0x0d, 0xca,
+		0xdf, 0x8a, 0xd6, 0xf6, 0xb3, 0x9a, 0x4b, 0x25, 0x7d, 0xfb, 0x31, 0x65,
+		0x17, 0x2a, 0xea, 0xe3, 0x70, 0xc4, 0x97, 0xd7, 0xc7, 0xbe, 0x66, 0x76,
+		0x76, 0x77, 0x42, 0xca, 0x58, 0x5c, 0x8a, 0xbe, 0x20, 0xaf, 0x5a, 0x62,
+		0x20, 0xec, 0xec, 0x62, 0xb2, 0xf5, 0xf1, 0x1b, 0x9f, 0x14, 0x8c, 0x2e,
+		0x85, 0x8e, 0x21, 0x2a, 0x06, 0x26, 0x8a, 0x53, 0x06, 0xf4, 0x71, 0x62,
+		0x41, 0x6b, 0x0f, 0x07, 0x7c, 0xcd, 0x81, 0xff, 0x11, 0x63, 0x0c, 0xce,
+		0x77, 0xdc, 0xee, 0x0f, 0x5c, 0xce, 0xdf, 0x1e, 0xa6, 0x76, 0xf8, 0xd1,
+		0xa5, 0x81, 0xd0, 0x91, 0x28, 0x79, 0x15, 0xcf, 0x6d, 0xbb, 0xb0, 0x93,
+		0x35, 0x6d, 0x27, 0xf8, 0x1b, 0xdf, 0x5a, 0x1f, 0xee, 0xe7, 0xc4, 0x2e,
+		0x48, 0x7c, 0xd6, 0xe1, 0x2c, 0x5a, 0xd0, 0x67, 0xa9, 0xbf, 0x57, 0x71,
+		0x5e, 0xe1, 0x5d, 0x61, 0x39, 0xe1, 0x17, 0xb9, 0x60, 0xe4, 0xb4, 0x50,
+		0x0a, 0x62, 0x82, 0xfd, 0x51, 0xbd, 0x66, 0xc8, 0x16, 0x7d, 0x9c, 0x58,
+		0x4c, 0xf3, 0x67, 0x00, 0x50, 0x4b, 0x07, 0x08, 0x44, 0xd2, 0x90, 0xce,
+		0x12, 0x01, 0x00, 0x00, 0x96, 0x01, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04,
+		0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x13, 0x00,
+		0x09, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x67, 0x6f, 0x2f,
+		0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x55, 0x54, 0x05,
+		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00,
+		0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1e, 0x00, 0x09, 0x00,
+		0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f,
+		0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x67, 0x6f, 0x2d, 0x63, 0x68,
+		0x65, 0x63, 0x6b, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00,
+		0xa6, 0xce, 0x12, 0x54, 0x50, 0xbb, 0x6e, 0x84, 0x30, 0x10, 0xec, 0xf9,
+		0x8a, 0x29, 0x8f, 0x13, 0xf8, 0x94, 0xa4, 0xe3, 0xba, 0x34, 0x69, 0xa3,
+		0xfb, 0x02, 0x36, 0xb0, 0x06, 0x2b, 0xc6, 0x8b, 0xbc, 0x4b, 0x12, 0x45,
+		0xf7, 0xf1, 0x91, 0xd1, 0x51, 0xa4, 0xb2, 0x66, 0xc6, 0xf3, 0xd0, 0xb6,
+		0x6d, 0x5b, 0x8d, 0xac, 0x43, 0x0e, 0xab, 0x05, 0x49, 0x1d, 0x6e, 0x5b,
+		0x82, 0xcd, 0x8c, 0x37, 0xc1, 0x44, 0xc6, 0x38, 0x79, 0xc9, 0x0b, 0x59,
+		0x83, 0x2f, 0xb6, 0x06, 0xc6, 0x6a, 0x5a, 0x83, 0xd2, 0x08, 0xdd, 0x96,
+		0x85, 0x72, 0xf8, 0x65, 0x78, 0x0a, 0x71, 0xcb, 0xac, 0x15, 0xc5, 0x28,
+		0xdf, 0x3c, 0xb6, 0x26, 0x12, 0xb5, 0xc3, 0x2b, 0xe9, 0x7c, 0x9a, 0xc4,
+		0x2f, 0x86, 0x36, 0x76, 0xe7, 0xba, 0x39, 0x98, 0x92, 0xf5, 0x0f, 0x97,
+		0xd8, 0xee, 0x5c, 0x57, 0x65, 0xcd, 0x53, 0xbd, 0x6f, 0xe8, 0x0f, 0x23,
+		0x5c, 0xdf, 0x14, 0x54, 0x4c, 0x70, 0x17, 0xe7, 0x0a, 0xb6, 0x99, 0xf7,
+		0x2f, 0xbb, 0xf3, 0xc1, 0x5e, 0xa1, 0x26, 0x2b, 0xc8, 0x8a, 0x0a, 0x1f,
+		0xb2, 0xda, 0x3e, 0x2d, 0xa4, 0x09, 0x6a, 0xbc, 0xba, 0xea, 0xb9, 0xc6,
+		0x8d, 0x57, 0xc9, 0x06, 0x82, 0xce, 0xe5, 0x35, 0xfa, 0x88, 0xdc, 0xa1,
+		0x2f, 0x3a, 0xee, 0x58, 0x69, 0xf8, 0xa4, 0x89, 0x2f, 0x3e, 0x44, 0xc6,
+		0xfd, 0x91, 0xc1, 0x39, 0x4b, 0x46, 0x0c, 0x89, 0x7b, 0x57, 0xbd, 0xd4,
+		0x78, 0xcf, 0xb2, 0x8a, 0xf2, 0xde, 0xa2, 0x0b, 0xc5, 0xc8, 0xa5, 0x28,
+		0xfc, 0xc0, 0x4b, 0x06, 0xd3, 0x30, 0x1f, 0x07, 0xb9, 0x62, 0x14, 0x24,
+		0x31, 0xf0, 0x18, 0x0c, 0x5b, 0xb2, 0x10, 0x31, 0x48, 0xf2, 0x21, 0x2f,
+		0x3c, 0xba, 0xea, 0x6f, 0x00, 0x50, 0x4b, 0x07, 0x08, 0xfe, 0x70, 0x69,
+		0xfb, 0xf2, 0x00, 0x00, 0x00, 0x7b, 0x01, 0x00, 0x00, 0x50, 0x4b, 0x03,
+		0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x17,
+		0x00, 0x09, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x67, 0x6f,
+		0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6a, 0x73,
+		0x6f, 0x6e, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x6c,
+		0x8d, 0x41, 0x0a, 0xc2, 0x40, 0x0c, 0x45, 0xf7, 0x39, 0xc5, 0x27, 0x2b,
+		0x95, 0x7a, 0x81, 0x59, 0x7a, 0x0d, 0xe9, 0xa2, 0x32, 0xb5, 0x06, 0x33,
+		0x46, 0xcc, 0xa8, 0x88, 0xf4, 0xee, 0xd2, 0xd1, 0x95, 0x64, 0xf9, 0xff,
+		0x83, 0xf7, 0xde, 0x04, 0xf0, 0x75, 0xbc, 0x15, 0x71, 0x17, 0xbb, 0x38,
+		0x27, 0x2c, 0x17, 0xc0, 0x83, 0xaa, 0x3d, 0x39, 0x61, 0xdf, 0x26, 0xc0,
+		0xbb, 0xc1, 0x4f, 0xab, 0xc9, 0x70, 0xb8, 0x8b, 0xe6, 0xb4, 0x59, 0x73,
+		0xf7, 0x4f, 0x1e, 0x63, 0x0d, 0x7f, 0x15, 0x0f, 0xc1, 0xb1, 0x54, 0x6c,
+		0x75, 0x21, 0x0d, 0xf4, 0xdd, 0xaf, 0xec, 0xe7, 0xa8, 0x5b, 0x2c, 0xa3,
+		0x4a, 0x7e, 0x45, 0x26, 0x4c, 0xdf, 0x74, 0x13, 0xf4, 0x04, 0xcc, 0x34,
+		0xd3, 0x67, 0x00, 0x50, 0x4b, 0x07, 0x08, 0x36, 0xaf, 0x29, 0xe1, 0x70,
+		0x00, 0x00, 0x00, 0xdc, 0x00, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0e, 0x00, 0x09,
+		0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x70, 0x79, 0x74, 0x68,
+		0x6f, 0x6e, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
+		0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00,
 		0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x07, 0x00, 0x09, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73,
-		0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b,
-		0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00,
-		0x21, 0x00, 0x30, 0x49, 0x74, 0xa9, 0x02, 0x04, 0x00, 0x00, 0xa4, 0x06,
-		0x00, 0x00, 0x09, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0xa4, 0x81, 0x00, 0x00, 0x00, 0x00, 0x43, 0x4c, 0x41, 0x55,
+		0x00, 0x00, 0x17, 0x00, 0x09, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73,
+		0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x2f, 0x43, 0x4c, 0x41, 0x55,
 		0x44, 0x45, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
+		0xce, 0x12, 0x3c, 0x8d, 0x41, 0x6e, 0xdb, 0x30, 0x14, 0x44, 0xf7, 0x3a,
+		0xc5, 0x00, 0x5e, 0xd8, 0x2e, 0x2a, 0x7b, 0xdf, 0x6e, 0x0b, 0x74, 0x5b,
+		0x14, 0x39, 0x00, 0x69, 0x7a, 0x24, 0x32, 0x92, 0x3e, 0x99, 0xcf, 0x4f,
+		0x3b, 0xbc, 0x7d, 0x60, 0x27, 0xc8, 0xf2, 0x3d, 0x60, 0xe6, 0xed, 0x76,
+		0xf8, 0xd7, 0x2d, 0x66, 0x19, 0x86, 0x11, 0x7f, 0xbd, 0xf1, 0x17, 0x9c,
+		0xb6, 0x69, 0x42, 0x88, 0x0c, 0x8b, 0xfb, 0xf9, 0x45, 0x53, 0xd6, 0xcd,
+		0x1b, 0xc6, 0xf1, 0x53, 0xe3, 0x90, 0x15, 0xee, 0xb2, 0xfa, 0xb0, 0x7c,
+		0xbb, 0x23, 0xbc, 0x5c, 0xe1, 0x4a, 0x37, 0x56, 0x73, 0xf0, 0x4a, 0xcc,
+		0x4a, 0xca, 0x69, 0x18, 0xf1, 0xbf, 0x09, 0x2c, 0xe7, 0xb5, 0xc2, 0xa2,
+		0xe6, 0x36, 0x47, 0x58, 0x24, 0x8a, 0xe6, 0x57, 0x06, 0xdb, 0x57, 0x50,
+		0x6e, 0x49, 0xb3, 0x6c, 0x14, 0xc3, 0xc1, 0xb5, 0x1b, 0xb4, 0xc9, 0xa3,
+		0x5d, 0x32, 0x4d, 0xfb, 0x93, 0x8e, 0x50, 0x6f, 0x91, 0x0a, 0x8b, 0x5e,
+		0x9e, 0xf3, 0xda, 0xab, 0x71, 0x43, 0x12, 0xa3, 0x16, 0xa5, 0x51, 0x1f,
+		0xa9, 0x97, 0x5e, 0x38, 0xc6, 0x24, 0x86, 0xd2, 0x2e, 0x6b, 0x0a, 0x98,
+		0x9a, 0x04, 0x4b, 0x59, 0xea, 0x6f, 0x2c, 0x64, 0x81, 0xdb, 0x7a, 0xe9,
+		0xee, 0xec, 0x4a, 0xd7, 0x34, 0x47, 0x73, 0x08, 0x2b, 0xbd, 0xe0, 0x1e,
+		0xa9, 0x44, 0xc8, 0x32, 0xa5, 0xb9, 0x29, 0xaf, 0x8f, 0xaf, 0x3f, 0x59,
+		0xf6, 0x06, 0x5e, 0x93, 0xc1, 0x29, 0xdf, 0x5a, 0x52, 0x6e, 0x14, 0xab,
+		0x3f, 0x4e, 0xf6, 0x6e, 0xee, 0xbc, 0xe6, 0xb0, 0x4c, 0x69, 0x65, 0xc5,
+		0x3d, 0x59, 0xcc, 0xcd, 0xe0, 0xeb, 0x92, 0x64, 0x3e, 0x0d, 0x1f, 0x03,
+		0x00, 0x50, 0x4b, 0x07, 0x08, 0x35, 0xdc, 0x02, 0x46, 0xef, 0x00, 0x00,
+		0x00, 0x56, 0x01, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08,
+		0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1b, 0x00, 0x09, 0x00, 0x73,
+		0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e,
+		0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6a, 0x73,
+		0x6f, 0x6e, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x74,
+		0xce, 0x4d, 0x0a, 0xc2, 0x30, 0x10, 0x05, 0xe0, 0x7d, 0x4e, 0xf1, 0x98,
+		0x95, 0x4a, 0x7b, 0x81, 0x2c, 0xbd, 0x86, 0xb8, 0x18, 0xda, 0x86, 0x86,
+		0xe6, 0x8f, 0x4c, 0x53, 0x29, 0xd2, 0xbb, 0x4b, 0x8a, 0xe0, 0x22, 0xb8,
+		0x9c, 0xf7, 0x86, 0x8f, 0xf7, 0x56, 0x00, 0xa5, 0x29, 0x7b, 0x2b, 0x62,
+		0x63, 0x10, 0xd2, 0xa8, 0x11, 0x40, 0xec, 0x5c, 0x7c, 0x91, 0xc6, 0xe3,
+		0x3c, 0x01, 0xba, 0xb3, 0xcc, 0x97, 0x5c, 0x8c, 0xc1, 0x30, 0x4f, 0xc3,
+		0xa2, 0x6f, 0x57, 0xea, 0xda, 0xce, 0xc4, 0xec, 0x79, 0x45, 0xdf, 0xff,
+		0x79, 0x2a, 0x1b, 0x72, 0x09, 0xa8, 0x4e, 0x5b, 0xfa, 0x3d, 0xed, 0x35,
+		0x3d, 0xd9, 0x67, 0xf7, 0x1d, 0x22, 0x4b, 0x33, 0xa3, 0x6c, 0xe0, 0x71,
+		0x6c, 0x81, 0x64, 0x13, 0x6c, 0x90, 0x95, 0x9d, 0xfb, 0x39, 0x0a, 0x38,
+		0xd4, 0xa1, 0x3e, 0x03, 0x00, 0x50, 0x4b, 0x07, 0x08, 0x21, 0xe3, 0xe1,
+		0x81, 0x7e, 0x00, 0x00, 0x00, 0xeb, 0x00, 0x00, 0x00, 0x50, 0x4b, 0x03,
+		0x04, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x12,
+		0x00, 0x09, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x74, 0x79,
+		0x70, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x2f, 0x55, 0x54, 0x05,
+		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00,
+		0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1b, 0x00, 0x09, 0x00,
+		0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+		0x63, 0x72, 0x69, 0x70, 0x74, 0x2f, 0x43, 0x4c, 0x41, 0x55, 0x44, 0x45,
+		0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
+		0x3c, 0xcd, 0xc1, 0x6a, 0xf3, 0x40, 0x0c, 0x04, 0xe0, 0xbb, 0x9f, 0x62,
+		0x20, 0x87, 0xfc, 0x3f, 0xc4, 0xe9, 0xbd, 0x39, 0x97, 0x1e, 0x0a, 0xa5,
+		0xd0, 0xf6, 0xbe, 0x8a, 0x77, 0xec, 0x6c, 0x63, 0x6b, 0x8d, 0x56, 0xdb,
+		0xe0, 0xb7, 0x2f, 0xdb, 0x40, 0x4f, 0x02, 0x49, 0xdf, 0xcc, 0x6e, 0x87,
+		0x8f, 0x6d, 0xe5, 0xfb, 0x60, 0x69, 0x75, 0x3c, 0xe0, 0x35, 0x47, 0x76,
+		0x5d, 0x8f, 0x67, 0x71, 0x3e, 0x22, 0x78, 0x19, 0xd0, 0xf7, 0x9a, 0x9f,
+		0x96, 0xe4, 0xe1, 0x00, 0xbf, 0x10, 0xab, 0xe5, 0x2f, 0x0e, 0xbe, 0x2f,
+		0x98, 0x93, 0x3a, 0xca, 0x5d, 0x8a, 0x46, 0x24, 0x2f, 0x70, 0x16, 0x87,
+		0x55, 0x55, 0x1a, 0xc4, 0x88, 0xc9, 0x48, 0x3d, 0x76, 0x3d, 0x3e, 0x0b,
+		0x7f, 0xf9, 0x9c, 0x87, 0xeb, 0x98, 0x66, 0xee, 0x0b, 0x56, 0x19, 0xae,
+		0x32, 0x11, 0x8b, 0xa8, 0x4c, 0x34, 0xfc, 0x0b, 0xab, 0xae, 0x4b, 0x38,
+		0x20, 0x6c, 0x62, 0xda, 0xe6, 0xb9, 0x6a, 0x40, 0x36, 0x84, 0xb6, 0xff,
+		0x7f, 0x82, 0xf2, 0x9b, 0x06, 0xc6, 0xe4, 0x7f, 0x39, 0x05, 0xe7, 0x0d,
+		0x17, 0xd1, 0xd8, 0x4a, 0xde, 0x8c, 0x23, 0x0d, 0xa1, 0xea, 0x55, 0xf3,
+		0xad, 0xd9, 0xf6, 0x1f, 0x44, 0xb7, 0x70, 0x82, 0x8a, 0x59, 0xbe, 0x41,
+		0x1c, 0x4b, 0x8e, 0x75, 0x26, 0xce, 0xb9, 0x6a, 0x14, 0x4b, 0x2c, 0xcd,
+		0xbe, 0x90, 0x2b, 0xc6, 0x6c, 0x8b, 0xb8, 0x27, 0x9d, 0xe0, 0xb9, 0xc5,
+		0xb9, 0x27, 0xda, 0x09, 0x31, 0xeb, 0xde, 0x61, 0xbc, 0xdf, 0x51, 0xd5,
+		0x73, 0x1d, 0x2e, 0x8c, 0x18, 0xd3, 0xcc, 0x72, 0xec, 0x7e, 0x06, 0x00,
+		0x50, 0x4b, 0x07, 0x08, 0xe1, 0x02, 0x13, 0xa4, 0xe4, 0x00, 0x00, 0x00,
+		0x47, 0x01, 0x00, 0x00, 0x50, 0x4b, 0x03, 0x04, 0x14, 0x00, 0x08, 0x00,
+		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1f, 0x00, 0x09, 0x00, 0x73, 0x74,
+		0x61, 0x63, 0x6b, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x63, 0x72,
+		0x69, 0x70, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+		0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
+		0xce, 0x12, 0x74, 0x8e, 0x41, 0xca, 0xc2, 0x30, 0x10, 0x46, 0xf7, 0x39,
+		0xc5, 0xc7, 0xac, 0xfe, 0x5f, 0x9a, 0x0b, 0x74, 0x29, 0x78, 0x0a, 0xe9,
+		0x22, 0xb4, 0x81, 0x0e, 0x4d, 0xa6, 0x21, 0x13, 0x51, 0x91, 0xde, 0x5d,
+		0x0c, 0xea, 0x26, 0x76, 0x99, 0xbc, 0x6f, 0x1e, 0xef, 0x61, 0x00, 0x4a,
+		0x3e, 0x47, 0x56, 0xe5, 0x55, 0x94, 0x7a, 0xbc, 0xbe, 0x00, 0x72, 0x21,
+		0xac, 0x57, 0xea, 0x71, 0xae, 0x4f, 0x80, 0x8e, 0x4e, 0xe7, 0x3f, 0x49,
+		0x37, 0x14, 0x1d, 0x61, 0xad, 0xac, 0xa7, 0xc8, 0xa5, 0x3f, 0xfc, 0x53,
+		0xd7, 0x2c, 0xbc, 0x06, 0x96, 0x1d, 0x96, 0xb2, 0x2f, 0x85, 0x7d, 0x86,
+		0xb5, 0xe3, 0xec, 0xc7, 0xa5, 0x5d, 0x25, 0x49, 0x11, 0x7b, 0x82, 0x88,
+		0x7c, 0x91, 0x2f, 0xad, 0x70, 0xe8, 0xde, 0xc5, 0xba, 0x34, 0xbd, 0xd5,
+		0xe5, 0xa6, 0xe9, 0x57, 0x4b, 0x04, 0x8b, 0x16, 0x17, 0x42, 0x0b, 0xef,
+		0x2e, 0xcb, 0xe7, 0xac, 0xca, 0x07, 0x03, 0x6c, 0x66, 0x33, 0xcf, 0x01,
+		0x00, 0x50, 0x4b, 0x07, 0x08, 0x2c, 0x5c, 0x69, 0x7c, 0x8f, 0x00, 0x00,
+		0x00, 0x30, 0x01, 0x00, 0x00, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14,
+		0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x30, 0x49, 0x74,
+		0xa9, 0xfe, 0x03, 0x00, 0x00, 0xa4, 0x06, 0x00, 0x00, 0x09, 0x00, 0x09,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x00,
+		0x00, 0x00, 0x00, 0x43, 0x4c, 0x41, 0x55, 0x44, 0x45, 0x2e, 0x6d, 0x64,
+		0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01,
+		0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x05, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+		0x00, 0xed, 0x41, 0x3e, 0x04, 0x00, 0x00, 0x64, 0x6f, 0x63, 0x73, 0x2f,
+		0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01,
+		0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21,
+		0x00, 0x74, 0x98, 0xfb, 0x78, 0x23, 0x00, 0x00, 0x00, 0x1c, 0x00, 0x00,
+		0x00, 0x0e, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xa4, 0x81, 0x6a, 0x04, 0x00, 0x00, 0x64, 0x6f, 0x63, 0x73, 0x2f,
+		0x72, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05,
+		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03,
+		0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x00,
+		0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0xed, 0x41,
+		0xd2, 0x04, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64,
+		0x65, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50,
+		0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00,
+		0x00, 0x21, 0x00, 0xb8, 0x65, 0xd2, 0x81, 0x61, 0x00, 0x00, 0x00, 0x88,
+		0x00, 0x00, 0x00, 0x14, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0xa4, 0x81, 0x03, 0x05, 0x00, 0x00, 0x64, 0x6f, 0x74,
+		0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x2e, 0x67, 0x69, 0x74, 0x69,
+		0x67, 0x6e, 0x6f, 0x72, 0x65, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
 		0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00,
 		0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x00, 0x09, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0xed, 0x41, 0x42, 0x04, 0x00, 0x00,
-		0x64, 0x6f, 0x63, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x11, 0x00, 0x09, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0xed, 0x41, 0xaf, 0x05, 0x00, 0x00,
+		0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x61, 0x67,
+		0x65, 0x6e, 0x74, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
 		0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00,
-		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x74, 0x98, 0xfb, 0x78, 0x22, 0x00,
-		0x00, 0x00, 0x1c, 0x00, 0x00, 0x00, 0x0e, 0x00, 0x09, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x6e, 0x04, 0x00, 0x00,
-		0x64, 0x6f, 0x63, 0x73, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x6d, 0x65, 0x2e,
+		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x35, 0x48, 0x73, 0x56, 0x4f, 0x02,
+		0x00, 0x00, 0x87, 0x03, 0x00, 0x00, 0x1a, 0x00, 0x09, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0xe7, 0x05, 0x00, 0x00,
+		0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x61, 0x67,
+		0x65, 0x6e, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e,
 		0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50,
-		0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x0a, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x10, 0x00, 0xed, 0x41, 0xd5, 0x04, 0x00, 0x00, 0x64, 0x6f, 0x74,
-		0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01,
-		0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00,
-		0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0xb8, 0x65, 0xd2, 0x81,
-		0x64, 0x00, 0x00, 0x00, 0x88, 0x00, 0x00, 0x00, 0x14, 0x00, 0x09, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x06, 0x05,
-		0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f,
-		0x2e, 0x67, 0x69, 0x74, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x55, 0x54,
+		0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00,
+		0x00, 0x21, 0x00, 0xad, 0xb9, 0x75, 0x0e, 0xf7, 0x01, 0x00, 0x00, 0xd6,
+		0x02, 0x00, 0x00, 0x1c, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0xa4, 0x81, 0x87, 0x08, 0x00, 0x00, 0x64, 0x6f, 0x74,
+		0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74,
+		0x73, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x2e, 0x6d,
+		0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b,
+		0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x13, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x10, 0x00, 0xed, 0x41, 0xd1, 0x0a, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63,
+		0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+		0x64, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
+		0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x1b, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x10, 0x00, 0xed, 0x41, 0x0b, 0x0b, 0x00, 0x00, 0x64, 0x6f,
+		0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+		0x61, 0x6e, 0x64, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
+		0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b,
+		0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00,
+		0x21, 0x00, 0xf8, 0xd6, 0xc9, 0xb6, 0x0b, 0x02, 0x00, 0x00, 0x2c, 0x03,
+		0x00, 0x00, 0x26, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0xa4, 0x81, 0x4d, 0x0b, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63,
+		0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+		0x64, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x2f, 0x72,
+		0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e, 0x6d, 0x64, 0x55, 0x54,
 		0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14,
-		0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x11,
-		0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0xed,
-		0x41, 0xb5, 0x05, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
-		0x64, 0x65, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x55, 0x54,
+		0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x17,
+		0x4a, 0xcf, 0xd0, 0x8a, 0x02, 0x00, 0x00, 0x0b, 0x04, 0x00, 0x00, 0x1d,
+		0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4,
+		0x81, 0xb5, 0x0d, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
+		0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f,
+		0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x2e, 0x6d, 0x64, 0x55, 0x54,
 		0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14,
-		0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x35,
-		0x48, 0x73, 0x56, 0x51, 0x02, 0x00, 0x00, 0x87, 0x03, 0x00, 0x00, 0x1a,
+		0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0xd8,
+		0x16, 0xc6, 0x04, 0x85, 0x02, 0x00, 0x00, 0x07, 0x04, 0x00, 0x00, 0x1a,
 		0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4,
-		0x81, 0xed, 0x05, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
-		0x64, 0x65, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x72, 0x65,
-		0x61, 0x64, 0x65, 0x72, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01,
+		0x81, 0x93, 0x10, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
+		0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f,
+		0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01,
 		0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00,
-		0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0xad, 0xb9, 0x75, 0x0e,
-		0xf6, 0x01, 0x00, 0x00, 0xd6, 0x02, 0x00, 0x00, 0x1c, 0x00, 0x09, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x8f, 0x08,
+		0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x2f, 0xd9, 0xa5, 0xcd,
+		0xc2, 0x01, 0x00, 0x00, 0xb8, 0x03, 0x00, 0x00, 0x24, 0x00, 0x09, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x69, 0x13,
 		0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f,
-		0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65,
-		0x77, 0x65, 0x72, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00,
-		0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x13, 0x00, 0x09, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0xed, 0x41, 0xd8, 0x0a, 0x00,
-		0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63,
-		0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00,
-		0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1b, 0x00, 0x09,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0xed, 0x41, 0x12,
-		0x0b, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65,
-		0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x63, 0x6f,
-		0x6d, 0x70, 0x61, 0x63, 0x74, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00,
-		0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08,
-		0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0xf8, 0xd6, 0xc9, 0xb6, 0x0e,
-		0x02, 0x00, 0x00, 0x2c, 0x03, 0x00, 0x00, 0x26, 0x00, 0x09, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x54, 0x0b, 0x00,
-		0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63,
-		0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
-		0x61, 0x63, 0x74, 0x2f, 0x72, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-		0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
-		0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00,
-		0x00, 0x00, 0x21, 0x00, 0x17, 0x4a, 0xcf, 0xd0, 0x73, 0x02, 0x00, 0x00,
-		0x0b, 0x04, 0x00, 0x00, 0x1d, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0xbf, 0x0d, 0x00, 0x00, 0x64, 0x6f,
-		0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
-		0x61, 0x6e, 0x64, 0x73, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
-		0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
-		0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00,
-		0x00, 0x00, 0x21, 0x00, 0xd8, 0x16, 0xc6, 0x04, 0x7e, 0x02, 0x00, 0x00,
-		0x07, 0x04, 0x00, 0x00, 0x1a, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x86, 0x10, 0x00, 0x00, 0x64, 0x6f,
-		0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
-		0x61, 0x6e, 0x64, 0x73, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x6d, 0x64,
+		0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x65,
+		0x70, 0x61, 0x72, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x2e,
+		0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50,
+		0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00,
+		0x00, 0x21, 0x00, 0x5b, 0xc1, 0xc1, 0xc7, 0xc7, 0x02, 0x00, 0x00, 0x74,
+		0x04, 0x00, 0x00, 0x1b, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0xa4, 0x81, 0x86, 0x15, 0x00, 0x00, 0x64, 0x6f, 0x74,
+		0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+		0x6e, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x69, 0x6d, 0x65, 0x2e, 0x6d, 0x64,
 		0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01,
 		0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21,
-		0x00, 0x2f, 0xd9, 0xa5, 0xcd, 0xc5, 0x01, 0x00, 0x00, 0xb8, 0x03, 0x00,
-		0x00, 0x24, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0xa4, 0x81, 0x55, 0x13, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c,
+		0x00, 0x4f, 0x66, 0xd1, 0x63, 0x22, 0x01, 0x00, 0x00, 0xb5, 0x01, 0x00,
+		0x00, 0x23, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xa4, 0x81, 0x9f, 0x18, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c,
 		0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-		0x73, 0x2f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x2d, 0x63, 0x6f,
-		0x6d, 0x6d, 0x69, 0x74, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01,
+		0x73, 0x2f, 0x72, 0x65, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2d, 0x70,
+		0x6c, 0x61, 0x6e, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00,
+		0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08,
+		0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x56, 0xc3, 0x8f, 0xe5, 0x72,
+		0x02, 0x00, 0x00, 0xc8, 0x03, 0x00, 0x00, 0x1c, 0x00, 0x09, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x1b, 0x1a, 0x00,
+		0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63,
+		0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69,
+		0x65, 0x77, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
+		0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0x09, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0xed, 0x41, 0xe0, 0x1c, 0x00, 0x00,
+		0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x68, 0x6f,
+		0x6f, 0x6b, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce,
+		0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08,
+		0x00, 0x00, 0x00, 0x21, 0x00, 0x1a, 0x8e, 0x16, 0x52, 0xe3, 0x00, 0x00,
+		0x00, 0x1e, 0x03, 0x00, 0x00, 0x14, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x17, 0x1d, 0x00, 0x00, 0x64,
+		0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x68, 0x6f, 0x6f,
+		0x6b, 0x73, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x54, 0x05, 0x00, 0x01,
 		0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00,
-		0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x5b, 0xc1, 0xc1, 0xc7,
-		0xc6, 0x02, 0x00, 0x00, 0x74, 0x04, 0x00, 0x00, 0x1b, 0x00, 0x09, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x75, 0x15,
+		0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x7a, 0xbe, 0x52, 0xce,
+		0x9b, 0x05, 0x00, 0x00, 0xa6, 0x11, 0x00, 0x00, 0x22, 0x00, 0x09, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x45, 0x1e,
 		0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f,
-		0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x69,
-		0x6d, 0x65, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
-		0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00,
-		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x4f, 0x66, 0xd1, 0x63, 0x23, 0x01,
-		0x00, 0x00, 0xb5, 0x01, 0x00, 0x00, 0x23, 0x00, 0x09, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x8d, 0x18, 0x00, 0x00,
-		0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f,
-		0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x72, 0x65, 0x66, 0x61, 0x63,
-		0x74, 0x6f, 0x72, 0x2d, 0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x6d, 0x64, 0x55,
-		0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02,
-		0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00,
-		0x56, 0xc3, 0x8f, 0xe5, 0x74, 0x02, 0x00, 0x00, 0xc8, 0x03, 0x00, 0x00,
-		0x1c, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0xa4, 0x81, 0x0a, 0x1a, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61,
-		0x75, 0x64, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
-		0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x6d, 0x64, 0x55, 0x54,
-		0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14,
-		0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
-		0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0xed,
-		0x41, 0xd1, 0x1c, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
-		0x64, 0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x55, 0x54, 0x05,
-		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03,
-		0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x1a, 0x8e,
-		0x16, 0x52, 0xe5, 0x00, 0x00, 0x00, 0x1e, 0x03, 0x00, 0x00, 0x14, 0x00,
-		0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81,
-		0x08, 0x1d, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64,
-		0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
+		0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f,
+		0x73, 0x74, 0x6f, 0x70, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x2e, 0x70, 0x79,
 		0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01,
 		0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21,
-		0x00, 0x7a, 0xbe, 0x52, 0xce, 0x88, 0x05, 0x00, 0x00, 0xa6, 0x11, 0x00,
-		0x00, 0x22, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0xa4, 0x81, 0x38, 0x1e, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c,
-		0x61, 0x75, 0x64, 0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x61,
-		0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x68, 0x69,
-		0x6e, 0x74, 0x2e, 0x70, 0x79, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
+		0x00, 0x54, 0xbb, 0xce, 0x8d, 0xcd, 0x06, 0x00, 0x00, 0x68, 0x14, 0x00,
+		0x00, 0x20, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xa4, 0x81, 0x39, 0x24, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c,
+		0x61, 0x75, 0x64, 0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x70,
+		0x6f, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x73, 0x65,
+		0x2e, 0x70, 0x79, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
+		0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00,
+		0x00, 0x00, 0x21, 0x00, 0x7c, 0x7e, 0xa0, 0xa9, 0x32, 0x05, 0x00, 0x00,
+		0x78, 0x0c, 0x00, 0x00, 0x1f, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x5d, 0x2b, 0x00, 0x00, 0x64, 0x6f,
+		0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b,
+		0x73, 0x2f, 0x70, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x75,
+		0x73, 0x65, 0x2e, 0x70, 0x79, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
+		0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00,
+		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0xd7, 0x0a, 0x3b, 0x34, 0x23, 0x01,
+		0x00, 0x00, 0x7d, 0x01, 0x00, 0x00, 0x1d, 0x00, 0x09, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0xe5, 0x30, 0x00, 0x00,
+		0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x68, 0x6f,
+		0x6f, 0x6b, 0x73, 0x2f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+		0x63, 0x74, 0x2e, 0x70, 0x79, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
 		0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00,
-		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x54, 0xbb, 0xce, 0x8d, 0xac, 0x06,
-		0x00, 0x00, 0x68, 0x14, 0x00, 0x00, 0x20, 0x00, 0x09, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x19, 0x24, 0x00, 0x00,
+		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0xb0, 0x87, 0x23, 0x92, 0xfa, 0x01,
+		0x00, 0x00, 0x25, 0x03, 0x00, 0x00, 0x25, 0x00, 0x09, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x5c, 0x32, 0x00, 0x00,
 		0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x68, 0x6f,
-		0x6f, 0x6b, 0x73, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x6f,
-		0x6c, 0x5f, 0x75, 0x73, 0x65, 0x2e, 0x70, 0x79, 0x55, 0x54, 0x05, 0x00,
+		0x6f, 0x6b, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f,
+		0x6d, 0x70, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x2e, 0x70,
+		0x79, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b,
+		0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x18, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x10, 0x00, 0xed, 0x41, 0xb2, 0x34, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63,
+		0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+		0x2d, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00,
 		0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14,
-		0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x7c, 0x7e, 0xa0,
-		0xa9, 0x26, 0x05, 0x00, 0x00, 0x78, 0x0c, 0x00, 0x00, 0x1f, 0x00, 0x09,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x1c,
-		0x2b, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65,
-		0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x70, 0x72, 0x65, 0x5f, 0x74,
-		0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x2e, 0x70, 0x79, 0x55, 0x54,
-		0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14,
-		0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0xd7,
-		0x0a, 0x3b, 0x34, 0x20, 0x01, 0x00, 0x00, 0x7d, 0x01, 0x00, 0x00, 0x1d,
-		0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4,
-		0x81, 0x98, 0x30, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
-		0x64, 0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x70, 0x72, 0x65,
-		0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x79, 0x55, 0x54,
-		0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14,
-		0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0xb0,
-		0x87, 0x23, 0x92, 0xfc, 0x01, 0x00, 0x00, 0x25, 0x03, 0x00, 0x00, 0x25,
-		0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4,
-		0x81, 0x0c, 0x32, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
-		0x64, 0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x75, 0x73, 0x65,
-		0x72, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x73, 0x75, 0x62,
-		0x6d, 0x69, 0x74, 0x2e, 0x70, 0x79, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00,
-		0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x00, 0x09, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0xed, 0x41, 0x64, 0x34, 0x00,
-		0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x6f,
-		0x75, 0x74, 0x70, 0x75, 0x74, 0x2d, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73,
-		0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b,
+		0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x2a, 0xd5, 0x94,
+		0xb6, 0xa5, 0x00, 0x00, 0x00, 0xd7, 0x00, 0x00, 0x00, 0x2e, 0x00, 0x09,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0xf1,
+		0x34, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65,
+		0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2d, 0x73, 0x74, 0x79, 0x6c,
+		0x65, 0x73, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2d,
+		0x6f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+		0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b,
 		0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00,
-		0x21, 0x00, 0x2a, 0xd5, 0x94, 0xb6, 0xa8, 0x00, 0x00, 0x00, 0xd7, 0x00,
-		0x00, 0x00, 0x2e, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0xa4, 0x81, 0xa3, 0x34, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63,
+		0x21, 0x00, 0x86, 0x8d, 0x65, 0x63, 0xac, 0x00, 0x00, 0x00, 0xa5, 0x00,
+		0x00, 0x00, 0x22, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0xa4, 0x81, 0xfb, 0x35, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63,
 		0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-		0x2d, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x2f, 0x6c, 0x65, 0x61, 0x72,
-		0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x6f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64,
-		0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00,
+		0x2d, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x2f, 0x70, 0x6c, 0x61, 0x6e,
+		0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00,
 		0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08,
-		0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x86, 0x8d, 0x65, 0x63, 0x8b,
-		0x00, 0x00, 0x00, 0xa5, 0x00, 0x00, 0x00, 0x22, 0x00, 0x09, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0xb0, 0x35, 0x00,
+		0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0xbf, 0xef, 0xd9, 0x4f, 0x7e,
+		0x00, 0x00, 0x00, 0x9d, 0x00, 0x00, 0x00, 0x23, 0x00, 0x09, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x00, 0x37, 0x00,
 		0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x6f,
 		0x75, 0x74, 0x70, 0x75, 0x74, 0x2d, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73,
-		0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x64, 0x55,
-		0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02,
-		0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00,
-		0xbf, 0xef, 0xd9, 0x4f, 0x83, 0x00, 0x00, 0x00, 0x9d, 0x00, 0x00, 0x00,
-		0x23, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0xa4, 0x81, 0x94, 0x36, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61,
-		0x75, 0x64, 0x65, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2d, 0x73,
-		0x74, 0x79, 0x6c, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-		0x65, 0x72, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
-		0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00,
-		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0xda, 0x03, 0xde, 0x04, 0x28, 0x01,
-		0x00, 0x00, 0x98, 0x01, 0x00, 0x00, 0x23, 0x00, 0x09, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x71, 0x37, 0x00, 0x00,
-		0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x6f, 0x75,
-		0x74, 0x70, 0x75, 0x74, 0x2d, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x2f,
-		0x73, 0x75, 0x72, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x2e, 0x6d, 0x64, 0x55,
-		0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02,
-		0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00,
-		0xa5, 0x5e, 0x20, 0x22, 0xa1, 0x01, 0x00, 0x00, 0x25, 0x05, 0x00, 0x00,
-		0x17, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0xa4, 0x81, 0xf3, 0x38, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61,
-		0x75, 0x64, 0x65, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
-		0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
-		0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x13, 0x00, 0x09, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0xed, 0x41, 0xe2, 0x3a, 0x00, 0x00,
-		0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x73, 0x6e,
-		0x69, 0x70, 0x70, 0x65, 0x74, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01,
+		0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x2e, 0x6d, 0x64,
+		0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01,
+		0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21,
+		0x00, 0xda, 0x03, 0xde, 0x04, 0x26, 0x01, 0x00, 0x00, 0x98, 0x01, 0x00,
+		0x00, 0x23, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xa4, 0x81, 0xd8, 0x37, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c,
+		0x61, 0x75, 0x64, 0x65, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2d,
+		0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x2f, 0x73, 0x75, 0x72, 0x67, 0x69,
+		0x63, 0x61, 0x6c, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00,
+		0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08,
+		0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0xa5, 0x5e, 0x20, 0x22, 0xa6,
+		0x01, 0x00, 0x00, 0x25, 0x05, 0x00, 0x00, 0x17, 0x00, 0x09, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x58, 0x39, 0x00,
+		0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x73,
+		0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
+		0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01,
+		0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x13, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+		0x00, 0xed, 0x41, 0x4c, 0x3b, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c,
+		0x61, 0x75, 0x64, 0x65, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+		0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50,
+		0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x19, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x10, 0x00, 0xed, 0x41, 0x86, 0x3b, 0x00, 0x00, 0x64, 0x6f, 0x74,
+		0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70,
+		0x65, 0x74, 0x73, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x55, 0x54,
+		0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14,
+		0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x5f,
+		0x0b, 0x05, 0xbb, 0x24, 0x01, 0x00, 0x00, 0x19, 0x02, 0x00, 0x00, 0x32,
+		0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4,
+		0x81, 0xc6, 0x3b, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75,
+		0x64, 0x65, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x73, 0x2f,
+		0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x66, 0x6c, 0x75, 0x74, 0x74, 0x65,
+		0x72, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2d, 0x67, 0x61,
+		0x74, 0x65, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x54, 0x05, 0x00, 0x01,
 		0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x19, 0x00, 0x09, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0xed, 0x41, 0x1c, 0x3b,
+		0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0xd2, 0x2a, 0x72, 0x76,
+		0xdc, 0x00, 0x00, 0x00, 0x63, 0x01, 0x00, 0x00, 0x2e, 0x00, 0x09, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x53, 0x3d,
 		0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f,
 		0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x73, 0x2f, 0x68, 0x6f, 0x6f,
-		0x6b, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
+		0x6b, 0x73, 0x2f, 0x67, 0x6f, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+		0x74, 0x65, 0x2d, 0x77, 0x61, 0x72, 0x6e, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
+		0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01,
+		0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x14, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+		0x00, 0xed, 0x41, 0x94, 0x3e, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c,
+		0x61, 0x75, 0x64, 0x65, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+		0x65, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
 		0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00,
-		0x00, 0x00, 0x21, 0x00, 0x0e, 0x9e, 0x83, 0x0e, 0xe9, 0x00, 0x00, 0x00,
-		0x61, 0x01, 0x00, 0x00, 0x32, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x5c, 0x3b, 0x00, 0x00, 0x64, 0x6f,
-		0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x73, 0x6e, 0x69, 0x70,
-		0x70, 0x65, 0x74, 0x73, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x66,
-		0x6c, 0x75, 0x74, 0x74, 0x65, 0x72, 0x2e, 0x72, 0x65, 0x6c, 0x65, 0x61,
-		0x73, 0x65, 0x2d, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
+		0x00, 0x00, 0x21, 0x00, 0xc8, 0x42, 0x9d, 0x2a, 0x7f, 0x00, 0x00, 0x00,
+		0x98, 0x00, 0x00, 0x00, 0x2f, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0xcf, 0x3e, 0x00, 0x00, 0x64, 0x6f,
+		0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x74, 0x65, 0x6d, 0x70,
+		0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+		0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d,
+		0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x74, 0x78, 0x74, 0x55, 0x54, 0x05,
+		0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03,
+		0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x08, 0xdc,
+		0x2c, 0x7a, 0xa9, 0x01, 0x00, 0x00, 0xc1, 0x03, 0x00, 0x00, 0x09, 0x00,
+		0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81,
+		0xb4, 0x3f, 0x00, 0x00, 0x70, 0x61, 0x63, 0x6b, 0x2e, 0x6a, 0x73, 0x6f,
+		0x6e, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b,
+		0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x07, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x10, 0x00, 0xed, 0x41, 0x9d, 0x41, 0x00, 0x00, 0x73, 0x74, 0x61, 0x63,
+		0x6b, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12,
+		0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x0f, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x10, 0x00, 0xed, 0x41, 0xcb, 0x41, 0x00, 0x00, 0x73, 0x74,
+		0x61, 0x63, 0x6b, 0x73, 0x2f, 0x66, 0x6c, 0x75, 0x74, 0x74, 0x65, 0x72,
+		0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b,
+		0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00,
+		0x21, 0x00, 0x80, 0x43, 0x2b, 0x2f, 0xe7, 0x00, 0x00, 0x00, 0x5b, 0x01,
+		0x00, 0x00, 0x18, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0xa4, 0x81, 0x01, 0x42, 0x00, 0x00, 0x73, 0x74, 0x61, 0x63,
+		0x6b, 0x73, 0x2f, 0x66, 0x6c, 0x75, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x43,
+		0x4c, 0x41, 0x55, 0x44, 0x45, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00,
+		0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14,
+		0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x89, 0xf5, 0x31,
+		0x84, 0x81, 0x00, 0x00, 0x00, 0xd4, 0x00, 0x00, 0x00, 0x1c, 0x00, 0x09,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x37,
+		0x43, 0x00, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x66, 0x6c,
+		0x75, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+		0x67, 0x73, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x54, 0x05, 0x00, 0x01,
+		0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x00, 0x09, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0xed, 0x41, 0x0b, 0x44,
+		0x00, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x67, 0x6f, 0x2f,
 		0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01,
 		0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21,
-		0x00, 0xe7, 0x7c, 0xff, 0x12, 0xa6, 0x00, 0x00, 0x00, 0xd8, 0x00, 0x00,
-		0x00, 0x2e, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0xa4, 0x81, 0xae, 0x3c, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c,
-		0x61, 0x75, 0x64, 0x65, 0x2f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
-		0x73, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x67, 0x6f, 0x2e, 0x67,
-		0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x2d, 0x77, 0x61, 0x72, 0x6e,
-		0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
-		0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x00, 0x09, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0xed, 0x41, 0xb9, 0x3d, 0x00, 0x00,
-		0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65, 0x2f, 0x74, 0x65,
-		0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00,
-		0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14,
-		0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0xc8, 0x42, 0x9d,
-		0x2a, 0x82, 0x00, 0x00, 0x00, 0x98, 0x00, 0x00, 0x00, 0x2f, 0x00, 0x09,
-		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0xf4,
-		0x3d, 0x00, 0x00, 0x64, 0x6f, 0x74, 0x63, 0x6c, 0x61, 0x75, 0x64, 0x65,
-		0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x63,
-		0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-		0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x74,
-		0x78, 0x74, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50,
+		0x00, 0x44, 0xd2, 0x90, 0xce, 0x12, 0x01, 0x00, 0x00, 0x96, 0x01, 0x00,
+		0x00, 0x13, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xa4, 0x81, 0x3c, 0x44, 0x00, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b,
+		0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x43, 0x4c, 0x41, 0x55, 0x44, 0x45, 0x2e,
+		0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50,
 		0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
 		0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x00, 0x00, 0x07, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-		0x00, 0x10, 0x00, 0xed, 0x41, 0xdc, 0x3e, 0x00, 0x00, 0x73, 0x74, 0x61,
-		0x63, 0x6b, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce,
-		0x12, 0x50, 0x4b, 0x05, 0x06, 0x00, 0x00, 0x00, 0x00, 0x25, 0x00, 0x25,
-		0x00, 0xdf, 0x0b, 0x00, 0x00, 0x0a, 0x3f, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x13, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x10, 0x00, 0xed, 0x41, 0x98, 0x45, 0x00, 0x00, 0x73, 0x74, 0x61,
+		0x63, 0x6b, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+		0x6e, 0x64, 0x73, 0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce,
+		0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08,
+		0x00, 0x00, 0x00, 0x21, 0x00, 0xfe, 0x70, 0x69, 0xfb, 0xf2, 0x00, 0x00,
+		0x00, 0x7b, 0x01, 0x00, 0x00, 0x1e, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0xd2, 0x45, 0x00, 0x00, 0x73,
+		0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6d,
+		0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x67, 0x6f, 0x2d, 0x63, 0x68, 0x65,
+		0x63, 0x6b, 0x2e, 0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6,
+		0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00,
+		0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x36, 0xaf, 0x29, 0xe1, 0x70, 0x00,
+		0x00, 0x00, 0xdc, 0x00, 0x00, 0x00, 0x17, 0x00, 0x09, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x19, 0x47, 0x00, 0x00,
+		0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x65,
+		0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x55,
+		0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02,
+		0x14, 0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x0e, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00,
+		0xed, 0x41, 0xd7, 0x47, 0x00, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73,
+		0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x2f, 0x55, 0x54, 0x05, 0x00,
+		0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02, 0x14, 0x03, 0x14,
+		0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00, 0x35, 0xdc, 0x02,
+		0x46, 0xef, 0x00, 0x00, 0x00, 0x56, 0x01, 0x00, 0x00, 0x17, 0x00, 0x09,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x81, 0x0c,
+		0x48, 0x00, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x70, 0x79,
+		0x74, 0x68, 0x6f, 0x6e, 0x2f, 0x43, 0x4c, 0x41, 0x55, 0x44, 0x45, 0x2e,
+		0x6d, 0x64, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50,
+		0x4b, 0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00,
+		0x00, 0x21, 0x00, 0x21, 0xe3, 0xe1, 0x81, 0x7e, 0x00, 0x00, 0x00, 0xeb,
+		0x00, 0x00, 0x00, 0x1b, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0xa4, 0x81, 0x49, 0x49, 0x00, 0x00, 0x73, 0x74, 0x61,
+		0x63, 0x6b, 0x73, 0x2f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x2f, 0x73,
+		0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
+		0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01,
+		0x02, 0x14, 0x03, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x12, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+		0x00, 0xed, 0x41, 0x19, 0x4a, 0x00, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b,
+		0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+		0x2f, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b,
+		0x01, 0x02, 0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00,
+		0x21, 0x00, 0xe1, 0x02, 0x13, 0xa4, 0xe4, 0x00, 0x00, 0x00, 0x47, 0x01,
+		0x00, 0x00, 0x1b, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0xa4, 0x81, 0x52, 0x4a, 0x00, 0x00, 0x73, 0x74, 0x61, 0x63,
+		0x6b, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+		0x74, 0x2f, 0x43, 0x4c, 0x41, 0x55, 0x44, 0x45, 0x2e, 0x6d, 0x64, 0x55,
+		0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b, 0x01, 0x02,
+		0x14, 0x03, 0x14, 0x00, 0x08, 0x00, 0x08, 0x00, 0x00, 0x00, 0x21, 0x00,
+		0x2c, 0x5c, 0x69, 0x7c, 0x8f, 0x00, 0x00, 0x00, 0x30, 0x01, 0x00, 0x00,
+		0x1f, 0x00, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0xa4, 0x81, 0x88, 0x4b, 0x00, 0x00, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73,
+		0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x2f,
+		0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6a, 0x73, 0x6f,
+		0x6e, 0x55, 0x54, 0x05, 0x00, 0x01, 0x00, 0xa6, 0xce, 0x12, 0x50, 0x4b,
+		0x05, 0x06, 0x00, 0x00, 0x00, 0x00, 0x34, 0x00, 0x34, 0x00, 0x55, 0x10,
+		0x00, 0x00, 0x6d, 0x4c, 0x00, 0x00, 0x00, 0x00,
 	}
 }
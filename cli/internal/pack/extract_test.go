@@ -0,0 +1,124 @@
+package pack
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type zipEntry struct {
+	name    string
+	mode    fs.FileMode
+	content string
+}
+
+// craftZip writes an archive with exactly the given entries, including ones
+// no honest pack build would produce.
+func craftZip(t *testing.T, entries ...zipEntry) string {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, e := range entries {
+		h := &zip.FileHeader{Name: e.name, Method: zip.Deflate}
+		mode := e.mode
+		if mode == 0 {
+			mode = 0o644
+		}
+		h.SetMode(mode)
+		w, err := zw.CreateHeader(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(e.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	p := filepath.Join(t.TempDir(), "pack.zip")
+	if err := os.WriteFile(p, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestExtractZipRejectsMaliciousArchives(t *testing.T) {
+	ok := zipEntry{name: "dotclaude/a.md", content: "a\n"}
+	cases := []struct {
+		name    string
+		entries []zipEntry
+		want    string
+	}{
+		{"parent traversal", []zipEntry{ok, {name: "../evil.sh", content: "x"}}, "escapes"},
+		{"nested traversal", []zipEntry{{name: "dotclaude/../../evil", content: "x"}}, "escapes"},
+		{"absolute path", []zipEntry{{name: "/tmp/evil", content: "x"}}, "escapes"},
+		{"backslash traversal", []zipEntry{{name: `..\evil`, content: "x"}}, "escapes"},
+		{"symlink", []zipEntry{{name: "dotclaude/link", mode: fs.ModeSymlink | 0o777, content: "/etc/passwd"}}, "symlink"},
+		{"device", []zipEntry{{name: "dotclaude/dev", mode: fs.ModeDevice | 0o644}}, "special file"},
+		{"named pipe", []zipEntry{{name: "dotclaude/fifo", mode: fs.ModeNamedPipe | 0o644}}, "special file"},
+		{"duplicate", []zipEntry{ok, ok}, "duplicate"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			src := craftZip(t, tc.entries...)
+			parent := t.TempDir()
+			dest := filepath.Join(parent, "out", "pack")
+			err := extractZip(src, dest)
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("err = %v, want %q", err, tc.want)
+			}
+			if _, err := os.Stat(dest); !os.IsNotExist(err) {
+				t.Fatalf("destination left behind after a rejected archive")
+			}
+			if left, _ := os.ReadDir(filepath.Dir(dest)); len(left) != 0 {
+				t.Fatalf("temporary files left behind: %v", left)
+			}
+			if _, err := os.Stat(filepath.Join(parent, "evil.sh")); err == nil {
+				t.Fatal("entry written outside the destination")
+			}
+		})
+	}
+}
+
+func TestExtractZipLimits(t *testing.T) {
+	defer func(files int, size int64) { maxPackFiles, maxPackSize = files, size }(maxPackFiles, maxPackSize)
+	maxPackFiles, maxPackSize = 3, 1<<10
+
+	many := craftZip(t, zipEntry{name: "a"}, zipEntry{name: "b"}, zipEntry{name: "c"}, zipEntry{name: "d"})
+	if err := extractZip(many, filepath.Join(t.TempDir(), "pack")); err == nil || !strings.Contains(err.Error(), "entries") {
+		t.Fatalf("err = %v, want too many entries", err)
+	}
+
+	// Highly compressible content far beyond the cap, as in a zip bomb.
+	bomb := craftZip(t, zipEntry{name: "dotclaude/a.md", content: "small"}, zipEntry{name: "dotclaude/bomb", content: strings.Repeat("0", 1<<20)})
+	if err := extractZip(bomb, filepath.Join(t.TempDir(), "pack")); err == nil || !strings.Contains(err.Error(), "more than") {
+		t.Fatalf("err = %v, want size limit", err)
+	}
+}
+
+func TestExtractZip(t *testing.T) {
+	src := craftZip(t,
+		zipEntry{name: "dotclaude/", mode: fs.ModeDir | 0o755},
+		zipEntry{name: "dotclaude/hooks/gate.sh", mode: 0o755, content: "#!/bin/sh\n"},
+		zipEntry{name: "./docs/readme.md", content: "docs\n"},
+	)
+	dest := filepath.Join(t.TempDir(), "pack")
+	if err := extractZip(src, dest); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(filepath.Join(dest, "dotclaude", "hooks", "gate.sh"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm()&0o100 == 0 {
+		t.Fatalf("gate.sh mode = %v, want executable", info.Mode())
+	}
+	if b, err := os.ReadFile(filepath.Join(dest, "docs", "readme.md")); err != nil || string(b) != "docs\n" {
+		t.Fatalf("readme = %q, %v", b, err)
+	}
+}
@@ -1,94 +1,332 @@
 package pack
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"io/fs"
+	"path"
 	"path/filepath"
 	"slices"
 	"strings"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/settings"
 )
 
-// FilesFromDotclaudeFS composes dotclaude + selected stacks/<stack>
-// and includes top-level files (CLAUDE.md, docs/**) from the provided FS root.
+// FilesFromDotclaudeFS composes the single pack at root; see Layers.Files.
+func FilesFromDotclaudeFS(root fs.FS, sel Selection) ([]File, error) {
+	return Layers{{FS: root}}.Files(sel)
+}
+
+// Files composes dotclaude + selected stacks/<stack>
+// and includes top-level files (CLAUDE.md, docs/**) from each layer in turn.
+// A stack overlay mirrors dotclaude (agents/, commands/, hooks/, ...); its
+// settings.json and hooks.json extend the base and its CLAUDE.md installs as
+// .claude/rules/<stack>.md, which Claude loads next to the project's CLAUDE.md,
+// so the project's own memory file is never rewritten.
+// A later layer replaces files an earlier one provides, extends its settings
+// and stack sections the same way a stack does and may add stacks and
+// snippets of its own.
+// hooks.json definitions are folded into the settings file chosen by
+// sel.HooksTarget rather than installed on their own.
+// Policies come from the rules in pack.json; settings files are always
+// merge-json.
 // The returned RelPath is the project-relative destination path.
-func FilesFromDotclaudeFS(root fs.FS, stacks []string) ([]File, error) {
+func (ls Layers) Files(sel Selection) ([]File, error) {
 	const baseRoot = "dotclaude"
 	const stacksRoot = "stacks"
+	topLevel := []string{memoryFile, "docs"}
 
-	index := map[string]string{} // rel -> FS path
-
-	// 1) base contents
-	if err := fs.WalkDir(root, baseRoot, func(p string, d fs.DirEntry, err error) error {
-		if err != nil {
-			return err
-		}
-		if d.IsDir() {
-			return nil
-		}
-		rel := strings.TrimPrefix(p, baseRoot+"/")
-		rel = filepath.ToSlash(filepath.Join(".claude", rel))
-		index[rel] = p
-		return nil
-	}); err != nil {
+	hooksRel, err := HooksTargetPath(sel.HooksTarget)
+	if err != nil {
 		return nil, err
 	}
+	index := map[string]part{}           // rel -> providing layer and FS path
+	sections := map[string][]part{}      // rules rel -> stack sections, one per layer
+	fragments := map[string][]fragment{} // settings rel -> fragments, in overlay order
+	replacedBy := map[string]string{}    // rel -> stack whose overlay provides it
+	extendedBy := map[string][]string{}  // rel -> stacks adding sections or fragments
+	addFragment := func(l Layer, rel, p string) bool {
+		switch {
+		case rel == hooksFile:
+			fragments[hooksRel] = append(fragments[hooksRel], fragment{part: part{l, p}, key: "hooks"})
+		case isSettingsPath(rel):
+			fragments[rel] = append(fragments[rel], fragment{part: part{l, p}})
+		default:
+			return false
+		}
+		return true
+	}
 
-	// Normalize stacks to allowed ones only
-	want := make([]string, 0, len(stacks))
-	for _, s := range stacks {
-		if slices.Contains(allowedStacks, s) {
+	// Normalize stacks to the pack's catalog; keys recorded against an older
+	// pack that this one dropped are skipped.
+	meta, err := ls.Meta()
+	if err != nil {
+		return nil, err
+	}
+	want := make([]string, 0, len(sel.Stacks))
+	for _, s := range sel.Stacks {
+		if slices.Contains(StackKeys(meta.Stacks), s) {
 			want = append(want, s)
 		}
 	}
 
-	// 2) stack overlays
-	for _, s := range want {
-		base := filepath.Join(stacksRoot, s)
-		if err := fs.WalkDir(root, base, func(p string, d fs.DirEntry, err error) error {
+	for _, l := range ls {
+		root := l.FS
+
+		// 1) base contents
+		if err := fs.WalkDir(root, baseRoot, func(p string, d fs.DirEntry, err error) error {
 			if err != nil {
 				return err
 			}
 			if d.IsDir() {
 				return nil
 			}
-			rel := strings.TrimPrefix(p, base+"/")
+			rel := strings.TrimPrefix(p, baseRoot+"/")
 			rel = filepath.ToSlash(filepath.Join(".claude", rel))
-			index[rel] = p // overlay wins
-			return nil
-		}); err != nil {
-			if errors.Is(err, fs.ErrNotExist) {
-				continue
+			if !addFragment(l, rel, p) {
+				index[rel] = part{l, p}
+				delete(replacedBy, rel)
 			}
+			return nil
+		}); err != nil && !errors.Is(err, fs.ErrNotExist) {
 			return nil, err
 		}
+
+		// 2) stack overlays
+		for _, s := range want {
+			base := filepath.Join(stacksRoot, s)
+			if err := fs.WalkDir(root, base, func(p string, d fs.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				if d.IsDir() {
+					return nil
+				}
+				rel := strings.TrimPrefix(p, base+"/")
+				if rel == memoryFile {
+					rel = stackRulesPath(s)
+					sections[rel] = append(sections[rel], part{l, p})
+					extendedBy[rel] = append(extendedBy[rel], s)
+					return nil
+				}
+				rel = filepath.ToSlash(filepath.Join(".claude", rel))
+				// settings fragments extend the base instead of replacing it
+				if addFragment(l, rel, p) {
+					if rel == hooksFile {
+						rel = hooksRel
+					}
+					extendedBy[rel] = append(extendedBy[rel], s)
+				} else {
+					index[rel] = part{l, p} // overlay wins
+					replacedBy[rel] = s
+				}
+				return nil
+			}); err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					continue
+				}
+				return nil, err
+			}
+		}
+
+		// 3) Top-level files: only CLAUDE.md and docs/**, so a pack checkout's
+		// .git, README, LICENSE or CI files never land in the project
+		for _, top := range topLevel {
+			if err := fs.WalkDir(root, top, func(p string, d fs.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				if d.IsDir() {
+					if p != top && strings.HasPrefix(d.Name(), ".") {
+						return fs.SkipDir
+					}
+					return nil
+				}
+				if strings.HasPrefix(d.Name(), ".") {
+					return nil
+				}
+				index[filepath.ToSlash(p)] = part{l, p}
+				delete(replacedBy, filepath.ToSlash(p))
+				return nil
+			}); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return nil, err
+			}
+		}
 	}
 
-	// 3) Top-level files (CLAUDE.md, docs/**)
-	if err := fs.WalkDir(root, ".", func(p string, d fs.DirEntry, err error) error {
+	// Enabled hook snippets register next to the pack's hooks
+	if len(sel.Snippets) > 0 {
+		snips, err := ls.Snippets()
 		if err != nil {
-			return err
-		}
-		if d.IsDir() {
-			return nil
+			return nil, err
 		}
-		// Skip dotclaude and stacks directories
-		if strings.HasPrefix(p, "dotclaude/") || strings.HasPrefix(p, "stacks/") {
-			return nil
+		for _, name := range sel.Snippets {
+			i := slices.IndexFunc(snips, func(s Snippet) bool { return s.Name == name })
+			if i < 0 {
+				return nil, fmt.Errorf("unknown snippet %q", name)
+			}
+			fragments[hooksRel] = append(fragments[hooksRel], fragment{part: snips[i].part, snippet: true})
 		}
-		index[filepath.ToSlash(p)] = p
-		return nil
-	}); err != nil {
-		return nil, err
 	}
 
-	out := make([]File, 0, len(index))
+	out := make([]File, 0, len(index)+len(fragments))
+	for rel, parts := range fragments {
+		partsLocal := parts
+		layers := make([]part, len(parts))
+		for i, f := range parts {
+			layers[i] = f.part
+		}
+		out = append(out, File{
+			RelPath: rel,
+			Read:    func() ([]byte, error) { return composeJSON(partsLocal) },
+			Policy:  PolicyMergeJSON,
+			Layer:   layerNames(layers...),
+			Stack:   stackNames(extendedBy[rel]...),
+		})
+	}
+	for rel := range sections {
+		if _, ok := index[rel]; !ok {
+			index[rel] = part{} // sections only; the pack has no base file
+		}
+	}
 	for rel, p := range index {
 		relLocal, pLocal := rel, p
+		policy, ok := meta.PolicyFor(rel)
+		if !ok {
+			policy = PolicyManaged
+		}
+		if parts, ok := sections[rel]; ok {
+			out = append(out, File{
+				RelPath: relLocal,
+				Read:    func() ([]byte, error) { return composeSections(pLocal, parts) },
+				Policy:  policy,
+				Layer:   layerNames(append([]part{pLocal}, parts...)...),
+				Stack:   stackNames(append([]string{replacedBy[rel]}, extendedBy[rel]...)...),
+			})
+			continue
+		}
 		out = append(out, File{
 			RelPath: relLocal,
-			Read:    func() ([]byte, error) { return fs.ReadFile(root, pLocal) },
+			Read:    func() ([]byte, error) { return fs.ReadFile(pLocal.layer.FS, pLocal.path) },
+			Policy:  policy,
+			Layer:   pLocal.layer.Name,
+			Stack:   replacedBy[rel],
 		})
 	}
 	slices.SortFunc(out, func(a, b File) int { return strings.Compare(a.RelPath, b.RelPath) })
 	return out, nil
 }
+
+// part is a file at path in one layer's FS.
+type part struct {
+	layer Layer
+	path  string
+}
+
+// stackNames joins the distinct non-empty stack names, in order.
+func stackNames(stacks ...string) string {
+	var names []string
+	for _, s := range stacks {
+		if s != "" && !slices.Contains(names, s) {
+			names = append(names, s)
+		}
+	}
+	return strings.Join(names, "+")
+}
+
+// composeSections appends stack sections to a base markdown file (which may
+// be absent), separated by blank lines.
+func composeSections(base part, parts []part) ([]byte, error) {
+	var buf bytes.Buffer
+	if base.path != "" {
+		b, err := fs.ReadFile(base.layer.FS, base.path)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+	for _, p := range parts {
+		b, err := fs.ReadFile(p.layer.FS, p.path)
+		if err != nil {
+			return nil, err
+		}
+		if buf.Len() > 0 {
+			if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+				buf.WriteByte('\n')
+			}
+			buf.WriteByte('\n')
+		}
+		buf.Write(b)
+	}
+	return buf.Bytes(), nil
+}
+
+// MissingStacks returns the selected stacks that have no overlay content in
+// the pack, so callers can warn instead of silently installing nothing.
+func MissingStacks(root fs.FS, stacks []string) []string {
+	return Layers{{FS: root}}.MissingStacks(stacks)
+}
+
+// MissingStacks returns the selected stacks no layer has overlay content for.
+func (ls Layers) MissingStacks(stacks []string) []string {
+	var missing []string
+	for _, s := range stacks {
+		found := false
+		for _, l := range ls {
+			_ = fs.WalkDir(l.FS, path.Join("stacks", s), func(p string, d fs.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				if !d.IsDir() {
+					found = true
+					return fs.SkipAll
+				}
+				return nil
+			})
+		}
+		if !found {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
+
+// fragment is a JSON file contributing to a settings document, optionally
+// nested under a top-level key (hooks.json lands under "hooks"). Snippet
+// fragments register their entries under the event they declare.
+type fragment struct {
+	part
+	key     string
+	snippet bool
+}
+
+// composeJSON overlays JSON fragments in order into a single settings document.
+func composeJSON(parts []fragment) ([]byte, error) {
+	doc := settings.NewObject()
+	for _, part := range parts {
+		if part.snippet {
+			frag, err := snippetSettings(part.layer.FS, part.path)
+			if err != nil {
+				return nil, err
+			}
+			settings.Overlay(doc, frag)
+			continue
+		}
+		b, err := fs.ReadFile(part.layer.FS, part.path)
+		if err != nil {
+			return nil, err
+		}
+		frag, err := settings.Parse(b)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", part.path, err)
+		}
+		if part.key != "" {
+			wrapped := settings.NewObject()
+			wrapped.Set(part.key, frag)
+			frag = wrapped
+		}
+		settings.Overlay(doc, frag)
+	}
+	return settings.Encode(doc), nil
+}
@@ -0,0 +1,74 @@
+package pack
+
+import (
+	"io/fs"
+	"slices"
+	"strings"
+)
+
+// Layer is one pack of a layered install.
+type Layer struct {
+	// Name identifies the layer in the manifest ("upstream", "org", ...).
+	// A pack installed on its own has no name.
+	Name string
+	FS   fs.FS
+}
+
+// Layers is an ordered list of packs composed into one install: upstream
+// first, then organization and team packs that override or extend it.
+type Layers []Layer
+
+// Meta combines the metadata of all layers. Version and CLI requirement are
+// the first layer's; each layer checks its own with Meta.Check. Stacks
+// declared by later layers replace or extend the catalog, and their file
+// rules take precedence.
+func (ls Layers) Meta() (Meta, error) {
+	var out Meta
+	for i, l := range ls {
+		m, err := ReadMeta(l.FS)
+		if err != nil {
+			return Meta{}, err
+		}
+		stacks := m.Stacks
+		if i == 0 {
+			out.Version, out.MinCLIVersion = m.Version, m.MinCLIVersion
+			// The first layer falls back to the default catalog; an
+			// overlay without stacks adds none.
+			if stacks, err = m.Catalog(); err != nil {
+				return Meta{}, err
+			}
+		} else if _, err := m.Catalog(); err != nil {
+			return Meta{}, err
+		}
+		for _, s := range stacks {
+			if j := slices.IndexFunc(out.Stacks, func(o Stack) bool { return o.Key == s.Key }); j >= 0 {
+				out.Stacks[j] = s
+			} else {
+				out.Stacks = append(out.Stacks, s)
+			}
+		}
+		out.Files = append(slices.Clone(m.Files), out.Files...)
+	}
+	return out, nil
+}
+
+// Catalog returns the stacks offered by all layers, in display order.
+func (ls Layers) Catalog() ([]Stack, error) {
+	m, err := ls.Meta()
+	if err != nil {
+		return nil, err
+	}
+	return m.Stacks, nil
+}
+
+// layerNames lists the distinct layers parts come from, in order, joined
+// with "+".
+func layerNames(parts ...part) string {
+	var names []string
+	for _, p := range parts {
+		if p.layer.Name != "" && !slices.Contains(names, p.layer.Name) {
+			names = append(names, p.layer.Name)
+		}
+	}
+	return strings.Join(names, "+")
+}
@@ -0,0 +1,163 @@
+package pack
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLayersOverrideAndExtend(t *testing.T) {
+	upstream := fstest.MapFS{
+		"pack.json":                  {Data: []byte(`{"stacks":[{"key":"go","label":"Go"}]}`)},
+		"dotclaude/agents/review.md": {Data: []byte("upstream review\n")},
+		"dotclaude/commands/ship.md": {Data: []byte("ship\n")},
+		"dotclaude/settings.json":    {Data: []byte(`{"permissions":{"allow":["Bash(go test:*)"]}}`)},
+		"stacks/go/CLAUDE.md":        {Data: []byte("## Go\n")},
+		"stacks/go/commands/vet.md":  {Data: []byte("vet\n")},
+		"CLAUDE.md":                  {Data: []byte("# Project\n")},
+	}
+	org := fstest.MapFS{
+		"pack.json":                  {Data: []byte(`{"files":[{"path":".claude/agents/*.md","policy":"seed-once"}]}`)},
+		"dotclaude/agents/review.md": {Data: []byte("org review\n")},
+		"dotclaude/settings.json":    {Data: []byte(`{"permissions":{"allow":["Bash(make:*)"]}}`)},
+		"stacks/go/CLAUDE.md":        {Data: []byte("## Go at Org\n")},
+	}
+	files, err := Layers{{Name: "upstream", FS: upstream}, {Name: "org", FS: org}}.Files(Selection{Stacks: []string{"go"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]File{}
+	for _, f := range files {
+		got[f.RelPath] = f
+	}
+	read := func(rel string) string {
+		t.Helper()
+		b, err := got[rel].Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+
+	if f := got[".claude/agents/review.md"]; read(f.RelPath) != "org review\n" || f.Layer != "org" || f.Policy != PolicySeedOnce {
+		t.Fatalf("review.md = %q from %q (%s), want the org copy, seed-once", read(f.RelPath), f.Layer, f.Policy)
+	}
+	if f := got[".claude/commands/ship.md"]; f.Layer != "upstream" || f.Stack != "" {
+		t.Fatalf("ship.md layer = %q, stack = %q; want upstream, no stack", f.Layer, f.Stack)
+	}
+	if f := got[".claude/commands/vet.md"]; f.Stack != "go" {
+		t.Fatalf("vet.md stack = %q, want go", f.Stack)
+	}
+	s := read(".claude/settings.json")
+	if !strings.Contains(s, "go test") || !strings.Contains(s, "make") {
+		t.Fatalf("settings.json does not merge both layers:\n%s", s)
+	}
+	if l := got[".claude/settings.json"].Layer; l != "upstream+org" {
+		t.Fatalf("settings.json layer = %q", l)
+	}
+	if m := read("CLAUDE.md"); m != "# Project\n" || got["CLAUDE.md"].Stack != "" {
+		t.Fatalf("CLAUDE.md = %q from stack %q; want the base file alone", m, got["CLAUDE.md"].Stack)
+	}
+	if m := read(".claude/rules/go.md"); m != "## Go\n\n## Go at Org\n" || got[".claude/rules/go.md"].Stack != "go" {
+		t.Fatalf("go rules = %q from stack %q", m, got[".claude/rules/go.md"].Stack)
+	}
+}
+
+func TestDirSourceInstallsOnlyPackFiles(t *testing.T) {
+	dir := t.TempDir()
+	for name, data := range map[string]string{
+		"pack.json":                `{"version":"1.0.0"}`,
+		"dotclaude/commands/x.md":  "x\n",
+		"CLAUDE.md":                "# Project\n",
+		"docs/guide.md":            "guide\n",
+		"docs/.hidden/notes.md":    "notes\n",
+		".git/HEAD":                "ref: refs/heads/main\n",
+		".git/config":              "[core]\n",
+		".github/workflows/ci.yml": "on: push\n",
+		".gitignore":               "dist/\n",
+		"README.md":                "# Pack\n",
+		"LICENSE":                  "MIT\n",
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	src, err := ParseSource("dir:" + dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, _, err := src.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := FilesFromDotclaudeFS(root, Selection{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range files {
+		got = append(got, f.RelPath)
+	}
+	slices.Sort(got)
+	want := []string{".claude/commands/x.md", "CLAUDE.md", "docs/guide.md"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("installed %v, want %v", got, want)
+	}
+}
+
+func TestHooksRegisterInTheHooksTarget(t *testing.T) {
+	root := fstest.MapFS{
+		"pack.json":               {Data: []byte(`{"version":"1.0.0"}`)},
+		"dotclaude/settings.json": {Data: []byte(`{"model":"opus"}`)},
+		"dotclaude/hooks.json":    {Data: []byte(`{"PreToolUse":[{"matcher":"*","hooks":[{"type":"command","command":"guard"}]}]}`)},
+		"dotclaude/snippets/hooks/go.warn.json": {Data: []byte(`{"description":"warn","event":"PostToolUse",
+			"hooks":[{"matcher":"Bash","hooks":[{"type":"command","command":"warn"}]}]}`)},
+	}
+	for _, tc := range []struct {
+		target, path, other string
+	}{
+		{HooksTargetSettings, ".claude/settings.json", ".claude/settings.local.json"},
+		{HooksTargetLocal, ".claude/settings.local.json", ".claude/settings.json"},
+	} {
+		files, err := FilesFromDotclaudeFS(root, Selection{HooksTarget: tc.target, Snippets: []string{"go.warn"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := map[string]string{}
+		for _, f := range files {
+			b, err := f.Read()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got[f.RelPath] = string(b)
+		}
+		if _, ok := got[".claude/hooks.json"]; ok {
+			t.Errorf("%s: hooks.json installed as a file", tc.target)
+		}
+		var s struct {
+			Hooks map[string][]struct {
+				Matcher string `json:"matcher"`
+			} `json:"hooks"`
+		}
+		if err := json.Unmarshal([]byte(got[tc.path]), &s); err != nil {
+			t.Fatalf("%s: %s: %v", tc.target, tc.path, err)
+		}
+		if len(s.Hooks["PreToolUse"]) != 1 || len(s.Hooks["PostToolUse"]) != 1 || s.Hooks["PostToolUse"][0].Matcher != "Bash" {
+			t.Errorf("%s: %s hooks = %+v, want the pack's and the snippet's", tc.target, tc.path, s.Hooks)
+		}
+		if strings.Contains(got[tc.other], `"hooks"`) {
+			t.Errorf("%s: hooks also registered in %s", tc.target, tc.other)
+		}
+		if !strings.Contains(got[".claude/settings.json"], `"model"`) {
+			t.Errorf("%s: settings.json lost the pack's settings", tc.target)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package pack
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver"
+)
+
+// metaFile describes the pack itself; it lives at the pack root and is not
+// installed into projects.
+const metaFile = "pack.json"
+
+// Meta is the content of a pack's pack.json.
+type Meta struct {
+	// Version is the pack's semantic version. A checkout carries a
+	// pre-release version; the release workflow stamps the released one.
+	Version string `json:"version,omitempty"`
+	// MinCLIVersion is the oldest codo that can install the pack.
+	MinCLIVersion string     `json:"min_cli_version,omitempty"`
+	Stacks        []Stack    `json:"stacks,omitempty"`
+	Files         []FileRule `json:"files,omitempty"`
+}
+
+// FileRule assigns an install policy to the destination paths matching Path,
+// a path.Match pattern or a directory prefix ending in "/**". The first
+// matching rule wins; unmatched files are managed.
+type FileRule struct {
+	Path   string `json:"path"`
+	Policy Policy `json:"policy"`
+}
+
+// Stack is one entry of the pack's stack catalog.
+type Stack struct {
+	Key         string   `json:"key"`
+	Label       string   `json:"label"`
+	Description string   `json:"description,omitempty"`
+	Detect      []string `json:"detect,omitempty"`   // marker files (globs) at the repo root
+	Requires    []string `json:"requires,omitempty"` // stacks installed along with this one
+}
+
+// defaultCatalog covers packs published before pack.json existed.
+var defaultCatalog = []Stack{
+	{Key: "go", Label: "Go", Detect: []string{"go.mod"}},
+	{Key: "typescript", Label: "TypeScript / Node", Detect: []string{"package.json", "tsconfig.json"}},
+	{Key: "python", Label: "Python", Detect: []string{"pyproject.toml", "requirements.txt"}},
+	{Key: "flutter", Label: "Flutter", Detect: []string{"pubspec.yaml"}},
+}
+
+// HasMeta reports whether the pack root carries a pack.json. Packs published
+// before pack metadata existed have none.
+func HasMeta(root fs.FS) bool {
+	_, err := fs.Stat(root, metaFile)
+	return err == nil
+}
+
+// ReadMeta loads pack.json from the pack root. A pack without one yields a
+// zero Meta.
+func ReadMeta(root fs.FS) (Meta, error) {
+	var m Meta
+	b, err := fs.ReadFile(root, metaFile)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return m, nil
+		}
+		return m, err
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return m, fmt.Errorf("%s: %w", metaFile, err)
+	}
+	return m, nil
+}
+
+// Check validates the metadata and that the pack can be installed by the
+// CLI at cliVersion. Development builds skip the version requirement.
+func (m Meta) Check(cliVersion string) error {
+	if m.Version != "" {
+		if _, err := semver.Parse(m.Version); err != nil {
+			return fmt.Errorf("%s: version %q is not a semantic version", metaFile, m.Version)
+		}
+	}
+	for _, r := range m.Files {
+		switch r.Policy {
+		case PolicyManaged, PolicySeedOnce, PolicyMergeJSON, PolicyNeverOverwrite:
+		default:
+			return fmt.Errorf("%s: unknown policy %q for %s", metaFile, r.Policy, r.Path)
+		}
+		if _, err := path.Match(r.Path, ""); err != nil {
+			return fmt.Errorf("%s: bad path pattern %q", metaFile, r.Path)
+		}
+	}
+	if m.MinCLIVersion == "" {
+		return nil
+	}
+	min, err := semver.Parse(strings.TrimPrefix(m.MinCLIVersion, "v"))
+	if err != nil {
+		return fmt.Errorf("%s: min_cli_version %q is not a semantic version", metaFile, m.MinCLIVersion)
+	}
+	cur, err := semver.Parse(strings.TrimPrefix(cliVersion, "v"))
+	if err != nil {
+		return nil
+	}
+	if cur.LT(min) {
+		name := "this pack"
+		if m.Version != "" {
+			name = "pack " + m.Version
+		}
+		return fmt.Errorf("%s requires codo %s or newer (this is %s); run `codo upgrade` first", name, min, cur)
+	}
+	return nil
+}
+
+// PolicyFor returns the policy the first matching rule assigns to rel.
+func (m Meta) PolicyFor(rel string) (Policy, bool) {
+	for _, r := range m.Files {
+		if dir, ok := strings.CutSuffix(r.Path, "/**"); ok {
+			if strings.HasPrefix(rel, dir+"/") {
+				return r.Policy, true
+			}
+			continue
+		}
+		if ok, _ := path.Match(r.Path, rel); ok {
+			return r.Policy, true
+		}
+	}
+	return "", false
+}
+
+// Catalog returns the stacks the pack offers, in display order.
+func Catalog(root fs.FS) ([]Stack, error) {
+	m, err := ReadMeta(root)
+	if err != nil {
+		return nil, err
+	}
+	return m.Catalog()
+}
+
+// Catalog returns the declared stacks, or the default ones for packs that
+// predate pack.json.
+func (m Meta) Catalog() ([]Stack, error) {
+	if len(m.Stacks) == 0 {
+		return defaultCatalog, nil
+	}
+	for _, s := range m.Stacks {
+		if s.Key == "" || strings.ContainsAny(s.Key, `/\.,`) {
+			return nil, fmt.Errorf("%s: invalid stack key %q", metaFile, s.Key)
+		}
+	}
+	return m.Stacks, nil
+}
+
+// ResolveStacks validates keys against the catalog and adds the stacks they
+// require. The result follows catalog order.
+func ResolveStacks(catalog []Stack, keys []string) ([]string, error) {
+	want := map[string]bool{}
+	var add func(key, from string) error
+	add = func(key, from string) error {
+		i := slices.IndexFunc(catalog, func(s Stack) bool { return s.Key == key })
+		if i < 0 {
+			if from != "" {
+				return fmt.Errorf("stack %q requires unknown stack %q", from, key)
+			}
+			return fmt.Errorf("unknown stack %q (available: %s)", key, strings.Join(StackKeys(catalog), ", "))
+		}
+		if want[key] {
+			return nil
+		}
+		want[key] = true
+		for _, dep := range catalog[i].Requires {
+			if err := add(dep, key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, k := range keys {
+		if err := add(k, ""); err != nil {
+			return nil, err
+		}
+	}
+	out := []string{}
+	for _, s := range catalog {
+		if want[s.Key] {
+			out = append(out, s.Key)
+		}
+	}
+	return out, nil
+}
+
+// StackKeys lists the catalog's keys.
+func StackKeys(catalog []Stack) []string {
+	keys := make([]string, len(catalog))
+	for i, s := range catalog {
+		keys[i] = s.Key
+	}
+	return keys
+}
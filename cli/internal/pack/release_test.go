@@ -0,0 +1,262 @@
+package pack
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/pack/zipbuild"
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/signature"
+)
+
+// releaseServer stands in for a GitHub releases page: <base>/latest redirects
+// to <base>/tag/<latest> and assets live under <base>/download/<tag>/.
+type releaseServer struct {
+	latest string
+	assets map[string][]byte // "<tag>/<name>" -> content
+	gets   map[string]int
+	key    signature.PublicKey
+	priv   ed25519.PrivateKey
+}
+
+func (rs *releaseServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/releases/latest" {
+		http.Redirect(w, r, "/releases/tag/"+rs.latest, http.StatusFound)
+		return
+	}
+	b, ok := rs.assets[strings.TrimPrefix(r.URL.Path, "/releases/download/")]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method == http.MethodGet {
+		rs.gets[r.URL.Path]++
+	}
+	w.Write(b)
+}
+
+// publish zips a pack made of files and serves it under tag.
+func (rs *releaseServer) publish(t *testing.T, tag string, files map[string]string) {
+	t.Helper()
+	dir := t.TempDir()
+	for rel, content := range files {
+		p := filepath.Join(dir, rel)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	zb, err := zipbuild.Build(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs.assets[tag+"/dotclaude-pack.zip"] = zb
+	rs.assets[tag+"/dotclaude-pack.zip.minisig"] = signature.Sign(rs.priv, rs.key.ID, zb, "file:dotclaude-pack.zip")
+	rs.assets[tag+"/dotclaude-pack.sha256"] = []byte(fmt.Sprintf("%x  dotclaude-pack.zip\n", sha256.Sum256(zb)))
+}
+
+func newReleaseServer(t *testing.T) (*releaseServer, ReleaseSource) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs := &releaseServer{assets: map[string][]byte{}, gets: map[string]int{}, priv: priv}
+	rs.key = signature.PublicKey{ID: [8]byte{1, 2, 3, 4, 5, 6, 7, 8}, Key: pub}
+	prev := Verifier
+	t.Cleanup(func() { Verifier = prev })
+	Verifier = signature.Verifier{Keys: signature.Keyring{rs.key}}
+	srv := httptest.NewServer(rs)
+	t.Cleanup(srv.Close)
+	return rs, ReleaseSource{BaseURL: srv.URL + "/releases", Client: srv.Client()}
+}
+
+func TestResolvePinsLatestAndCachesByDigest(t *testing.T) {
+	rs, src := newReleaseServer(t)
+	rs.publish(t, "v1.0.0", map[string]string{
+		"pack.json":               `{"version":"1.0.0"}`,
+		"dotclaude/agents/a.md":   "a\n",
+		"dotclaude/commands/c.md": "c\n",
+		"docs/readme.md":          "docs\n",
+	})
+	rs.latest = "v1.0.0"
+
+	rel, err := src.Resolve("latest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rel.Tag != "v1.0.0" {
+		t.Fatalf("tag = %q, want v1.0.0", rel.Tag)
+	}
+	if want := fmt.Sprintf("%x", sha256.Sum256(rs.assets["v1.0.0/dotclaude-pack.zip"])); rel.Digest != want {
+		t.Fatalf("digest = %s, want %s", rel.Digest, want)
+	}
+	if !strings.HasSuffix(rel.URL, "/download/v1.0.0/dotclaude-pack.zip") {
+		t.Fatalf("url = %s", rel.URL)
+	}
+	if _, err := os.Stat(filepath.Join(rel.Dir, "dotclaude", "agents", "a.md")); err != nil {
+		t.Fatalf("pack not extracted: %v", err)
+	}
+
+	// A second resolve of the same content is served from the cache.
+	if _, err := src.Resolve("v1.0.0"); err != nil {
+		t.Fatal(err)
+	}
+	if n := rs.gets["/releases/download/v1.0.0/dotclaude-pack.zip"]; n != 1 {
+		t.Fatalf("pack downloaded %d times, want 1", n)
+	}
+	if dir, err := Cached(rel.Digest); err != nil || dir != rel.Dir {
+		t.Fatalf("Cached = %q, %v", dir, err)
+	}
+}
+
+func TestResolveRejectsChecksumMismatch(t *testing.T) {
+	rs, src := newReleaseServer(t)
+	rs.publish(t, "v1.0.0", map[string]string{"dotclaude/a.md": "a\n"})
+	rs.assets["v1.0.0/dotclaude-pack.sha256"] = []byte(strings.Repeat("0", 64) + "\n")
+	if _, err := src.Resolve("v1.0.0"); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("err = %v, want checksum mismatch", err)
+	}
+}
+
+func TestResolveRequiresSignature(t *testing.T) {
+	rs, src := newReleaseServer(t)
+	rs.publish(t, "v1.0.0", map[string]string{"dotclaude/a.md": "a\n"})
+	rs.publish(t, "v1.1.0", map[string]string{"dotclaude/a.md": "b\n"})
+	delete(rs.assets, "v1.0.0/dotclaude-pack.zip.minisig")
+	if _, err := src.Resolve("v1.0.0"); err == nil || !strings.Contains(err.Error(), "not signed") {
+		t.Fatalf("err = %v, want unsigned pack rejected", err)
+	}
+	// A signature over other content, e.g. a swapped archive.
+	rs.assets["v1.1.0/dotclaude-pack.zip.minisig"] = signature.Sign(rs.priv, rs.key.ID, []byte("other"), "x")
+	if _, err := src.Resolve("v1.1.0"); err == nil || !strings.Contains(err.Error(), "verification failed") {
+		t.Fatalf("err = %v, want bad signature rejected", err)
+	}
+	if entries, _ := CacheEntries(); len(entries) != 0 {
+		t.Fatalf("rejected packs were cached: %v", entries)
+	}
+
+	Verifier.Insecure = true
+	if _, err := src.Resolve("v1.0.0"); err != nil {
+		t.Fatalf("insecure: %v", err)
+	}
+	// Once signatures are checked again, the unverified cache entry is not
+	// trusted, neither directly nor as an offline fallback.
+	Verifier.Insecure = false
+	if _, err := src.NewestCached("v1.0.0"); err == nil {
+		t.Fatal("unverified pack used as offline fallback")
+	}
+	if _, err := src.Resolve("v1.0.0"); err == nil {
+		t.Fatal("unverified cached pack trusted")
+	}
+}
+
+func TestCompareReleases(t *testing.T) {
+	rs, src := newReleaseServer(t)
+	rs.publish(t, "v1.0.0", map[string]string{
+		"dotclaude/agents/a.md":   "a\n",
+		"dotclaude/commands/c.md": "c\n",
+		"docs/old.md":             "old\n",
+	})
+	rs.publish(t, "v1.1.0", map[string]string{
+		"dotclaude/agents/a.md":   "a, revised\n",
+		"dotclaude/commands/c.md": "c\n",
+		"docs/new.md":             "new\n",
+	})
+	rs.latest = "v1.1.0"
+
+	pin, err := src.Pin("latest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pin.Tag != "v1.1.0" {
+		t.Fatalf("latest = %s", pin.Tag)
+	}
+	files := func(tag string) []File {
+		rel, err := src.Resolve(tag)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fs, err := FilesFromDotclaudeFS(os.DirFS(rel.Dir), Selection{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return fs
+	}
+	c, err := Compare(files("v1.0.0"), files(pin.Tag))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(c.Added, []string{"docs/new.md"}) ||
+		!slices.Equal(c.Changed, []string{".claude/agents/a.md"}) ||
+		!slices.Equal(c.Removed, []string{"docs/old.md"}) {
+		t.Fatalf("changes = %+v", c)
+	}
+}
+
+func TestCacheFallbackExportImport(t *testing.T) {
+	rs, src := newReleaseServer(t)
+	rs.publish(t, "v1.0.0", map[string]string{"pack.json": `{"version":"1.0.0"}`, "dotclaude/a.md": "a\n"})
+	rs.publish(t, "v1.1.0", map[string]string{"pack.json": `{"version":"1.1.0"}`, "dotclaude/a.md": "a2\n"})
+	for _, tag := range []string{"v1.1.0", "v1.0.0"} {
+		if _, err := src.Resolve(tag); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Offline, latest falls back to the highest cached version, not the
+	// most recent download.
+	rel, err := src.NewestCached("latest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rel.Tag != "v1.1.0" {
+		t.Fatalf("fallback = %s, want v1.1.0", rel.Tag)
+	}
+	if _, err := (ReleaseSource{BaseURL: "https://elsewhere"}).NewestCached("latest"); err == nil {
+		t.Fatal("packs of other releases must not be used")
+	}
+
+	e, err := FindCached("v1.1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(t.TempDir(), "pack.zip")
+	if err := e.Export(out); err != nil {
+		t.Fatal(err)
+	}
+	// A corrupted cache entry is skipped.
+	if err := os.WriteFile(filepath.Join(e.path, "pack.zip"), []byte("junk"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if rel, err := src.NewestCached("latest"); err != nil || rel.Tag != "v1.0.0" {
+		t.Fatalf("fallback = %v, %v; want v1.0.0", rel.Tag, err)
+	}
+
+	// Import onto a fresh machine.
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	if _, err := src.Import(out, ""); err != nil {
+		t.Fatal(err)
+	}
+	if rel, err := src.NewestCached("v1.1.0"); err != nil || rel.Digest != e.Digest {
+		t.Fatalf("imported = %+v, %v", rel, err)
+	}
+	if err := os.WriteFile(out+".sha256", []byte(strings.Repeat("0", 64)+"  pack.zip\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.Import(out, ""); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("err = %v, want checksum mismatch", err)
+	}
+}
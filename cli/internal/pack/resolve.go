@@ -4,33 +4,67 @@ import (
 	"archive/zip"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/signature"
 )
 
 var httpClient = &http.Client{
 	Timeout: 30 * time.Second,
 }
 
+// ReleaseSource locates pack assets on a GitHub-style releases page. Tests
+// point BaseURL at a stand-in server.
+type ReleaseSource struct {
+	BaseURL string // e.g. https://github.com/<owner>/<repo>/releases
+	Client  *http.Client
+}
+
+// Verifier checks the detached signatures of downloaded packs. cmd sets its
+// keys from the key built into the CLI and the user's trusted keys.
+var Verifier signature.Verifier
+
+// DefaultReleases is where published packs live.
+var DefaultReleases = ReleaseSource{BaseURL: "https://github.com/hergert/codo-agentic-toolkit/releases"}
+
+// Release is a pack release resolved to immutable coordinates.
+type Release struct {
+	Tag    string // concrete tag (the moving tag for edge builds), or a git commit
+	Digest string // sha256 of the pack zip
+	URL    string // download URL of the pack zip
+	Dir    string // extracted pack root in the cache
+}
+
+func (s ReleaseSource) client() *http.Client {
+	if s.Client != nil {
+		return s.Client
+	}
+	return httpClient
+}
+
 // headOK checks if a URL is accessible via HEAD request
-func headOK(u string) bool {
+func (s ReleaseSource) headOK(u string) bool {
 	req, err := http.NewRequest(http.MethodHead, u, nil)
 	if err != nil {
 		return false
 	}
-	r, err := httpClient.Do(req)
+	r, err := s.client().Do(req)
 	if err != nil {
 		gr, gerr := http.NewRequest(http.MethodGet, u, nil)
 		if gerr != nil {
 			return false
 		}
 		gr.Header.Set("Range", "bytes=0-0")
-		resp, gerr := httpClient.Do(gr)
+		resp, gerr := s.client().Do(gr)
 		if gerr != nil {
 			return false
 		}
@@ -41,17 +75,39 @@ func headOK(u string) bool {
 	return r.StatusCode == http.StatusOK
 }
 
-// Resolve downloads and verifies a pack from GitHub releases
-func Resolve(tag string) (string, error) {
-	baseURL := "https://github.com/hergert/codo-agentic-toolkit/releases"
+// LatestTag resolves the latest release to its tag by reading the redirect
+// of <base>/latest, which points at <base>/tag/<tag>.
+func (s ReleaseSource) LatestTag() (string, error) {
+	c := *s.client()
+	c.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	resp, err := c.Get(s.BaseURL + "/latest")
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	loc := resp.Header.Get("Location")
+	_, tag, ok := strings.Cut(loc, "/tag/")
+	if !ok || tag == "" {
+		return "", fmt.Errorf("could not resolve latest release (status %s)", resp.Status)
+	}
+	return strings.Trim(tag, "/"), nil
+}
+
+// Resolve downloads and verifies a pack using the default release source.
+func Resolve(tag string) (Release, error) {
+	return DefaultReleases.Resolve(tag)
+}
 
-	// Normalize tag for URL construction
-	urlTag := tag
+// Pin resolves tag to an immutable release without downloading the pack:
+// "latest" becomes the newest release's tag, and every release, including
+// moving tags such as "edge", is pinned by the digest of its pack.
+func (s ReleaseSource) Pin(tag string) (Release, error) {
 	if tag == "" || tag == "latest" {
-		urlTag = "latest/download"
-		tag = "latest"
-	} else {
-		urlTag = "download/" + tag
+		latest, err := s.LatestTag()
+		if err != nil {
+			return Release{}, err
+		}
+		tag = latest
 	}
 
 	// Try both naming patterns: flat names (recommended) and tag-suffixed names (legacy)
@@ -65,9 +121,9 @@ func Resolve(tag string) (string, error) {
 	}
 
 	for _, p := range patterns {
-		testPackURL := fmt.Sprintf("%s/%s/%s", baseURL, urlTag, p.zip)
-		testChecksumURL := fmt.Sprintf("%s/%s/%s", baseURL, urlTag, p.sha)
-		if headOK(testPackURL) && headOK(testChecksumURL) {
+		testPackURL := fmt.Sprintf("%s/download/%s/%s", s.BaseURL, tag, p.zip)
+		testChecksumURL := fmt.Sprintf("%s/download/%s/%s", s.BaseURL, tag, p.sha)
+		if s.headOK(testPackURL) && s.headOK(testChecksumURL) {
 			packURL = testPackURL
 			checksumURL = testChecksumURL
 			break
@@ -75,66 +131,198 @@ func Resolve(tag string) (string, error) {
 	}
 
 	if packURL == "" {
-		return "", fmt.Errorf("no pack asset found for tag=%s", tag)
+		return Release{}, fmt.Errorf("no pack asset found for tag=%s", tag)
 	}
 
-	// Download to ~/.codo/packs/<tag>/
-	home, err := os.UserHomeDir()
+	// The checksum names the content; it keys the cache.
+	digest, err := s.fetchChecksum(checksumURL)
 	if err != nil {
-		return "", err
-	}
-	cacheDir := filepath.Join(home, ".codo", "packs", strings.ReplaceAll(tag, "/", "_"))
-	if err := os.MkdirAll(cacheDir, 0755); err != nil {
-		return "", err
+		return Release{}, fmt.Errorf("failed to download checksum: %w", err)
 	}
+	return Release{Tag: tag, Digest: digest, URL: packURL}, nil
+}
 
-	zipPath := filepath.Join(cacheDir, "dotclaude-pack.zip")
+// Resolve pins tag and returns the release extracted in the cache. The cache
+// is keyed by digest, so a pack already downloaded is not fetched again.
+func (s ReleaseSource) Resolve(tag string) (Release, error) {
+	rel, err := s.Pin(tag)
+	if err != nil {
+		return Release{}, err
+	}
+	if e, err := FindCached(rel.Digest); err == nil && e.Dir != "" {
+		if e.SignedBy != "" || Verifier.Insecure {
+			rel.Dir = e.Dir
+			return rel, nil
+		}
+		// Cached without a signature check (--insecure); fetch it again
+		// to verify.
+		if err := e.Remove(); err != nil {
+			return Release{}, err
+		}
+	}
+	zipPath, err := s.download(rel.URL)
+	if err != nil {
+		return Release{}, fmt.Errorf("failed to download pack: %w", err)
+	}
+	defer os.Remove(zipPath)
 
-	// Download pack
-	if err := downloadFile(zipPath, packURL); err != nil {
-		return "", fmt.Errorf("failed to download pack: %w", err)
+	// Calculate actual checksum
+	actual, err := fileChecksum(zipPath)
+	if err != nil {
+		return Release{}, err
+	}
+	if actual != rel.Digest {
+		return Release{}, fmt.Errorf("checksum mismatch: expected %s, got %s", rel.Digest, actual)
+	}
+	e := CacheEntry{Digest: rel.Digest, Tag: rel.Tag, URL: rel.URL, Origin: s.BaseURL}
+	if err := s.verify(zipPath, rel.URL, &e); err != nil {
+		return Release{}, err
+	}
+	if rel.Dir, err = unpack(zipPath, e); err != nil {
+		return Release{}, err
 	}
+	return rel, nil
+}
 
-	// Download and verify checksum
-	checksumPath := filepath.Join(cacheDir, "dotclaude-pack.sha256")
-	if err := downloadFile(checksumPath, checksumURL); err != nil {
-		return "", fmt.Errorf("failed to download checksum: %w", err)
+// Cached returns the extracted pack with the given digest if it has been
+// downloaded before.
+func Cached(digest string) (string, error) {
+	cacheDir, err := packCacheDir(digest)
+	if err != nil {
+		return "", err
 	}
+	return canonicalPackRoot(filepath.Join(cacheDir, "pack"))
+}
 
-	// Read expected checksum
-	expectedBytes, err := os.ReadFile(checksumPath)
+// unpack extracts a verified pack zip into the cache under its digest,
+// keeping the archive for verification and export, and returns the pack root.
+// The entry is assembled in a temporary directory and renamed into place
+// complete, so the cache never holds a partial pack.
+func unpack(zipPath string, e CacheEntry) (string, error) {
+	if dir, err := Cached(e.Digest); err == nil {
+		return dir, nil
+	}
+	cacheDir, err := packCacheDir(e.Digest)
 	if err != nil {
 		return "", err
 	}
-	expectedLine := strings.TrimSpace(string(expectedBytes))
-	fields := strings.Fields(expectedLine)
-	if len(fields) == 0 {
-		return "", fmt.Errorf("empty checksum file: %s", checksumPath)
+	if err := os.MkdirAll(filepath.Dir(cacheDir), 0755); err != nil {
+		return "", err
+	}
+	tmp, err := os.MkdirTemp(filepath.Dir(cacheDir), ".unpack-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+	if err := extractZip(zipPath, filepath.Join(tmp, "pack")); err != nil {
+		return "", fmt.Errorf("failed to extract pack: %w", err)
+	}
+	if e.Dir, err = canonicalPackRoot(filepath.Join(tmp, "pack")); err != nil {
+		return "", err
+	}
+	if err := copyFile(zipPath, filepath.Join(tmp, "pack.zip")); err != nil {
+		return "", err
+	}
+	if e.sig != nil {
+		if err := os.WriteFile(filepath.Join(tmp, "pack.zip.minisig"), e.sig, 0o644); err != nil {
+			return "", err
+		}
 	}
-	expected := fields[0]
+	if err := writeEntry(tmp, e); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, cacheDir); err != nil {
+		// Another process may have cached the same pack meanwhile.
+		if dir, cerr := Cached(e.Digest); cerr == nil {
+			return dir, nil
+		}
+		return "", err
+	}
+	return Cached(e.Digest)
+}
 
-	// Calculate actual checksum
-	actual, err := fileChecksum(zipPath)
+// packCacheDir is packs/sha256/<digest> in the cache.
+func packCacheDir(digest string) (string, error) {
+	if len(digest) != sha256.Size*2 || strings.Trim(digest, "0123456789abcdef") != "" {
+		return "", fmt.Errorf("invalid pack digest %q", digest)
+	}
+	root, err := packsRoot()
 	if err != nil {
 		return "", err
 	}
+	return filepath.Join(root, "sha256", digest), nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
 
-	if actual != expected {
-		return "", fmt.Errorf("checksum mismatch: expected %s, got %s", expected, actual)
+// verify checks the downloaded zip at zipPath against the signature
+// published next to it at url.minisig and records the result in e.
+func (s ReleaseSource) verify(zipPath, url string, e *CacheEntry) error {
+	sig, err := s.fetchSignature(url + ".minisig")
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(zipPath)
+	if err != nil {
+		return err
 	}
+	if e.SignedBy, err = Verifier.Verify(path.Base(url), data, sig); err != nil {
+		return err
+	}
+	e.sig = sig
+	return nil
+}
 
-	// Extract pack
-	extractDir := filepath.Join(cacheDir, "pack")
-	if err := extractZip(zipPath, extractDir); err != nil {
-		return "", fmt.Errorf("failed to extract pack: %w", err)
+// fetchSignature downloads a detached signature; it returns nil if the
+// artifact has none.
+func (s ReleaseSource) fetchSignature(url string) ([]byte, error) {
+	resp, err := s.client().Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("download signature: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download signature: %s", resp.Status)
 	}
+	return io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+}
 
-	normalized, err := canonicalPackRoot(extractDir)
+func (s ReleaseSource) fetchChecksum(url string) (string, error) {
+	resp, err := s.client().Get(url)
 	if err != nil {
 		return "", err
 	}
-
-	return normalized, nil
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("bad status: %s", resp.Status)
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+	if err != nil {
+		return "", err
+	}
+	fields := strings.Fields(string(b))
+	if len(fields) == 0 {
+		return "", fmt.Errorf("empty checksum file: %s", url)
+	}
+	return strings.ToLower(fields[0]), nil
 }
 
 func canonicalPackRoot(root string) (string, error) {
@@ -158,25 +346,36 @@ func canonicalPackRoot(root string) (string, error) {
 	return "", fmt.Errorf("dotclaude directory not found in pack (checked %v)", candidates)
 }
 
-func downloadFile(filepath string, url string) error {
-	resp, err := httpClient.Get(url)
+// download fetches url into a temporary file in the packs cache.
+func (s ReleaseSource) download(url string) (string, error) {
+	resp, err := s.client().Get(url)
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("bad status: %s", resp.Status)
+		return "", fmt.Errorf("bad status: %s", resp.Status)
 	}
 
-	out, err := os.Create(filepath)
+	root, err := packsRoot()
 	if err != nil {
-		return err
+		return "", err
+	}
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return "", err
+	}
+	out, err := os.CreateTemp(root, "download-*.zip")
+	if err != nil {
+		return "", err
 	}
 	defer out.Close()
 
-	_, err = io.Copy(out, resp.Body)
-	return err
+	if _, err := io.Copy(out, resp.Body); err != nil {
+		os.Remove(out.Name())
+		return "", err
+	}
+	return out.Name(), nil
 }
 
 func fileChecksum(filepath string) (string, error) {
@@ -194,47 +393,111 @@ func fileChecksum(filepath string) (string, error) {
 	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
+// Limits on what a pack archive may expand to, far above any real pack, so a
+// corrupt or hostile archive cannot fill the disk.
+var (
+	maxPackFiles       = 10000
+	maxPackSize  int64 = 256 << 20
+)
+
+// extractZip extracts the archive src into dest, which must not exist yet.
+// Entries are written to a temporary directory next to dest that is renamed
+// into place only once every entry has been checked and written, so a
+// rejected or interrupted archive leaves nothing behind. Entries escaping
+// dest, symlinks, devices and other special files are rejected.
 func extractZip(src, dest string) error {
 	r, err := zip.OpenReader(src)
 	if err != nil {
 		return err
 	}
 	defer r.Close()
+	if len(r.File) > maxPackFiles {
+		return fmt.Errorf("archive has %d entries, more than the %d allowed", len(r.File), maxPackFiles)
+	}
 
-	os.MkdirAll(dest, 0755)
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.MkdirTemp(filepath.Dir(dest), ".extract-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
 
+	remaining := maxPackSize
 	for _, f := range r.File {
-		rc, err := f.Open()
+		name, err := entryPath(f.Name)
 		if err != nil {
 			return err
 		}
-
-		path := filepath.Join(dest, f.Name)
-
-		if f.FileInfo().IsDir() {
-			os.MkdirAll(path, f.Mode())
-			rc.Close()
+		target := filepath.Join(tmp, name)
+		mode := f.Mode()
+		switch {
+		case mode&fs.ModeSymlink != 0:
+			return fmt.Errorf("%s: archive contains a symlink", f.Name)
+		case mode.IsDir():
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
 			continue
+		case !mode.IsRegular():
+			return fmt.Errorf("%s: archive contains a special file (%s)", f.Name, mode.Type())
 		}
-
-		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
-			rc.Close()
+		if f.UncompressedSize64 > uint64(remaining) {
+			return fmt.Errorf("archive expands to more than %d bytes", maxPackSize)
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
 			return err
 		}
-
-		outFile, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, f.Mode())
+		n, err := extractFile(f, target, remaining)
 		if err != nil {
-			rc.Close()
 			return err
 		}
+		remaining -= n
+	}
+	return os.Rename(tmp, dest)
+}
 
-		_, err = io.Copy(outFile, rc)
-		outFile.Close()
-		rc.Close()
-
-		if err != nil {
-			return err
+// extractFile writes f to target, failing once more than limit bytes come
+// out whatever the entry's header claims.
+func extractFile(f *zip.File, target string, limit int64) (int64, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+	perm := os.FileMode(0644)
+	if f.Mode()&0111 != 0 {
+		perm = 0755
+	}
+	// O_EXCL: an entry listed twice must not replace the first copy.
+	out, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			return 0, fmt.Errorf("%s: duplicate archive entry", f.Name)
 		}
+		return 0, err
 	}
-	return nil
+	n, err := io.Copy(out, io.LimitReader(rc, limit+1))
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return n, fmt.Errorf("%s: %w", f.Name, err)
+	}
+	if n > limit {
+		return n, fmt.Errorf("archive expands to more than %d bytes", maxPackSize)
+	}
+	return n, nil
+}
+
+// entryPath checks an archive entry name stays inside the destination and
+// returns it as a relative OS path.
+func entryPath(name string) (string, error) {
+	slashed := strings.ReplaceAll(name, `\`, "/")
+	p := filepath.FromSlash(path.Clean(slashed))
+	if name == "" || path.IsAbs(slashed) || !filepath.IsLocal(p) {
+		return "", fmt.Errorf("%s: archive entry escapes the pack directory", name)
+	}
+	return p, nil
 }
@@ -0,0 +1,142 @@
+package pack
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/hergert/codo-agentic-toolkit/cli/internal/settings"
+)
+
+// snippetsRoot holds optional hook definitions users opt into one by one.
+const snippetsRoot = "dotclaude/snippets/hooks"
+
+// Snippet is an optional set of hook entries registered under one event.
+type Snippet struct {
+	Name        string
+	Description string
+	Event       string
+	Stacks      []string // stacks the snippet is suggested for
+	part
+}
+
+// snippetFile is the on-disk form. Older packs ship a bare array of matcher
+// entries, which registers under PreToolUse.
+type snippetFile struct {
+	Description string          `json:"description"`
+	Event       string          `json:"event"`
+	Stacks      []string        `json:"stacks"`
+	Hooks       json.RawMessage `json:"hooks"`
+}
+
+// Snippets lists the hook snippets available in the pack, sorted by name.
+func Snippets(root fs.FS) ([]Snippet, error) {
+	return Layers{{FS: root}}.Snippets()
+}
+
+// Snippets lists the hook snippets of all layers, sorted by name. A layer's
+// snippet replaces an earlier one of the same name.
+func (ls Layers) Snippets() ([]Snippet, error) {
+	var out []Snippet
+	for _, l := range ls {
+		snips, err := layerSnippets(l)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range snips {
+			if i := slices.IndexFunc(out, func(o Snippet) bool { return o.Name == s.Name }); i >= 0 {
+				out[i] = s
+			} else {
+				out = append(out, s)
+			}
+		}
+	}
+	slices.SortFunc(out, func(a, b Snippet) int { return strings.Compare(a.Name, b.Name) })
+	return out, nil
+}
+
+func layerSnippets(l Layer) ([]Snippet, error) {
+	root := l.FS
+	entries, err := fs.ReadDir(root, snippetsRoot)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var out []Snippet
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+		p := path.Join(snippetsRoot, e.Name())
+		sf, err := readSnippet(root, p)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, Snippet{
+			Name:        strings.TrimSuffix(e.Name(), ".json"),
+			Description: sf.Description,
+			Event:       sf.Event,
+			Stacks:      sf.Stacks,
+			part:        part{l, p},
+		})
+	}
+	return out, nil
+}
+
+// SuggestSnippets returns the names of snippets meant for any of the stacks.
+func SuggestSnippets(snips []Snippet, stacks []string) []string {
+	var out []string
+	for _, s := range snips {
+		for _, st := range s.Stacks {
+			if slices.Contains(stacks, st) {
+				out = append(out, s.Name)
+				break
+			}
+		}
+	}
+	return out
+}
+
+func readSnippet(root fs.FS, p string) (snippetFile, error) {
+	b, err := fs.ReadFile(root, p)
+	if err != nil {
+		return snippetFile{}, err
+	}
+	var sf snippetFile
+	if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 && trimmed[0] == '[' {
+		sf.Hooks = trimmed
+	} else if err := json.Unmarshal(b, &sf); err != nil {
+		return snippetFile{}, fmt.Errorf("%s: %w", p, err)
+	}
+	if sf.Event == "" {
+		sf.Event = "PreToolUse"
+	}
+	return sf, nil
+}
+
+// snippetSettings renders a snippet as a settings fragment: {"hooks": {event: [...]}}.
+func snippetSettings(root fs.FS, p string) (*settings.Object, error) {
+	sf, err := readSnippet(root, p)
+	if err != nil {
+		return nil, err
+	}
+	list, err := settings.ParseValue(sf.Hooks)
+	if err != nil {
+		return nil, fmt.Errorf("%s: hooks: %w", p, err)
+	}
+	if _, ok := list.([]any); !ok {
+		return nil, fmt.Errorf("%s: hooks must be an array of matcher entries", p)
+	}
+	events := settings.NewObject()
+	events.Set(sf.Event, list)
+	doc := settings.NewObject()
+	doc.Set("hooks", events)
+	return doc, nil
+}
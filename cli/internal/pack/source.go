@@ -0,0 +1,256 @@
+package pack
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultRepo publishes the upstream pack.
+const DefaultRepo = "hergert/codo-agentic-toolkit"
+
+// Source is somewhere a pack can be installed from. Its spec is recorded in
+// the manifest so later updates go back to the same place.
+type Source interface {
+	// Spec is the canonical --from form of the source.
+	Spec() string
+	// Open fetches the pack and returns its root. The release pins what was
+	// fetched; its fields are empty where the source has nothing to pin.
+	Open() (fs.FS, Release, error)
+}
+
+// ParseSource parses a --from spec:
+//
+//	dir:<path>                 a pack checkout on disk
+//	zip:<path>                 a pack archive on disk
+//	https://host/pack.zip      a pack archive on a web server
+//	git+https://host/repo@ref  a git repository at a branch, tag or commit
+//	github:<org>/<repo>[@tag]  release assets of a GitHub repository (default: latest)
+//	embedded                   the pack built into this binary
+func ParseSource(spec string) (Source, error) {
+	switch {
+	case spec == "embedded":
+		return EmbeddedSource{}, nil
+	case strings.HasPrefix(spec, "dir:"):
+		return DirSource{Path: strings.TrimPrefix(spec, "dir:")}, nil
+	case strings.HasPrefix(spec, "zip:"):
+		return ZipSource{Path: strings.TrimPrefix(spec, "zip:")}, nil
+	case strings.HasPrefix(spec, "git+"):
+		repo, ref := splitRef(strings.TrimPrefix(spec, "git+"))
+		if ref == "" {
+			return nil, fmt.Errorf("git source %q needs a ref (git+https://host/repo@ref)", spec)
+		}
+		return GitSource{Repo: repo, Ref: ref}, nil
+	case strings.HasPrefix(spec, "github:"):
+		repo, tag := splitRef(strings.TrimPrefix(spec, "github:"))
+		if strings.Count(repo, "/") != 1 {
+			return nil, fmt.Errorf("github source %q must name <org>/<repo>", spec)
+		}
+		return NewGitHubSource(repo, tag), nil
+	case strings.HasPrefix(spec, "https://"), strings.HasPrefix(spec, "http://"):
+		return URLSource{URL: spec}, nil
+	}
+	return nil, fmt.Errorf("unknown pack source %q (want dir:, zip:, https://, git+https://, github: or embedded)", spec)
+}
+
+// splitRef splits "<location>@<ref>", where the ref follows the last path
+// segment's @.
+func splitRef(s string) (string, string) {
+	i := strings.LastIndex(s, "@")
+	if i < 0 || i < strings.LastIndex(s, "/") {
+		return s, ""
+	}
+	return s[:i], s[i+1:]
+}
+
+// EmbeddedSource is the pack compiled into the CLI.
+type EmbeddedSource struct{}
+
+func (EmbeddedSource) Spec() string { return "embedded" }
+
+func (EmbeddedSource) Open() (fs.FS, Release, error) {
+	root, err := GetEmbeddedBaseFS()
+	return root, Release{}, err
+}
+
+// DirSource is a pack directory, e.g. a checkout of an internal fork.
+type DirSource struct{ Path string }
+
+func (s DirSource) Spec() string { return "dir:" + s.Path }
+
+// Open checks the directory looks like a codo pack, so a repository's
+// unrelated pack/ folder is never mistaken for one.
+func (s DirSource) Open() (fs.FS, Release, error) {
+	info, err := os.Stat(s.Path)
+	if err != nil {
+		return nil, Release{}, err
+	}
+	if !info.IsDir() {
+		return nil, Release{}, fmt.Errorf("%s is not a directory", s.Path)
+	}
+	var missing []string
+	for _, name := range []string{"dotclaude", metaFile} {
+		if _, err := os.Stat(filepath.Join(s.Path, name)); err != nil {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, Release{}, fmt.Errorf("%s is not a codo pack (missing %s)", s.Path, strings.Join(missing, " and "))
+	}
+	dir, err := filepath.Abs(s.Path)
+	if err != nil {
+		return nil, Release{}, err
+	}
+	return os.DirFS(dir), Release{Dir: dir}, nil
+}
+
+// ZipSource is a pack archive on disk. It is unpacked into the cache under
+// its digest.
+type ZipSource struct{ Path string }
+
+func (s ZipSource) Spec() string { return "zip:" + s.Path }
+
+func (s ZipSource) Open() (fs.FS, Release, error) {
+	digest, err := fileChecksum(s.Path)
+	if err != nil {
+		return nil, Release{}, err
+	}
+	abs, err := filepath.Abs(s.Path)
+	if err != nil {
+		return nil, Release{}, err
+	}
+	dir, err := unpack(s.Path, CacheEntry{Digest: digest, Origin: "zip:" + abs})
+	if err != nil {
+		return nil, Release{}, err
+	}
+	return os.DirFS(dir), Release{Digest: digest, Dir: dir}, nil
+}
+
+// URLSource is a pack archive served over HTTP(S), signed like releases.
+type URLSource struct{ URL string }
+
+func (s URLSource) Spec() string { return s.URL }
+
+func (s URLSource) Open() (fs.FS, Release, error) {
+	zipPath, err := ReleaseSource{}.download(s.URL)
+	if err != nil {
+		return nil, Release{}, fmt.Errorf("failed to download pack: %w", err)
+	}
+	defer os.Remove(zipPath)
+	digest, err := fileChecksum(zipPath)
+	if err != nil {
+		return nil, Release{}, err
+	}
+	e := CacheEntry{Digest: digest, URL: s.URL, Origin: s.URL}
+	if err := (ReleaseSource{}).verify(zipPath, s.URL, &e); err != nil {
+		return nil, Release{}, err
+	}
+	dir, err := unpack(zipPath, e)
+	if err != nil {
+		return nil, Release{}, err
+	}
+	return os.DirFS(dir), Release{Digest: digest, URL: s.URL, Dir: dir}, nil
+}
+
+// GitSource is a git repository at a ref. The ref is pinned to the commit it
+// points at, which keys the cache.
+type GitSource struct {
+	Repo string
+	Ref  string
+}
+
+func (s GitSource) Spec() string { return "git+" + s.Repo + "@" + s.Ref }
+
+func (s GitSource) Open() (fs.FS, Release, error) {
+	root, err := packsRoot()
+	if err != nil {
+		return nil, Release{}, err
+	}
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, Release{}, err
+	}
+	tmp, err := os.MkdirTemp(root, "git-*")
+	if err != nil {
+		return nil, Release{}, err
+	}
+	defer os.RemoveAll(tmp)
+	git := func(args ...string) (string, error) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = tmp
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			return "", fmt.Errorf("git %s: %v: %s", args[0], err, strings.TrimSpace(string(out)))
+		}
+		return strings.TrimSpace(string(out)), nil
+	}
+	if _, err := git("init", "-q"); err != nil {
+		return nil, Release{}, err
+	}
+	if _, err := git("fetch", "-q", "--depth", "1", s.Repo, s.Ref); err != nil {
+		return nil, Release{}, err
+	}
+	commit, err := git("rev-parse", "FETCH_HEAD")
+	if err != nil {
+		return nil, Release{}, err
+	}
+	rel := Release{Tag: commit, URL: s.Repo}
+
+	cacheDir := filepath.Join(root, "git", commit)
+	if dir, err := canonicalPackRoot(filepath.Join(cacheDir, "pack")); err == nil {
+		rel.Dir = dir
+		return os.DirFS(dir), rel, nil
+	}
+	if _, err := git("checkout", "-q", "FETCH_HEAD"); err != nil {
+		return nil, Release{}, err
+	}
+	if err := os.RemoveAll(filepath.Join(tmp, ".git")); err != nil {
+		return nil, Release{}, err
+	}
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return nil, Release{}, err
+	}
+	if err := os.Rename(tmp, filepath.Join(cacheDir, "pack")); err != nil {
+		return nil, Release{}, err
+	}
+	if rel.Dir, err = canonicalPackRoot(filepath.Join(cacheDir, "pack")); err != nil {
+		return nil, Release{}, err
+	}
+	if err := writeEntry(cacheDir, CacheEntry{Commit: commit, Tag: s.Ref, Origin: s.Repo, Dir: rel.Dir}); err != nil {
+		return nil, Release{}, err
+	}
+	return os.DirFS(rel.Dir), rel, nil
+}
+
+// GitHubSource is the pack published as release assets of a GitHub
+// repository. Tag "latest" (or empty) and moving tags are pinned on Open.
+type GitHubSource struct {
+	Repo     string
+	Tag      string
+	Releases ReleaseSource
+}
+
+// NewGitHubSource returns the release source of repo. The upstream repo uses
+// DefaultReleases, so a mirror configured there applies.
+func NewGitHubSource(repo, tag string) *GitHubSource {
+	rs := ReleaseSource{BaseURL: "https://github.com/" + repo + "/releases"}
+	if repo == DefaultRepo {
+		rs = DefaultReleases
+	}
+	if tag == "" {
+		tag = "latest"
+	}
+	return &GitHubSource{Repo: repo, Tag: tag, Releases: rs}
+}
+
+func (s *GitHubSource) Spec() string { return "github:" + s.Repo + "@" + s.Tag }
+
+func (s *GitHubSource) Open() (fs.FS, Release, error) {
+	rel, err := s.Releases.Resolve(s.Tag)
+	if err != nil {
+		return nil, Release{}, err
+	}
+	return os.DirFS(rel.Dir), rel, nil
+}
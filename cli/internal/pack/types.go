@@ -1,14 +1,77 @@
 package pack
 
+import "fmt"
+
+// Policy controls how an installed file is reconciled with the project's copy.
+type Policy string
+
+const (
+	// PolicyManaged files are overwritten when clean and merged line by line
+	// when edited locally.
+	PolicyManaged Policy = "managed"
+	// PolicyMergeJSON files are deep-merged with the project's copy so user
+	// keys and permission rules survive install, update and removal.
+	PolicyMergeJSON Policy = "merge-json"
+	// PolicySeedOnce files are installed once and then belong to the
+	// project: updates never touch them and removal leaves them in place.
+	PolicySeedOnce Policy = "seed-once"
+	// PolicyNeverOverwrite files are installed when missing but an existing
+	// copy is never replaced or merged.
+	PolicyNeverOverwrite Policy = "never-overwrite"
+)
+
 type File struct {
 	RelPath string
 	Read    func() ([]byte, error)
+	Policy  Policy
+	// Layer names the layer the file came from; composed files list every
+	// contributing layer joined with "+". Empty for a single pack.
+	Layer string
+	// Stack names the stack overlay that provides or extends the file, joined
+	// with "+" like Layer. Empty for base files.
+	Stack string
+}
+
+// Selection describes what to install from a pack.
+type Selection struct {
+	Stacks []string
+	// HooksTarget picks the settings file that receives hook definitions:
+	// HooksTargetSettings (default) or HooksTargetLocal.
+	HooksTarget string
+	// Snippets names optional hook snippets to register alongside the hooks.
+	Snippets []string
+}
+
+const (
+	HooksTargetSettings = "settings"
+	HooksTargetLocal    = "local"
+)
+
+// memoryFile is the project memory file; a stack's copy is its section.
+const memoryFile = "CLAUDE.md"
+
+// stackRulesPath is where a stack's CLAUDE.md section is installed. Claude
+// reads every file in .claude/rules as project memory.
+func stackRulesPath(stack string) string {
+	return ".claude/rules/" + stack + ".md"
+}
+
+// hooksFile is where a pack's hooks.json would land if copied verbatim.
+const hooksFile = ".claude/hooks.json"
+
+// HooksTargetPath maps a hooks target to the settings file it names.
+func HooksTargetPath(target string) (string, error) {
+	switch target {
+	case "", HooksTargetSettings:
+		return ".claude/settings.json", nil
+	case HooksTargetLocal:
+		return ".claude/settings.local.json", nil
+	}
+	return "", fmt.Errorf("unknown hooks target %q (want %q or %q)", target, HooksTargetSettings, HooksTargetLocal)
 }
 
-// Allowed stack keys returned by the TUI/flags.
-var allowedStacks = []string{
-	"go",
-	"typescript",
-	"python",
-	"flutter",
+// isSettingsPath reports whether rel is a Claude settings file, which stacks
+// extend with fragments instead of replacing.
+func isSettingsPath(rel string) bool {
+	return rel == ".claude/settings.json" || rel == ".claude/settings.local.json"
 }
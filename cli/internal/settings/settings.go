@@ -0,0 +1,387 @@
+package settings
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Object is a JSON object that preserves key order, so rewriting a user's
+// settings file does not shuffle it. Values are *Object, []any, string,
+// json.Number, bool or nil.
+type Object struct {
+	keys   []string
+	values map[string]any
+}
+
+// NewObject returns an empty object.
+func NewObject() *Object {
+	return &Object{values: map[string]any{}}
+}
+
+// Keys returns the object's keys in document order.
+func (o *Object) Keys() []string {
+	if o == nil {
+		return nil
+	}
+	return o.keys
+}
+
+// Len reports the number of keys.
+func (o *Object) Len() int {
+	if o == nil {
+		return 0
+	}
+	return len(o.keys)
+}
+
+// Get returns the value stored under k.
+func (o *Object) Get(k string) (any, bool) {
+	if o == nil {
+		return nil, false
+	}
+	v, ok := o.values[k]
+	return v, ok
+}
+
+// Set stores v under k, appending k if it is new.
+func (o *Object) Set(k string, v any) {
+	if _, ok := o.values[k]; !ok {
+		o.keys = append(o.keys, k)
+	}
+	o.values[k] = v
+}
+
+// Delete removes k.
+func (o *Object) Delete(k string) {
+	if _, ok := o.values[k]; !ok {
+		return
+	}
+	delete(o.values, k)
+	for i, key := range o.keys {
+		if key == k {
+			o.keys = append(o.keys[:i:i], o.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// Parse decodes a JSON object. Empty input yields an empty object.
+func Parse(b []byte) (*Object, error) {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return NewObject(), nil
+	}
+	v, err := ParseValue(b)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := v.(*Object)
+	if !ok {
+		return nil, fmt.Errorf("expected a JSON object")
+	}
+	return obj, nil
+}
+
+// ParseValue decodes any JSON value, keeping object key order.
+func ParseValue(b []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	v, err := decodeValue(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return v, nil
+}
+
+func decodeValue(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t := tok.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			obj := NewObject()
+			for dec.More() {
+				kt, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				v, err := decodeValue(dec)
+				if err != nil {
+					return nil, err
+				}
+				obj.Set(kt.(string), v)
+			}
+			if _, err := dec.Token(); err != nil {
+				return nil, err
+			}
+			return obj, nil
+		case '[':
+			arr := []any{}
+			for dec.More() {
+				v, err := decodeValue(dec)
+				if err != nil {
+					return nil, err
+				}
+				arr = append(arr, v)
+			}
+			if _, err := dec.Token(); err != nil {
+				return nil, err
+			}
+			return arr, nil
+		}
+		return nil, fmt.Errorf("unexpected delimiter %v", t)
+	default:
+		return t, nil
+	}
+}
+
+// Encode renders o with two-space indentation and a trailing newline.
+// HTML characters are left unescaped since hook commands often use && and >.
+func Encode(o *Object) []byte {
+	var b bytes.Buffer
+	encodeValue(&b, o, "", true)
+	b.WriteByte('\n')
+	return b.Bytes()
+}
+
+// Compact renders v on a single line; it doubles as the identity of array
+// members when arrays are treated as sets.
+func Compact(v any) string {
+	var b bytes.Buffer
+	encodeValue(&b, v, "", false)
+	return b.String()
+}
+
+func encodeValue(b *bytes.Buffer, v any, indent string, pretty bool) {
+	inner := indent + "  "
+	newline := func(level string) {
+		if pretty {
+			b.WriteByte('\n')
+			b.WriteString(level)
+		}
+	}
+	switch t := v.(type) {
+	case *Object:
+		if t.Len() == 0 {
+			b.WriteString("{}")
+			return
+		}
+		b.WriteByte('{')
+		for i, k := range t.keys {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			newline(inner)
+			encodeString(b, k)
+			b.WriteByte(':')
+			if pretty {
+				b.WriteByte(' ')
+			}
+			encodeValue(b, t.values[k], inner, pretty)
+		}
+		newline(indent)
+		b.WriteByte('}')
+	case []any:
+		if len(t) == 0 {
+			b.WriteString("[]")
+			return
+		}
+		b.WriteByte('[')
+		for i, e := range t {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			newline(inner)
+			encodeValue(b, e, inner, pretty)
+		}
+		newline(indent)
+		b.WriteByte(']')
+	case string:
+		encodeString(b, t)
+	case json.Number:
+		b.WriteString(t.String())
+	case bool:
+		if t {
+			b.WriteString("true")
+		} else {
+			b.WriteString("false")
+		}
+	case nil:
+		b.WriteString("null")
+	default:
+		out, _ := json.Marshal(t)
+		b.Write(out)
+	}
+}
+
+func encodeString(b *bytes.Buffer, s string) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	b.WriteString(strings.TrimSuffix(buf.String(), "\n"))
+}
+
+// Overlay folds src into dst, as used to compose the pack's settings from the
+// base file and stack fragments: nested objects are combined, arrays are
+// unioned, and scalars from src replace those in dst.
+func Overlay(dst, src *Object) {
+	for _, k := range src.Keys() {
+		sv := src.values[k]
+		dv, ok := dst.values[k]
+		if !ok {
+			dst.Set(k, clone(sv))
+			continue
+		}
+		switch s := sv.(type) {
+		case *Object:
+			if d, ok := dv.(*Object); ok {
+				Overlay(d, s)
+				continue
+			}
+		case []any:
+			if d, ok := dv.([]any); ok {
+				dst.Set(k, union(d, s))
+				continue
+			}
+		}
+		dst.Set(k, clone(sv))
+	}
+}
+
+// Merge performs a three-way merge of JSON settings. base is what codo
+// contributed previously (nil on first install), ours is the file on disk and
+// theirs is the new contribution. Keys and array members the user added are
+// kept, upstream additions are applied, upstream removals drop only what codo
+// contributed, and a scalar the user changed wins over upstream.
+func Merge(base, ours, theirs *Object) *Object {
+	out := NewObject()
+	keys := append([]string(nil), ours.Keys()...)
+	for _, k := range theirs.Keys() {
+		if _, ok := ours.Get(k); !ok {
+			keys = append(keys, k)
+		}
+	}
+	for _, k := range keys {
+		b, bok := base.Get(k)
+		o, ook := ours.Get(k)
+		t, tok := theirs.Get(k)
+		if v, keep := mergeValue(b, bok, o, ook, t, tok); keep {
+			out.Set(k, v)
+		}
+	}
+	return out
+}
+
+func mergeValue(b any, bok bool, o any, ook bool, t any, tok bool) (any, bool) {
+	if !ook {
+		// Absent locally: either new upstream, or deleted by the user.
+		if tok && !bok {
+			return clone(t), true
+		}
+		return nil, false
+	}
+	if bok && Compact(o) == Compact(b) {
+		// Untouched since codo wrote it: follow upstream.
+		if tok {
+			return clone(t), true
+		}
+		return nil, false
+	}
+	switch ov := o.(type) {
+	case *Object:
+		bv, _ := b.(*Object)
+		tv, isObj := t.(*Object)
+		if tok && !isObj {
+			return o, true
+		}
+		merged := Merge(bv, ov, tv)
+		if merged.Len() == 0 && ov.Len() > 0 {
+			return nil, false
+		}
+		return merged, true
+	case []any:
+		bv, _ := b.([]any)
+		tv, isArr := t.([]any)
+		if tok && !isArr {
+			return o, true
+		}
+		merged := mergeSet(bv, ov, tv)
+		if len(merged) == 0 && len(ov) > 0 {
+			return nil, false
+		}
+		return merged, true
+	}
+	return o, true
+}
+
+// mergeSet treats arrays as sets: ours minus what upstream removed, plus what
+// upstream added, preserving the local order.
+func mergeSet(base, ours, theirs []any) []any {
+	inBase := index(base)
+	inTheirs := index(theirs)
+	inOurs := index(ours)
+	out := []any{}
+	for _, v := range ours {
+		k := Compact(v)
+		if inBase[k] && !inTheirs[k] {
+			continue
+		}
+		out = append(out, v)
+	}
+	for _, v := range theirs {
+		k := Compact(v)
+		if !inBase[k] && !inOurs[k] {
+			out = append(out, clone(v))
+			inOurs[k] = true
+		}
+	}
+	return out
+}
+
+func union(a, b []any) []any {
+	seen := index(a)
+	out := append([]any(nil), a...)
+	for _, v := range b {
+		k := Compact(v)
+		if !seen[k] {
+			out = append(out, clone(v))
+			seen[k] = true
+		}
+	}
+	return out
+}
+
+func index(vs []any) map[string]bool {
+	m := make(map[string]bool, len(vs))
+	for _, v := range vs {
+		m[Compact(v)] = true
+	}
+	return m
+}
+
+func clone(v any) any {
+	switch t := v.(type) {
+	case *Object:
+		c := NewObject()
+		for _, k := range t.keys {
+			c.Set(k, clone(t.values[k]))
+		}
+		return c
+	case []any:
+		c := make([]any, len(t))
+		for i, e := range t {
+			c[i] = clone(e)
+		}
+		return c
+	}
+	return v
+}
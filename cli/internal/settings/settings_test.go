@@ -0,0 +1,89 @@
+package settings
+
+import "testing"
+
+func mustParse(t *testing.T, s string) *Object {
+	t.Helper()
+	o, err := Parse([]byte(s))
+	if err != nil {
+		t.Fatalf("parse %s: %v", s, err)
+	}
+	return o
+}
+
+func TestParseEncodeKeepsOrder(t *testing.T) {
+	in := "{\n  \"z\": 1,\n  \"a\": {\n    \"cmd\": \"a && b > c\",\n    \"list\": []\n  }\n}\n"
+	if got := string(Encode(mustParse(t, in))); got != in {
+		t.Fatalf("round trip:\n%s\nwant:\n%s", got, in)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	cases := []struct {
+		name   string
+		base   string
+		ours   string
+		theirs string
+		want   string
+	}{
+		{
+			name:   "first install keeps user keys and rules",
+			base:   `{}`,
+			ours:   `{"model":"opus","permissions":{"defaultMode":"acceptEdits","allow":["Bash(make:*)"]}}`,
+			theirs: `{"permissions":{"defaultMode":"plan","allow":["Read(**)"]}}`,
+			want:   `{"model":"opus","permissions":{"defaultMode":"acceptEdits","allow":["Bash(make:*)","Read(**)"]}}`,
+		},
+		{
+			name:   "upstream adds and removes rules",
+			base:   `{"permissions":{"allow":["A","B"],"deny":["X"]}}`,
+			ours:   `{"permissions":{"allow":["A","B","Mine"],"deny":["X"]}}`,
+			theirs: `{"permissions":{"allow":["A","C"],"deny":["X","Y"]}}`,
+			want:   `{"permissions":{"allow":["A","Mine","C"],"deny":["X","Y"]}}`,
+		},
+		{
+			name:   "user removal of an owned rule sticks",
+			base:   `{"permissions":{"deny":["Bash(curl:*)","Bash(wget:*)"]}}`,
+			ours:   `{"permissions":{"deny":["Bash(wget:*)"]}}`,
+			theirs: `{"permissions":{"deny":["Bash(curl:*)","Bash(wget:*)"]}}`,
+			want:   `{"permissions":{"deny":["Bash(wget:*)"]}}`,
+		},
+		{
+			name:   "untouched scalar follows upstream, edited scalar wins",
+			base:   `{"a":1,"b":1}`,
+			ours:   `{"a":1,"b":2}`,
+			theirs: `{"a":3,"b":3}`,
+			want:   `{"a":3,"b":2}`,
+		},
+		{
+			name:   "stripping the contribution leaves user content",
+			base:   `{"includeCoAuthoredBy":false,"permissions":{"allow":["A"]}}`,
+			ours:   `{"includeCoAuthoredBy":false,"permissions":{"allow":["A","Mine"]},"env":{"X":"1"}}`,
+			theirs: `{}`,
+			want:   `{"permissions":{"allow":["Mine"]},"env":{"X":"1"}}`,
+		},
+		{
+			name:   "containers emptied by removals are dropped",
+			base:   `{"permissions":{"allow":["A"]}}`,
+			ours:   `{"permissions":{"allow":["A"],"deny":["B"]}}`,
+			theirs: `{}`,
+			want:   `{"permissions":{"deny":["B"]}}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Merge(mustParse(t, tc.base), mustParse(t, tc.ours), mustParse(t, tc.theirs))
+			if Compact(got) != tc.want {
+				t.Fatalf("got  %s\nwant %s", Compact(got), tc.want)
+			}
+		})
+	}
+}
+
+func TestOverlay(t *testing.T) {
+	dst := mustParse(t, `{"permissions":{"defaultMode":"plan","allow":["A"]}}`)
+	Overlay(dst, mustParse(t, `{"permissions":{"allow":["A","B"]},"env":{"GOFLAGS":"-mod=mod"}}`))
+	want := `{"permissions":{"defaultMode":"plan","allow":["A","B"]},"env":{"GOFLAGS":"-mod=mod"}}`
+	if Compact(dst) != want {
+		t.Fatalf("got  %s\nwant %s", Compact(dst), want)
+	}
+}
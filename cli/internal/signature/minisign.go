@@ -0,0 +1,191 @@
+// Package signature verifies detached minisign signatures (Ed25519) on
+// release artifacts.
+package signature
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// Signature algorithms: legacy signatures sign the message itself, current
+// minisign signs its BLAKE2b-512 hash.
+var (
+	algLegacy    = [2]byte{'E', 'd'}
+	algPrehashed = [2]byte{'E', 'D'}
+)
+
+const (
+	untrustedPrefix = "untrusted comment:"
+	trustedPrefix   = "trusted comment: "
+)
+
+// PublicKey is a minisign public key.
+type PublicKey struct {
+	ID  [8]byte
+	Key ed25519.PublicKey
+}
+
+// ParsePublicKey parses a key as printed by `minisign -G` (the base64 line),
+// optionally preceded by the untrusted comment line of a .pub file.
+func ParsePublicKey(s string) (PublicKey, error) {
+	var line string
+	for _, l := range strings.Split(strings.TrimSpace(s), "\n") {
+		if l = strings.TrimSpace(l); l != "" && !strings.HasPrefix(l, untrustedPrefix) {
+			line = l
+		}
+	}
+	b, err := base64.StdEncoding.DecodeString(line)
+	if err != nil || len(b) != 2+8+ed25519.PublicKeySize || !bytes.Equal(b[:2], algLegacy[:]) {
+		return PublicKey{}, fmt.Errorf("invalid minisign public key %q", line)
+	}
+	var k PublicKey
+	copy(k.ID[:], b[2:10])
+	k.Key = ed25519.PublicKey(b[10:])
+	return k, nil
+}
+
+// String returns the key in minisign's base64 form.
+func (k PublicKey) String() string {
+	b := append(append(algLegacy[:], k.ID[:]...), k.Key...)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// KeyID formats the key ID the way minisign prints it.
+func (k PublicKey) KeyID() string {
+	return keyID(k.ID)
+}
+
+func keyID(id [8]byte) string {
+	return fmt.Sprintf("%016X", binary.LittleEndian.Uint64(id[:]))
+}
+
+// Signature is a parsed .minisig file.
+type Signature struct {
+	Algorithm      [2]byte
+	KeyID          [8]byte
+	Sig            []byte
+	TrustedComment string
+	GlobalSig      []byte
+}
+
+// ParseSignature parses the contents of a .minisig file.
+func ParseSignature(b []byte) (Signature, error) {
+	lines := strings.Split(strings.ReplaceAll(string(b), "\r\n", "\n"), "\n")
+	if len(lines) < 4 || !strings.HasPrefix(lines[0], untrustedPrefix) || !strings.HasPrefix(lines[2], trustedPrefix) {
+		return Signature{}, errors.New("malformed signature file")
+	}
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[1]))
+	if err != nil || len(raw) != 2+8+ed25519.SignatureSize {
+		return Signature{}, errors.New("malformed signature")
+	}
+	global, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[3]))
+	if err != nil || len(global) != ed25519.SignatureSize {
+		return Signature{}, errors.New("malformed global signature")
+	}
+	var s Signature
+	copy(s.Algorithm[:], raw[:2])
+	copy(s.KeyID[:], raw[2:10])
+	s.Sig = raw[10:]
+	s.TrustedComment = strings.TrimPrefix(lines[2], trustedPrefix)
+	s.GlobalSig = global
+	return s, nil
+}
+
+// Verify checks sig is k's signature of msg, including the trusted comment.
+func (k PublicKey) Verify(msg []byte, sig Signature) error {
+	if sig.KeyID != k.ID {
+		return fmt.Errorf("signed by key %s, not %s", keyID(sig.KeyID), k.KeyID())
+	}
+	switch sig.Algorithm {
+	case algPrehashed:
+		h := blake2b.Sum512(msg)
+		msg = h[:]
+	case algLegacy:
+	default:
+		return fmt.Errorf("unsupported signature algorithm %q", sig.Algorithm[:])
+	}
+	if !ed25519.Verify(k.Key, msg, sig.Sig) {
+		return errors.New("signature verification failed")
+	}
+	if !ed25519.Verify(k.Key, append(append([]byte{}, sig.Sig...), sig.TrustedComment...), sig.GlobalSig) {
+		return errors.New("trusted comment verification failed")
+	}
+	return nil
+}
+
+// Sign signs msg the way `minisign -S` does (prehashed), for tests and
+// release tooling.
+func Sign(priv ed25519.PrivateKey, id [8]byte, msg []byte, trustedComment string) []byte {
+	h := blake2b.Sum512(msg)
+	sig := ed25519.Sign(priv, h[:])
+	global := ed25519.Sign(priv, append(append([]byte{}, sig...), trustedComment...))
+	raw := append(append(algPrehashed[:], id[:]...), sig...)
+	return []byte(fmt.Sprintf("%s signature from codo\n%s\n%s%s\n%s\n",
+		untrustedPrefix, base64.StdEncoding.EncodeToString(raw),
+		trustedPrefix, trustedComment, base64.StdEncoding.EncodeToString(global)))
+}
+
+// Keyring is the set of keys trusted to sign artifacts.
+type Keyring []PublicKey
+
+// ParseKeys reads one key per line. Blank lines, # comments and the
+// untrusted comment lines of .pub files are skipped.
+func ParseKeys(text string) (Keyring, error) {
+	var out Keyring
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, untrustedPrefix) {
+			continue
+		}
+		k, err := ParsePublicKey(line)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, k)
+	}
+	return out, nil
+}
+
+// ErrNoKeys is returned by Verify when there is no key to check against.
+var ErrNoKeys = errors.New("no trusted signing keys")
+
+// Verifier checks artifacts against a keyring. Insecure skips the check.
+type Verifier struct {
+	Keys     Keyring
+	Insecure bool
+}
+
+// Verify checks sig, the contents of name's .minisig file (nil if there is
+// none), and returns the ID of the key that signed it.
+func (v Verifier) Verify(name string, data, sig []byte) (string, error) {
+	if v.Insecure {
+		return "", nil
+	}
+	if len(v.Keys) == 0 {
+		return "", fmt.Errorf("cannot verify %s: %w (add one to the trusted keys file, or pass --insecure)", name, ErrNoKeys)
+	}
+	if sig == nil {
+		return "", fmt.Errorf("%s is not signed (pass --insecure to use it anyway)", name)
+	}
+	s, err := ParseSignature(sig)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+	for _, k := range v.Keys {
+		if k.ID != s.KeyID {
+			continue
+		}
+		if err := k.Verify(data, s); err != nil {
+			return "", fmt.Errorf("%s: %w", name, err)
+		}
+		return k.KeyID(), nil
+	}
+	return "", fmt.Errorf("%s is signed by untrusted key %s", name, keyID(s.KeyID))
+}
@@ -0,0 +1,63 @@
+package signature
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newKey(t *testing.T, id byte) (PublicKey, ed25519.PrivateKey) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return PublicKey{ID: [8]byte{id, 1, 2, 3, 4, 5, 6, 7}, Key: pub}, priv
+}
+
+func TestVerify(t *testing.T) {
+	pub, priv := newKey(t, 1)
+	other, otherPriv := newKey(t, 2)
+	msg := []byte("pack contents")
+	sig := Sign(priv, pub.ID, msg, "timestamp:1700000000\tfile:dotclaude-pack.zip")
+
+	// Keys round-trip through their text form, as in a .pub file.
+	keys, err := ParseKeys("# release key\nuntrusted comment: minisign public key\n" + pub.String() + "\n\n" + other.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := Verifier{Keys: keys}
+	if id, err := v.Verify("pack.zip", msg, sig); err != nil || id != pub.KeyID() {
+		t.Fatalf("Verify = %q, %v", id, err)
+	}
+
+	tamperedComment := strings.Replace(string(sig), "file:dotclaude-pack.zip", "file:other.zip", 1)
+	cases := []struct {
+		name string
+		v    Verifier
+		msg  []byte
+		sig  []byte
+		want string
+	}{
+		{"tampered content", v, []byte("pack contents!"), sig, "verification failed"},
+		{"tampered comment", v, msg, []byte(tamperedComment), "trusted comment"},
+		{"unsigned", v, msg, nil, "not signed"},
+		{"untrusted key", Verifier{Keys: Keyring{other}}, msg, sig, "untrusted key " + pub.KeyID()},
+		{"no keys", Verifier{}, msg, sig, "no trusted signing keys"},
+		{"malformed", v, msg, []byte("garbage"), "malformed"},
+		{"key id spoofed", v, msg, Sign(otherPriv, pub.ID, msg, "x"), "verification failed"},
+	}
+	for _, tc := range cases {
+		if _, err := tc.v.Verify("pack.zip", tc.msg, tc.sig); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: err = %v, want %q", tc.name, err, tc.want)
+		}
+	}
+	if _, err := (Verifier{}).Verify("pack.zip", msg, sig); !errors.Is(err, ErrNoKeys) {
+		t.Errorf("no keys: err = %v, want ErrNoKeys", err)
+	}
+	if _, err := (Verifier{Insecure: true}).Verify("pack.zip", []byte("anything"), nil); err != nil {
+		t.Fatalf("insecure: %v", err)
+	}
+}
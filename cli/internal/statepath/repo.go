@@ -0,0 +1,213 @@
+package statepath
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// idFile holds a repository's codo identity in its git directory, shared by
+// all of its worktrees.
+const idFile = "codo-id"
+
+var validID = regexp.MustCompile(`^[0-9a-f]{16}$`)
+
+var (
+	keysMu sync.Mutex
+	keys   = map[string]string{}
+)
+
+// RepoRoot returns the top level of the git work tree enclosing dir (the
+// worktree's own root in a linked worktree), or dir itself outside git.
+func RepoRoot(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	top, err := git(abs, "rev-parse", "--show-toplevel")
+	if err != nil || top == "" {
+		return abs, nil
+	}
+	return filepath.FromSlash(top), nil
+}
+
+// RepoID returns the identity codo keys the state of the repository at root
+// by, and whether it is a git identity rather than a hash of the path.
+//
+// A git repository's identity is a random ID stored in its git directory, so
+// it survives moving the checkout and is shared by worktrees, but no two
+// clones have the same one. A repository that has none yet is keyed by its
+// path, where older versions kept its state; EnsureRepoID gives it one.
+func RepoID(root string) (string, bool) {
+	return repoID(root, false)
+}
+
+// EnsureRepoID is RepoID for a command about to change the repository: a git
+// repository without an identity is given one.
+func EnsureRepoID(root string) (string, bool) {
+	return repoID(root, true)
+}
+
+func repoID(root string, create bool) (string, bool) {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		abs = root
+	}
+	keysMu.Lock()
+	defer keysMu.Unlock()
+	if k, ok := keys[abs]; ok && (k != pathKey(abs) || !create) {
+		return k, k != pathKey(abs)
+	}
+	k, ok := gitID(abs, create)
+	if !ok {
+		k = pathKey(abs)
+	}
+	keys[abs] = k
+	return k, ok
+}
+
+func gitID(root string, create bool) (string, bool) {
+	common, err := git(root, "rev-parse", "--git-common-dir")
+	if err != nil || common == "" {
+		return "", false
+	}
+	if !filepath.IsAbs(common) {
+		common = filepath.Join(root, common)
+	}
+	path := filepath.Join(common, idFile)
+	if b, err := os.ReadFile(path); err == nil {
+		if id := strings.TrimSpace(string(b)); validID.MatchString(id) {
+			return id, true
+		}
+	}
+	if !create {
+		return "", false
+	}
+	// Identities used to be derived from the origin remote and root
+	// commit, which clones share; one is kept only for the checkout whose
+	// state is already stored under it.
+	id := derivedID(root)
+	if id == "" || !ownsState(id, root) {
+		var b [8]byte
+		if _, err := rand.Read(b[:]); err != nil {
+			return "", false
+		}
+		id = hex.EncodeToString(b[:])
+	}
+	if err := os.WriteFile(path, []byte(id+"\n"), 0o644); err != nil {
+		return "", false
+	}
+	return id, true
+}
+
+// derivedID hashes the repository's origin URL and root commit, the way
+// older versions named a repository, or returns "" for one without commits.
+func derivedID(root string) string {
+	out, err := git(root, "rev-list", "--max-parents=0", "HEAD")
+	if err != nil || out == "" {
+		return ""
+	}
+	commits := strings.Fields(out)
+	slices.Sort(commits)
+	remote, _ := git(root, "remote", "get-url", "origin")
+	sum := sha256.Sum256([]byte(normalizeRemote(remote) + "\n" + commits[0]))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// normalizeRemote reduces the forms of a remote URL (https, ssh, scp-like,
+// with or without .git) to host/path.
+func normalizeRemote(url string) string {
+	url = strings.TrimSpace(url)
+	if i := strings.Index(url, "://"); i >= 0 {
+		url = url[i+3:]
+	} else if host, path, ok := strings.Cut(url, ":"); ok && !strings.Contains(host, "/") {
+		url = host + "/" + path
+	}
+	if i := strings.LastIndex(url, "@"); i >= 0 && i < strings.Index(url+"/", "/") {
+		url = url[i+1:]
+	}
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSuffix(url, "/"), ".git"))
+}
+
+// ownsState reports whether the manifest keyed id was written from the
+// checkout at root.
+func ownsState(id, root string) bool {
+	base, err := baseDir()
+	if err != nil {
+		return false
+	}
+	b, err := os.ReadFile(filepath.Join(base, "manifests", id+".json"))
+	if err != nil {
+		return false
+	}
+	var m struct {
+		Root string `json:"root"`
+	}
+	return json.Unmarshal(b, &m) == nil && m.Root == root
+}
+
+// pathKey is how state was keyed before repository identities: a hash of the
+// absolute path.
+func pathKey(abs string) string {
+	sum := sha256.Sum256([]byte(abs))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// MigratePathKeyed moves the state of the repository at root (manifest,
+// backups, journal and history) from its path key to its identity. It returns the
+// manifest path moved from, or "" when there was nothing to move.
+func MigratePathKeyed(root string) (string, error) {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return "", err
+	}
+	id, ok := RepoID(abs)
+	old := pathKey(abs)
+	if !ok || id == old {
+		return "", nil
+	}
+	base, err := baseDir()
+	if err != nil {
+		return "", err
+	}
+	from := filepath.Join(base, "manifests", old+".json")
+	to := filepath.Join(base, "manifests", id+".json")
+	if _, err := os.Stat(from); err != nil {
+		return "", nil
+	}
+	if _, err := os.Stat(to); err == nil {
+		return "", nil // already installed under its identity
+	}
+	if err := os.Rename(from, to); err != nil {
+		return "", err
+	}
+	for _, layout := range []string{"backups/%s", "journals/%s", "history/%s.jsonl"} {
+		src := filepath.Join(base, filepath.FromSlash(fmt.Sprintf(layout, old)))
+		dst := filepath.Join(base, filepath.FromSlash(fmt.Sprintf(layout, id)))
+		if _, err := os.Stat(dst); !errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err := os.Rename(src, dst); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+	}
+	return from, nil
+}
+
+func git(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	return strings.TrimSpace(string(out)), err
+}
@@ -0,0 +1,142 @@
+package statepath
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestNormalizeRemote(t *testing.T) {
+	want := "github.com/acme/widgets"
+	for _, url := range []string{
+		"git@github.com:acme/widgets.git",
+		"https://github.com/acme/widgets",
+		"https://user@GitHub.com/acme/widgets.git",
+		"ssh://git@github.com/acme/widgets.git/",
+	} {
+		if got := normalizeRemote(url); got != want {
+			t.Errorf("normalizeRemote(%q) = %q, want %q", url, got, want)
+		}
+	}
+}
+
+func TestRepoIDFollowsTheRepository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := filepath.Join(dir, "repo")
+	run := func(dir string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=a", "GIT_AUTHOR_EMAIL=a@b", "GIT_COMMITTER_NAME=a", "GIT_COMMITTER_EMAIL=a@b")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(repo, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	run(repo, "init", "-q")
+	run(repo, "commit", "-q", "--allow-empty", "-m", "root")
+	run(repo, "worktree", "add", "-q", filepath.Join(dir, "wt"))
+
+	root, err := RepoRoot(filepath.Join(repo, "sub"))
+	if err != nil || root != repo {
+		t.Fatalf("RepoRoot(sub) = %q, %v; want %q", root, err, repo)
+	}
+	if _, ok := RepoID(repo); ok {
+		t.Fatal("RepoID gave a repository without one an identity")
+	}
+	if _, err := os.Stat(filepath.Join(repo, ".git", idFile)); !os.IsNotExist(err) {
+		t.Fatalf("RepoID wrote %s: %v", idFile, err)
+	}
+	id, ok := EnsureRepoID(repo)
+	if !ok {
+		t.Fatal("no git identity")
+	}
+	if again, _ := RepoID(repo); again != id {
+		t.Fatalf("RepoID = %s after EnsureRepoID gave %s", again, id)
+	}
+	if wt, _ := RepoID(filepath.Join(dir, "wt")); wt != id {
+		t.Fatalf("worktree identity %s, repository %s", wt, id)
+	}
+	moved := filepath.Join(dir, "moved")
+	if err := os.Rename(repo, moved); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := RepoID(moved); got != id {
+		t.Fatalf("identity after move %s, want %s", got, id)
+	}
+
+	// Clones of the same upstream are different repositories.
+	run(dir, "clone", "-q", moved, filepath.Join(dir, "a"))
+	run(dir, "clone", "-q", moved, filepath.Join(dir, "b"))
+	a, _ := EnsureRepoID(filepath.Join(dir, "a"))
+	b, _ := EnsureRepoID(filepath.Join(dir, "b"))
+	if a == b || a == id {
+		t.Fatalf("clones share an identity: %s, %s (upstream %s)", a, b, id)
+	}
+
+	outside := t.TempDir()
+	if _, ok := RepoID(outside); ok {
+		t.Fatal("a directory outside git has a git identity")
+	}
+}
+
+func TestMigratePathKeyedMovesAllState(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	repo, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out, err := exec.Command("git", "-C", repo, "init", "-q").CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v\n%s", err, out)
+	}
+	base, err := baseDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	layouts := []string{"manifests/%s.json", "backups/%s/20250101-000000/a.md", "journals/%s/journal.json", "history/%s.jsonl"}
+	at := func(layout, key string) string {
+		return filepath.Join(base, filepath.FromSlash(fmt.Sprintf(layout, key)))
+	}
+	// State as older versions kept it, under the hash of the path.
+	old := pathKey(repo)
+	for _, layout := range layouts {
+		path := at(layout, old)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(layout), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	id, ok := EnsureRepoID(repo)
+	if !ok {
+		t.Fatal("no git identity")
+	}
+	from, err := MigratePathKeyed(repo)
+	if err != nil || from != at(layouts[0], old) {
+		t.Fatalf("MigratePathKeyed = %q, %v", from, err)
+	}
+	for _, layout := range layouts {
+		if _, err := os.Stat(at(layout, id)); err != nil {
+			t.Errorf("%s not moved to the identity: %v", layout, err)
+		}
+		if _, err := os.Stat(at(layout, old)); !os.IsNotExist(err) {
+			t.Errorf("%s left under the path key: %v", layout, err)
+		}
+	}
+}
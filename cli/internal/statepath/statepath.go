@@ -1,8 +1,6 @@
 package statepath
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
 	"os"
 	"path/filepath"
 )
@@ -22,12 +20,8 @@ func baseDir() (string, error) {
 }
 
 func repoKey(root string) string {
-	abs, err := filepath.Abs(root)
-	if err != nil {
-		abs = root
-	}
-	sum := sha256.Sum256([]byte(abs))
-	return hex.EncodeToString(sum[:])[:16]
+	key, _ := RepoID(root)
+	return key
 }
 
 func manifestPath(root string) (string, error) {
@@ -46,6 +40,14 @@ func backupRoot(root string) (string, error) {
 	return filepath.Join(base, "backups", repoKey(root)), nil
 }
 
+func basesRoot() (string, error) {
+	base, err := baseDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, "bases"), nil
+}
+
 // ManifestPath returns the absolute path to the manifest file for the repository root.
 func ManifestPath(root string) (string, error) {
 	return manifestPath(root)
@@ -60,7 +62,73 @@ func BackupDir(root, timestamp string) (string, error) {
 	return filepath.Join(base, timestamp), nil
 }
 
+// BackupsDir returns the directory holding the repo's backups, one per timestamp.
+func BackupsDir(root string) (string, error) {
+	return backupRoot(root)
+}
+
+// BasePath returns the content-addressed location of an installed pack file,
+// keyed by its SHA256 hex digest. Stored bases feed three-way merges on update.
+func BasePath(sum string) (string, error) {
+	root, err := basesRoot()
+	if err != nil {
+		return "", err
+	}
+	if len(sum) < 2 {
+		return filepath.Join(root, sum), nil
+	}
+	return filepath.Join(root, sum[:2], sum), nil
+}
+
+// ManifestsDir returns the directory holding the manifests of all repositories.
+func ManifestsDir() (string, error) {
+	base, err := baseDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, "manifests"), nil
+}
+
+// PacksDir returns the pack cache, where fetched packs are kept by digest.
+func PacksDir() (string, error) {
+	base, err := baseDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, "packs"), nil
+}
+
+// TrustedKeysPath returns the file listing the user's trusted signing keys,
+// one minisign public key per line.
+func TrustedKeysPath() (string, error) {
+	base, err := baseDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, "trusted_keys"), nil
+}
+
+// JournalDir returns where the transaction of a command modifying the repo is
+// journaled until it commits.
+func JournalDir(root string) (string, error) {
+	base, err := baseDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, "journals", repoKey(root)), nil
+}
+
 // LegacyManifestPath returns the old in-repo manifest location for migration/removal.
 func LegacyManifestPath(root string) string {
 	return filepath.Join(root, ".claude", ".codo-manifest.json")
 }
+
+// HistoryPath returns the log of the changes codo made to the repo, one JSON
+// event per line.
+func HistoryPath(root string) (string, error) {
+	base, err := baseDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, "history", repoKey(root)+".jsonl"), nil
+}
@@ -15,19 +15,16 @@ type InitResult struct {
 	Confirmed bool
 }
 
-type option struct {
-	Key   string // exact key for pack.Files()
-	Label string // pretty label in the TUI
-}
-
-var opts = []option{
-	{Key: "go", Label: "Go"},
-	{Key: "typescript", Label: "TypeScript / Node"},
-	{Key: "python", Label: "Python"},
-	{Key: "flutter", Label: "Flutter"},
+// Option is one selectable stack, taken from the pack's catalog.
+type Option struct {
+	Key         string // exact key for pack.Files()
+	Label       string // pretty label in the TUI
+	Description string
+	Selected    bool   // preselected, e.g. detected in the repository
 }
 
 type model struct {
+	opts     []Option
 	cursor   int
 	selected map[int]bool
 	quit     bool
@@ -50,7 +47,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.cursor--
 			}
 		case "down", "j":
-			if m.cursor < len(opts)-1 {
+			if m.cursor < len(m.opts)-1 {
 				m.cursor++
 			}
 		case " ":
@@ -62,7 +59,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.selected == nil {
 				m.selected = map[int]bool{}
 			}
-			for i := range opts {
+			for i := range m.opts {
 				m.selected[i] = true
 			}
 		case "n": // select none
@@ -78,7 +75,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m model) View() string {
 	var b strings.Builder
 	fmt.Fprint(&b, "Select stacks to include (space to toggle):\n\n")
-	for i, o := range opts {
+	for i, o := range m.opts {
 		cursor := " "
 		if m.cursor == i {
 			cursor = ">"
@@ -88,13 +85,19 @@ func (m model) View() string {
 			box = "x"
 		}
 		fmt.Fprintf(&b, " %s [%s] %s\n", cursor, box, o.Label)
+		if m.cursor == i && o.Description != "" {
+			fmt.Fprintf(&b, "       %s\n", o.Description)
+		}
 	}
 	fmt.Fprintln(&b, "\n[↑/↓/j/k] move   [space] toggle   [a] all   [n] none   [enter] continue   [q] abort")
 	return b.String()
 }
 
-func RunInitWizard(_ context.Context) (InitResult, error) {
-	m := model{selected: map[int]bool{}}
+func RunInitWizard(_ context.Context, opts []Option) (InitResult, error) {
+	m := model{opts: opts, selected: map[int]bool{}}
+	for i, o := range opts {
+		m.selected[i] = o.Selected
+	}
 	pm := tea.NewProgram(m)
 	res, err := pm.Run()
 	if err != nil {